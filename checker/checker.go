@@ -0,0 +1,183 @@
+package checker
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/contester/runlib/subprocess"
+)
+
+type Verdict int
+
+const (
+	Accepted = Verdict(iota)
+	WrongAnswer
+	PresentationError
+	Fail
+	// The solution didn't terminate successfully, so its output wasn't checked.
+	NotChecked
+	// Partial score, which the checker reports at the start of its message, see Result.Score.
+	Points
+)
+
+func (v Verdict) String() string {
+	switch v {
+	case Accepted:
+		return "ACCEPTED"
+	case WrongAnswer:
+		return "WRONG_ANSWER"
+	case PresentationError:
+		return "PRESENTATION_ERROR"
+	case Fail:
+		return "FAIL"
+	case NotChecked:
+		return "NOT_CHECKED"
+	case Points:
+		return "POINTS"
+	}
+	return "FAIL"
+}
+
+// ExitCodes maps checker exit codes to verdicts; codes not listed are Fail.
+type ExitCodes map[uint32]Verdict
+
+// Testlib is the convention of testlib.h checkers.
+var Testlib = ExitCodes{0: Accepted, 1: WrongAnswer, 2: PresentationError, 3: Fail, 7: Points}
+
+func (c ExitCodes) Verdict(code uint32) Verdict {
+	if v, ok := c[code]; ok {
+		return v
+	}
+	return Fail
+}
+
+// ParseExitCodes reads a convention written as "code=VERDICT" pairs separated by spaces or commas, like
+// "0=ACCEPTED 1=WRONG_ANSWER 4=POINTS".
+func ParseExitCodes(s string) (ExitCodes, error) {
+	verdicts := map[string]Verdict{}
+	for v := Accepted; v <= Points; v++ {
+		verdicts[v.String()] = v
+	}
+	result := ExitCodes{}
+	for _, pair := range strings.FieldsFunc(s, func(r rune) bool { return r == ',' || r == ' ' || r == '\t' }) {
+		code, name, ok := strings.Cut(pair, "=")
+		if !ok {
+			return nil, fmt.Errorf("exit code mapping %q: no verdict", pair)
+		}
+		n, err := strconv.ParseUint(code, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("exit code mapping %q: %w", pair, err)
+		}
+		v, ok := verdicts[strings.ToUpper(name)]
+		if !ok || v == NotChecked {
+			return nil, fmt.Errorf("exit code mapping %q: unknown verdict %q", pair, name)
+		}
+		result[uint32(n)] = v
+	}
+	if len(result) == 0 {
+		return nil, fmt.Errorf("empty exit code mapping")
+	}
+	return result, nil
+}
+
+// VerdictFromExitCode maps testlib checker exit codes to verdicts.
+func VerdictFromExitCode(code uint32) Verdict {
+	return Testlib.Verdict(code)
+}
+
+// parseScore takes the score from the start of a points message: testlib writes "points 0.5 comment", others
+// may omit the word.
+func parseScore(message string) (float64, string, bool) {
+	rest := strings.TrimPrefix(message, "points ")
+	token, comment, _ := strings.Cut(strings.TrimSpace(rest), " ")
+	score, err := strconv.ParseFloat(token, 64)
+	if err != nil {
+		return 0, message, false
+	}
+	return score, strings.TrimSpace(comment), true
+}
+
+// Files are passed to the checker as its three arguments, in testlib order.
+type Files struct {
+	Input, Output, Answer string
+}
+
+type Result struct {
+	Solution *subprocess.SubprocessResult
+	// Not set if the checker wasn't run, or failed to.
+	Checker *subprocess.SubprocessResult
+	Verdict Verdict
+	// Checker comment, taken from its stderr (or stdout, if stderr is empty). For Points, without the score. If
+	// the checker failed to run, why.
+	Message string
+	// Set for Points.
+	Score float64
+}
+
+// RunWithChecker executes the solution and, if it terminated normally, the checker with files appended to its
+// command line. Solution output must be redirected to files.Output by the caller. Checker output is captured to
+// memory unless the checker subprocess already has redirects set. Checker exit codes are read with codes, Testlib
+// if nil. Both are run with execute, which is Subprocess.Execute if nil. If the checker fails to run, the result is
+// Fail, without Checker.
+func RunWithChecker(solution, checker *subprocess.Subprocess, files Files, codes ExitCodes,
+	execute func(*subprocess.Subprocess) (*subprocess.SubprocessResult, error)) (*Result, error) {
+	if execute == nil {
+		execute = (*subprocess.Subprocess).Execute
+	}
+	sr, err := execute(solution)
+	if err != nil {
+		return nil, fmt.Errorf("solution: %w", err)
+	}
+	result := &Result{
+		Solution: sr,
+		Verdict:  NotChecked,
+	}
+	if sr.SuccessCode != 0 || sr.ExitCode != 0 {
+		return result, nil
+	}
+
+	checker.Cmd.AppendArgs(files.Input, files.Output, files.Answer)
+	if checker.StdOut == nil {
+		checker.StdOut = &subprocess.Redirect{Mode: subprocess.REDIRECT_MEMORY}
+	}
+	if checker.StdErr == nil && !checker.JoinStdOutErr {
+		checker.StdErr = &subprocess.Redirect{Mode: subprocess.REDIRECT_MEMORY}
+	}
+
+	cr, err := execute(checker)
+	if err != nil {
+		// The solution result still stands, only checking failed.
+		result.Verdict = Fail
+		result.Message = fmt.Sprintf("checker: %s", err)
+		return result, nil
+	}
+	result.Checker = cr
+	result.Message = checkerMessage(cr)
+
+	if cr.SuccessCode != 0 {
+		result.Verdict = Fail
+		result.Message = fmt.Sprintf("checker terminated abnormally (flags %#x): %s", cr.SuccessCode, result.Message)
+		return result, nil
+	}
+	if codes == nil {
+		codes = Testlib
+	}
+	result.Verdict = codes.Verdict(cr.ExitCode)
+	if result.Verdict == Points {
+		var ok bool
+		if result.Score, result.Message, ok = parseScore(result.Message); !ok {
+			result.Verdict = Fail
+			result.Message = fmt.Sprintf("checker reported points without a score: %s", result.Message)
+		}
+	}
+	return result, nil
+}
+
+func checkerMessage(r *subprocess.SubprocessResult) string {
+	if m := bytes.TrimSpace(r.Error); len(m) > 0 {
+		return string(m)
+	}
+	return string(bytes.TrimSpace(r.Output))
+}
@@ -0,0 +1,43 @@
+package checker
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseExitCodes(t *testing.T) {
+	got, err := ParseExitCodes("0=ACCEPTED, 4=wrong_answer 5=POINTS")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := (ExitCodes{0: Accepted, 4: WrongAnswer, 5: Points}); !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+	if v := got.Verdict(1); v != Fail {
+		t.Errorf("unlisted code: %s", v)
+	}
+	for _, bad := range []string{"", "0", "x=ACCEPTED", "0=GOOD", "0=NOT_CHECKED"} {
+		if _, err := ParseExitCodes(bad); err == nil {
+			t.Errorf("%q accepted", bad)
+		}
+	}
+}
+
+func TestParseScore(t *testing.T) {
+	cases := []struct {
+		message string
+		score   float64
+		comment string
+		ok      bool
+	}{
+		{"points 0.5 half of the answers", 0.5, "half of the answers", true},
+		{"12", 12, "", true},
+		{"wrong answer", 0, "wrong answer", false},
+	}
+	for _, c := range cases {
+		score, comment, ok := parseScore(c.message)
+		if score != c.score || comment != c.comment || ok != c.ok {
+			t.Errorf("%q: got %v %q %v", c.message, score, comment, ok)
+		}
+	}
+}
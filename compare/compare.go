@@ -0,0 +1,352 @@
+package compare
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Longest token we accept, to keep memory bounded on garbage output.
+const MAX_TOKEN_SIZE = 16 * 1024 * 1024
+
+// Tokens in Result are cut to this length.
+const MAX_REPORTED_TOKEN = 64
+
+// On mismatch, Result has up to this many tokens before and after the differing one.
+const CONTEXT_TOKENS = 3
+
+type Options struct {
+	// If positive, tokens which both parse as floats are equal when they differ by no more than Epsilon, either
+	// absolute or relative to the expected value.
+	Epsilon float64
+	// Zero-byte output never matches, even an empty answer; for problems where it's a presentation error. By
+	// default it's compared like any other output.
+	RejectEmptyOutput bool
+	// Compare bytes exactly, for binary output; Epsilon is ignored. Token in Result is then the 1-based offset of the
+	// first differing byte, and Expected/Actual are the bytes from there, in hex.
+	Binary bool
+	// Tokens equal but for case match, like YES and yes. Tokens which both parse as floats are still compared as
+	// numbers, so 1E5 and 1e5 are only equal within Epsilon.
+	IgnoreCase bool
+}
+
+type Result struct {
+	Equal bool
+	// 1-based index of the first differing token.
+	Token    int64
+	Expected string
+	Actual   string
+	Message  string
+	// Output had no bytes at all, not even whitespace.
+	EmptyOutput bool
+	// For StreamsAny: 1-based index of the answer this result is for.
+	Answer int
+
+	// 1-based lines of the differing token in the answer and in the output, 0 if that side ended.
+	ExpectedLine int64
+	ActualLine   int64
+	// Tokens around the differing one: before it on each side (equal, unless within Epsilon), and after it.
+	ExpectedBefore []string
+	ActualBefore   []string
+	ExpectedAfter  []string
+	ActualAfter    []string
+}
+
+// tokenScanner splits input into whitespace-separated tokens, like bufio.ScanWords, keeping track of lines.
+type tokenScanner struct {
+	*bufio.Scanner
+	// Line of the last token.
+	line int64
+	next int64
+}
+
+func newScanner(r io.Reader) *tokenScanner {
+	t := &tokenScanner{Scanner: bufio.NewScanner(r), next: 1}
+	t.Buffer(make([]byte, 0, 64*1024), MAX_TOKEN_SIZE)
+	t.Split(t.split)
+	return t
+}
+
+func (t *tokenScanner) split(data []byte, atEOF bool) (int, []byte, error) {
+	start := 0
+	for start < len(data) {
+		r, width := utf8.DecodeRune(data[start:])
+		if !unicode.IsSpace(r) {
+			break
+		}
+		if r == '\n' {
+			t.next++
+		}
+		start += width
+	}
+	for i := start; i < len(data); {
+		r, width := utf8.DecodeRune(data[i:])
+		if unicode.IsSpace(r) {
+			t.line = t.next
+			if r == '\n' {
+				t.next++
+			}
+			return i + width, data[start:i], nil
+		}
+		i += width
+	}
+	if atEOF && len(data) > start {
+		t.line = t.next
+		return len(data), data[start:], nil
+	}
+	return start, nil, nil
+}
+
+// context keeps the last CONTEXT_TOKENS tokens seen.
+type context []string
+
+func (c *context) push(s string) {
+	if len(*c) == CONTEXT_TOKENS {
+		*c = append((*c)[:0], (*c)[1:]...)
+	}
+	*c = append(*c, shorten(s))
+}
+
+// tokensAfter reads up to CONTEXT_TOKENS more tokens. Read errors just end the context.
+func tokensAfter(s *tokenScanner) []string {
+	var result []string
+	for len(result) < CONTEXT_TOKENS && s.Scan() {
+		result = append(result, shorten(s.Text()))
+	}
+	return result
+}
+
+func shorten(s string) string {
+	if len(s) > MAX_REPORTED_TOKEN {
+		return s[:MAX_REPORTED_TOKEN] + "..."
+	}
+	return s
+}
+
+func (o *Options) tokensEqual(expected, actual string) bool {
+	if expected == actual {
+		return true
+	}
+	e, errE := strconv.ParseFloat(expected, 64)
+	a, errA := strconv.ParseFloat(actual, 64)
+	if errE != nil || errA != nil {
+		return o.IgnoreCase && strings.EqualFold(expected, actual)
+	}
+	if o.Epsilon <= 0 || math.IsNaN(a) || math.IsInf(a, 0) {
+		return false
+	}
+	return math.Abs(e-a) <= o.Epsilon*math.Max(1, math.Abs(e))
+}
+
+// byteCounter tells if anything was read through it.
+type byteCounter struct {
+	r io.Reader
+	n int64
+}
+
+func (c *byteCounter) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	c.n += int64(n)
+	return n, err
+}
+
+// binaryChunk is how many bytes are read from each side at once, and the most reported in hex on mismatch.
+const binaryChunk = 4096
+
+func hexBytes(b []byte) string {
+	if len(b) > MAX_REPORTED_TOKEN/2 {
+		return fmt.Sprintf("%x...", b[:MAX_REPORTED_TOKEN/2])
+	}
+	return fmt.Sprintf("%x", b)
+}
+
+func binaryStreams(output, answer io.Reader, opts Options) (*Result, error) {
+	out, ans := make([]byte, binaryChunk), make([]byte, binaryChunk)
+	var offset int64
+	for {
+		na, err := io.ReadFull(answer, ans)
+		ansDone := err == io.EOF || err == io.ErrUnexpectedEOF
+		if err != nil && !ansDone {
+			return nil, fmt.Errorf("reading answer: %w", err)
+		}
+		no, err := io.ReadFull(output, out)
+		outDone := err == io.EOF || err == io.ErrUnexpectedEOF
+		if err != nil && !outDone {
+			return nil, fmt.Errorf("reading output: %w", err)
+		}
+		empty := offset == 0 && no == 0
+
+		i := 0
+		for i < na && i < no && ans[i] == out[i] {
+			i++
+		}
+		pos := offset + int64(i) + 1
+		switch {
+		case empty && opts.RejectEmptyOutput:
+			return &Result{EmptyOutput: true, Token: pos, Expected: hexBytes(ans[:na]), Message: "empty output"}, nil
+		case i < na && i < no:
+			r := &Result{Token: pos, Expected: hexBytes(ans[i:na]), Actual: hexBytes(out[i:no]), EmptyOutput: empty}
+			r.Message = fmt.Sprintf("byte %d differs - expected: %#02x, found: %#02x", pos, ans[i], out[i])
+			return r, nil
+		case i < na:
+			return &Result{Token: pos, Expected: hexBytes(ans[i:na]), EmptyOutput: empty,
+				Message: fmt.Sprintf("output ended at byte %d", pos)}, nil
+		case i < no:
+			return &Result{Token: pos, Actual: hexBytes(out[i:no]), EmptyOutput: empty,
+				Message: fmt.Sprintf("extra byte %d in output", pos)}, nil
+		case ansDone || outDone:
+			// Both ended at the same offset, ReadFull reads whole chunks otherwise.
+			return &Result{Equal: true, Token: offset + int64(na), EmptyOutput: empty,
+				Message: fmt.Sprintf("%d bytes", offset+int64(na))}, nil
+		}
+		offset += int64(na)
+	}
+}
+
+// Streams compares output against answer token by token, reading both in lockstep, so memory use doesn't depend on
+// their size. It stops at the first mismatch, leaving the rest of output unread.
+func Streams(output, answer io.Reader, opts Options) (*Result, error) {
+	if opts.Binary {
+		return binaryStreams(output, answer, opts)
+	}
+	counter := &byteCounter{r: output}
+	out, ans := newScanner(counter), newScanner(answer)
+	var outBefore, ansBefore context
+	mismatch := func(r *Result) *Result {
+		r.EmptyOutput = counter.n == 0
+		r.ExpectedBefore, r.ActualBefore = ansBefore, outBefore
+		r.ExpectedAfter, r.ActualAfter = tokensAfter(ans), tokensAfter(out)
+		return r
+	}
+	var n int64
+	for {
+		n++
+		haveAns := ans.Scan()
+		if err := ans.Err(); err != nil {
+			return nil, fmt.Errorf("reading answer: %w", err)
+		}
+		haveOut := out.Scan()
+		if err := out.Err(); err != nil {
+			return nil, fmt.Errorf("reading output: %w", err)
+		}
+
+		switch {
+		case !haveAns && !haveOut:
+			if counter.n == 0 && opts.RejectEmptyOutput {
+				return &Result{EmptyOutput: true, Message: "empty output"}, nil
+			}
+			return &Result{Equal: true, Token: n - 1, EmptyOutput: counter.n == 0, Message: fmt.Sprintf("%d tokens", n-1)}, nil
+		case !haveAns:
+			return mismatch(&Result{Token: n, Actual: shorten(out.Text()), ActualLine: out.line,
+				Message: fmt.Sprintf("extra token %d in output", n)}), nil
+		case !haveOut:
+			if counter.n == 0 && opts.RejectEmptyOutput {
+				return mismatch(&Result{Token: n, Expected: shorten(ans.Text()), ExpectedLine: ans.line, Message: "empty output"}), nil
+			}
+			return mismatch(&Result{Token: n, Expected: shorten(ans.Text()), ExpectedLine: ans.line,
+				Message: fmt.Sprintf("output ended at token %d", n)}), nil
+		}
+
+		if !opts.tokensEqual(ans.Text(), out.Text()) {
+			r := &Result{
+				Token:        n,
+				Expected:     shorten(ans.Text()),
+				Actual:       shorten(out.Text()),
+				ExpectedLine: ans.line,
+				ActualLine:   out.line,
+			}
+			r.Message = fmt.Sprintf("token %d differs - expected: %q, found: %q", n, r.Expected, r.Actual)
+			return mismatch(r), nil
+		}
+		ansBefore.push(ans.Text())
+		outBefore.push(out.Text())
+	}
+}
+
+// StreamsAny compares output against all answers at once, for problems with a few acceptable outputs. The result
+// is for the first answer matched, or, if none is, for the one matched the longest.
+func StreamsAny(output io.Reader, answers []io.Reader, opts Options) (*Result, error) {
+	if len(answers) == 1 {
+		r, err := Streams(output, answers[0], opts)
+		if r != nil {
+			r.Answer = 1
+		}
+		return r, err
+	}
+	results := make([]*Result, len(answers))
+	errs := make([]error, len(answers))
+	writers := make([]io.Writer, len(answers))
+	pipes := make([]*io.PipeWriter, len(answers))
+	var wg sync.WaitGroup
+	for i, answer := range answers {
+		pr, pw := io.Pipe()
+		writers[i], pipes[i] = pw, pw
+		wg.Add(1)
+		go func(i int, pr *io.PipeReader, answer io.Reader) {
+			defer wg.Done()
+			results[i], errs[i] = Streams(pr, answer, opts)
+			// The other comparisons still need the output.
+			io.Copy(io.Discard, pr)
+		}(i, pr, answer)
+	}
+	_, err := io.Copy(io.MultiWriter(writers...), output)
+	for _, pw := range pipes {
+		pw.CloseWithError(err)
+	}
+	wg.Wait()
+	if err != nil {
+		return nil, fmt.Errorf("reading output: %w", err)
+	}
+
+	var best *Result
+	for i, r := range results {
+		if errs[i] != nil {
+			return nil, fmt.Errorf("answer %d: %w", i+1, errs[i])
+		}
+		r.Answer = i + 1
+		if best == nil || (!best.Equal && (r.Equal || r.Token > best.Token)) {
+			best = r
+		}
+	}
+	return best, nil
+}
+
+func Files(outputName, answerName string, opts Options) (*Result, error) {
+	output, err := os.Open(outputName)
+	if err != nil {
+		return nil, err
+	}
+	defer output.Close()
+	answer, err := os.Open(answerName)
+	if err != nil {
+		return nil, err
+	}
+	defer answer.Close()
+	return Streams(bufio.NewReader(output), bufio.NewReader(answer), opts)
+}
+
+// FilesAny is StreamsAny for files.
+func FilesAny(outputName string, answerNames []string, opts Options) (*Result, error) {
+	output, err := os.Open(outputName)
+	if err != nil {
+		return nil, err
+	}
+	defer output.Close()
+	answers := make([]io.Reader, 0, len(answerNames))
+	for _, name := range answerNames {
+		answer, err := os.Open(name)
+		if err != nil {
+			return nil, err
+		}
+		defer answer.Close()
+		answers = append(answers, bufio.NewReader(answer))
+	}
+	return StreamsAny(bufio.NewReader(output), answers, opts)
+}
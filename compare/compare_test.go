@@ -0,0 +1,149 @@
+package compare
+
+import (
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestStreams(t *testing.T) {
+	cases := []struct {
+		output, answer string
+		opts           Options
+		equal          bool
+		token          int64
+	}{
+		{"1 2 3\n", "1 2 3", Options{}, true, 3},
+		{"1\n2\r\n  3", "1 2 3\n", Options{}, true, 3},
+		{"", "", Options{}, true, 0},
+		{"1 2 4", "1 2 3", Options{}, false, 3},
+		{"1 2", "1 2 3", Options{}, false, 3},
+		{"1 2 3 4", "1 2 3", Options{}, false, 4},
+		{"0.1000001", "0.1", Options{Epsilon: 1e-6}, true, 1},
+		{"0.1001", "0.1", Options{Epsilon: 1e-6}, false, 1},
+		{"1000000.5", "1000000", Options{Epsilon: 1e-6}, true, 1},
+		{"nan", "1", Options{Epsilon: 1e-6}, false, 1},
+		{"abc", "abd", Options{Epsilon: 1e-6}, false, 1},
+		{"yes", "YES", Options{}, false, 1},
+		{"yes\r\nNo", "YES no", Options{IgnoreCase: true}, true, 2},
+		{"1E5", "1e5", Options{IgnoreCase: true}, false, 1},
+		{"1E5", "1e5", Options{IgnoreCase: true, Epsilon: 1e-6}, true, 1},
+		{"INF", "inf", Options{IgnoreCase: true}, false, 1},
+	}
+	for _, c := range cases {
+		r, err := Streams(strings.NewReader(c.output), strings.NewReader(c.answer), c.opts)
+		if err != nil {
+			t.Fatalf("%q vs %q: %s", c.output, c.answer, err)
+		}
+		if r.Equal != c.equal || r.Token != c.token {
+			t.Errorf("%q vs %q: got %+v, want equal=%v token=%d", c.output, c.answer, r, c.equal, c.token)
+		}
+	}
+}
+
+func TestContext(t *testing.T) {
+	r, err := Streams(strings.NewReader("1 2\n3 4 5\n9 7 8 10 11"), strings.NewReader("1 2 3\n4\n5 6 7 8 10 11"), Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.Equal || r.Token != 6 || r.ActualLine != 3 || r.ExpectedLine != 3 {
+		t.Errorf("got %+v", r)
+	}
+	if !reflect.DeepEqual(r.ActualBefore, []string{"3", "4", "5"}) || !reflect.DeepEqual(r.ExpectedBefore, []string{"3", "4", "5"}) {
+		t.Errorf("before: got %q and %q", r.ExpectedBefore, r.ActualBefore)
+	}
+	if !reflect.DeepEqual(r.ActualAfter, []string{"7", "8", "10"}) || !reflect.DeepEqual(r.ExpectedAfter, []string{"7", "8", "10"}) {
+		t.Errorf("after: got %q and %q", r.ExpectedAfter, r.ActualAfter)
+	}
+
+	r, err = Streams(strings.NewReader("1\n2"), strings.NewReader("1\n2\n\n3 4"), Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.Token != 3 || r.ExpectedLine != 4 || r.ActualLine != 0 || len(r.ActualAfter) != 0 ||
+		!reflect.DeepEqual(r.ExpectedAfter, []string{"4"}) || !reflect.DeepEqual(r.ActualBefore, []string{"1", "2"}) {
+		t.Errorf("got %+v", r)
+	}
+}
+
+func TestEmptyOutput(t *testing.T) {
+	cases := []struct {
+		output, answer string
+		reject         bool
+		equal, empty   bool
+	}{
+		{"", "", false, true, true},
+		{"", "", true, false, true},
+		{"\n", "", true, true, false},
+		{"", "1", false, false, true},
+		{"", "1", true, false, true},
+	}
+	for _, c := range cases {
+		r, err := Streams(strings.NewReader(c.output), strings.NewReader(c.answer), Options{RejectEmptyOutput: c.reject})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if r.Equal != c.equal || r.EmptyOutput != c.empty {
+			t.Errorf("%q vs %q, reject=%v: got %+v", c.output, c.answer, c.reject, r)
+		}
+	}
+}
+
+func TestBinary(t *testing.T) {
+	long := strings.Repeat("\x00\xff", binaryChunk)
+	cases := []struct {
+		output, answer string
+		equal          bool
+		token          int64
+	}{
+		{"\x00\x01\n", "\x00\x01\n", true, 3},
+		{"", "", true, 0},
+		{"\x00\x01", "\x00\x01\n", false, 3},
+		{"\x00\x01\n\n", "\x00\x01\n", false, 4},
+		{"1 2", "1  2", false, 3},
+		{long, long, true, int64(len(long))},
+		{long + "x", long + "y", false, int64(len(long)) + 1},
+		{long, long + "y", false, int64(len(long)) + 1},
+	}
+	for _, c := range cases {
+		r, err := Streams(strings.NewReader(c.output), strings.NewReader(c.answer), Options{Binary: true})
+		if err != nil {
+			t.Fatalf("%q vs %q: %s", c.output, c.answer, err)
+		}
+		if r.Equal != c.equal || r.Token != c.token {
+			t.Errorf("%q vs %q: got %+v, want equal=%v token=%d", c.output, c.answer, r, c.equal, c.token)
+		}
+	}
+}
+
+func TestStreamsAny(t *testing.T) {
+	long := strings.Repeat("1 ", 100000)
+	cases := []struct {
+		output  string
+		answers []string
+		equal   bool
+		answer  int
+		token   int64
+	}{
+		{"YES", []string{"YES"}, true, 1, 1},
+		{"2 1", []string{"1 2", "2 1"}, true, 2, 2},
+		{"1 2", []string{"1 2", "1 2"}, true, 1, 2},
+		{"1 2 4", []string{"1 3 4", "1 2 3", "2"}, false, 2, 3},
+		{long, []string{"2", long}, true, 2, 100000},
+		{long, []string{"2", "1 2"}, false, 2, 2},
+	}
+	for _, c := range cases {
+		var answers []io.Reader
+		for _, a := range c.answers {
+			answers = append(answers, strings.NewReader(a))
+		}
+		r, err := StreamsAny(strings.NewReader(c.output), answers, Options{})
+		if err != nil {
+			t.Fatalf("%.20q vs %.20q: %s", c.output, c.answers, err)
+		}
+		if r.Equal != c.equal || r.Answer != c.answer || r.Token != c.token {
+			t.Errorf("%.20q vs %.20q: got equal=%v answer=%d token=%d, want %v, %d, %d", c.output, c.answers, r.Equal, r.Answer, r.Token, c.equal, c.answer, c.token)
+		}
+	}
+}
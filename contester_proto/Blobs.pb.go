@@ -71,6 +71,7 @@ type Blob struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
+	// Stored as is, so binary output survives the transport; JSON encodings of the messages carry it as base64.
 	Data        []byte                `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
 	Compression *Blob_CompressionInfo `protobuf:"bytes,2,opt,name=compression,proto3" json:"compression,omitempty"`
 	Sha1        []byte                `protobuf:"bytes,3,opt,name=sha1,proto3" json:"sha1,omitempty"`
@@ -30,6 +30,36 @@ type RedirectParameters struct {
 	Buffer                   *Blob  `protobuf:"bytes,3,opt,name=buffer,proto3" json:"buffer,omitempty"`
 	RemoteFilename           string `protobuf:"bytes,4,opt,name=remote_filename,json=remoteFilename,proto3" json:"remote_filename,omitempty"`
 	RemoteAuthorizationToken string `protobuf:"bytes,5,opt,name=remote_authorization_token,json=remoteAuthorizationToken,proto3" json:"remote_authorization_token,omitempty"`
+	// stdin only: return up to capture_size bytes of what was fed to the process.
+	CaptureSize uint64 `protobuf:"varint,6,opt,name=capture_size,json=captureSize,proto3" json:"capture_size,omitempty"`
+	// stdin only: close stdin after this many bytes, setting stdin_limit_hit if there was more.
+	MaxInputSize uint64 `protobuf:"varint,11,opt,name=max_input_size,json=maxInputSize,proto3" json:"max_input_size,omitempty"`
+	// stdout/stderr with memory: keep only the last tail_size bytes of output.
+	TailSize uint64 `protobuf:"varint,7,opt,name=tail_size,json=tailSize,proto3" json:"tail_size,omitempty"`
+	// stdout/stderr with memory: keep the first head_size and the last tail_size bytes, or lines with in_lines,
+	// and a "[... N bytes, M lines skipped ...]" line between them if anything was left out.
+	HeadSize uint64 `protobuf:"varint,15,opt,name=head_size,json=headSize,proto3" json:"head_size,omitempty"`
+	InLines  bool   `protobuf:"varint,16,opt,name=in_lines,json=inLines,proto3" json:"in_lines,omitempty"`
+	// stdout/stderr: return time and size of up to record_chunks reads of the output.
+	RecordChunks uint32 `protobuf:"varint,8,opt,name=record_chunks,json=recordChunks,proto3" json:"record_chunks,omitempty"`
+	// stdout only: compare output with this answer file while it's produced, instead of storing it; see
+	// mapped_filename for comparing big outputs kept in a file.
+	CompareAnswer  string          `protobuf:"bytes,9,opt,name=compare_answer,json=compareAnswer,proto3" json:"compare_answer,omitempty"`
+	CompareOptions *CompareOptions `protobuf:"bytes,10,opt,name=compare_options,json=compareOptions,proto3" json:"compare_options,omitempty"`
+	// stdout only: more acceptable answers besides compare_answer; output matching any of them is equal.
+	CompareAlternatives []string `protobuf:"bytes,14,rep,name=compare_alternatives,json=compareAlternatives,proto3" json:"compare_alternatives,omitempty"`
+	// stdout/stderr: connect the process to this named pipe (FIFO on Linux), which the caller serves and must be
+	// listening on before the run. Writes block until the caller reads them.
+	NamedPipe string `protobuf:"bytes,12,opt,name=named_pipe,json=namedPipe,proto3" json:"named_pipe,omitempty"`
+	// stdin only: feed these to the process one after another, as one input. Files are opened before the start;
+	// one failing to read later sets input_failed.
+	Sources []*InputSource `protobuf:"bytes,13,rep,name=sources,proto3" json:"sources,omitempty"`
+	// stdout/stderr: write output to this file through a memory mapping of up to mapped_size bytes (256 MiB by
+	// default), cutting it there, for big outputs compared on the host. If the mapping can't be created, the file
+	// is written as with filename, failing the run over mapped_size. With compare_answer, the output is kept in the
+	// file and compared once the process is done, reading it from the mapping (or back from the file, if unmapped).
+	MappedFilename string `protobuf:"bytes,17,opt,name=mapped_filename,json=mappedFilename,proto3" json:"mapped_filename,omitempty"`
+	MappedSize     uint64 `protobuf:"varint,18,opt,name=mapped_size,json=mappedSize,proto3" json:"mapped_size,omitempty"`
 }
 
 func (x *RedirectParameters) Reset() {
@@ -99,32 +129,282 @@ func (x *RedirectParameters) GetRemoteAuthorizationToken() string {
 	return ""
 }
 
+func (x *RedirectParameters) GetCaptureSize() uint64 {
+	if x != nil {
+		return x.CaptureSize
+	}
+	return 0
+}
+
+func (x *RedirectParameters) GetMaxInputSize() uint64 {
+	if x != nil {
+		return x.MaxInputSize
+	}
+	return 0
+}
+
+func (x *RedirectParameters) GetTailSize() uint64 {
+	if x != nil {
+		return x.TailSize
+	}
+	return 0
+}
+
+func (x *RedirectParameters) GetHeadSize() uint64 {
+	if x != nil {
+		return x.HeadSize
+	}
+	return 0
+}
+
+func (x *RedirectParameters) GetInLines() bool {
+	if x != nil {
+		return x.InLines
+	}
+	return false
+}
+
+func (x *RedirectParameters) GetRecordChunks() uint32 {
+	if x != nil {
+		return x.RecordChunks
+	}
+	return 0
+}
+
+func (x *RedirectParameters) GetCompareAnswer() string {
+	if x != nil {
+		return x.CompareAnswer
+	}
+	return ""
+}
+
+func (x *RedirectParameters) GetCompareOptions() *CompareOptions {
+	if x != nil {
+		return x.CompareOptions
+	}
+	return nil
+}
+
+func (x *RedirectParameters) GetCompareAlternatives() []string {
+	if x != nil {
+		return x.CompareAlternatives
+	}
+	return nil
+}
+
+func (x *RedirectParameters) GetNamedPipe() string {
+	if x != nil {
+		return x.NamedPipe
+	}
+	return ""
+}
+
+func (x *RedirectParameters) GetSources() []*InputSource {
+	if x != nil {
+		return x.Sources
+	}
+	return nil
+}
+
+func (x *RedirectParameters) GetMappedFilename() string {
+	if x != nil {
+		return x.MappedFilename
+	}
+	return ""
+}
+
+func (x *RedirectParameters) GetMappedSize() uint64 {
+	if x != nil {
+		return x.MappedSize
+	}
+	return 0
+}
+
+// A file, if filename is set, or data.
+type InputSource struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Filename string `protobuf:"bytes,1,opt,name=filename,proto3" json:"filename,omitempty"`
+	Data     *Blob  `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *InputSource) Reset() {
+	*x = InputSource{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_Execution_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InputSource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InputSource) ProtoMessage() {}
+
+func (x *InputSource) ProtoReflect() protoreflect.Message {
+	mi := &file_Execution_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InputSource.ProtoReflect.Descriptor instead.
+func (*InputSource) Descriptor() ([]byte, []int) {
+	return file_Execution_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *InputSource) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *InputSource) GetData() *Blob {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type CompareOptions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Epsilon float64 `protobuf:"fixed64,1,opt,name=epsilon,proto3" json:"epsilon,omitempty"`
+	// Zero-byte output is a mismatch, even for an empty answer; by default empty output is compared as usual.
+	RejectEmptyOutput bool `protobuf:"varint,2,opt,name=reject_empty_output,json=rejectEmptyOutput,proto3" json:"reject_empty_output,omitempty"`
+	// Byte-exact comparison, for binary output; mismatching bytes are reported in hex.
+	Binary bool `protobuf:"varint,3,opt,name=binary,proto3" json:"binary,omitempty"`
+	// Tokens are equal regardless of case, unless both are numbers.
+	IgnoreCase bool `protobuf:"varint,4,opt,name=ignore_case,json=ignoreCase,proto3" json:"ignore_case,omitempty"`
+}
+
+func (x *CompareOptions) Reset() {
+	*x = CompareOptions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_Execution_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CompareOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompareOptions) ProtoMessage() {}
+
+func (x *CompareOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_Execution_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompareOptions.ProtoReflect.Descriptor instead.
+func (*CompareOptions) Descriptor() ([]byte, []int) {
+	return file_Execution_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CompareOptions) GetEpsilon() float64 {
+	if x != nil {
+		return x.Epsilon
+	}
+	return 0
+}
+
+func (x *CompareOptions) GetRejectEmptyOutput() bool {
+	if x != nil {
+		return x.RejectEmptyOutput
+	}
+	return false
+}
+
+func (x *CompareOptions) GetBinary() bool {
+	if x != nil {
+		return x.Binary
+	}
+	return false
+}
+
+func (x *CompareOptions) GetIgnoreCase() bool {
+	if x != nil {
+		return x.IgnoreCase
+	}
+	return false
+}
+
 type ExecutionResultFlags struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Killed                 bool `protobuf:"varint,1,opt,name=killed,proto3" json:"killed,omitempty"`
-	TimeLimitHit           bool `protobuf:"varint,2,opt,name=time_limit_hit,json=timeLimitHit,proto3" json:"time_limit_hit,omitempty"`
-	MemoryLimitHit         bool `protobuf:"varint,3,opt,name=memory_limit_hit,json=memoryLimitHit,proto3" json:"memory_limit_hit,omitempty"`
-	Inactive               bool `protobuf:"varint,4,opt,name=inactive,proto3" json:"inactive,omitempty"`
-	StdoutOverflow         bool `protobuf:"varint,6,opt,name=stdout_overflow,json=stdoutOverflow,proto3" json:"stdout_overflow,omitempty"`
-	StderrOverflow         bool `protobuf:"varint,7,opt,name=stderr_overflow,json=stderrOverflow,proto3" json:"stderr_overflow,omitempty"`
-	StdpipeTimeout         bool `protobuf:"varint,8,opt,name=stdpipe_timeout,json=stdpipeTimeout,proto3" json:"stdpipe_timeout,omitempty"`
-	TimeLimitHitPost       bool `protobuf:"varint,9,opt,name=time_limit_hit_post,json=timeLimitHitPost,proto3" json:"time_limit_hit_post,omitempty"`
-	MemoryLimitHitPost     bool `protobuf:"varint,10,opt,name=memory_limit_hit_post,json=memoryLimitHitPost,proto3" json:"memory_limit_hit_post,omitempty"`
-	ProcessLimitHit        bool `protobuf:"varint,11,opt,name=process_limit_hit,json=processLimitHit,proto3" json:"process_limit_hit,omitempty"`
-	StoppedBySignal        bool `protobuf:"varint,12,opt,name=stopped_by_signal,json=stoppedBySignal,proto3" json:"stopped_by_signal,omitempty"` // linux: SIGSTOP/PTRACE
-	KilledBySignal         bool `protobuf:"varint,13,opt,name=killed_by_signal,json=killedBySignal,proto3" json:"killed_by_signal,omitempty"`    // linux: WTERMSIG
-	KernelTimeLimitHit     bool `protobuf:"varint,14,opt,name=kernel_time_limit_hit,json=kernelTimeLimitHit,proto3" json:"kernel_time_limit_hit,omitempty"`
-	KernelTimeLimitHitPost bool `protobuf:"varint,15,opt,name=kernel_time_limit_hit_post,json=kernelTimeLimitHitPost,proto3" json:"kernel_time_limit_hit_post,omitempty"`
-	WallTimeLimitHit       bool `protobuf:"varint,16,opt,name=wall_time_limit_hit,json=wallTimeLimitHit,proto3" json:"wall_time_limit_hit,omitempty"`
+	Killed                    bool `protobuf:"varint,1,opt,name=killed,proto3" json:"killed,omitempty"`
+	TimeLimitHit              bool `protobuf:"varint,2,opt,name=time_limit_hit,json=timeLimitHit,proto3" json:"time_limit_hit,omitempty"`
+	MemoryLimitHit            bool `protobuf:"varint,3,opt,name=memory_limit_hit,json=memoryLimitHit,proto3" json:"memory_limit_hit,omitempty"`
+	Inactive                  bool `protobuf:"varint,4,opt,name=inactive,proto3" json:"inactive,omitempty"`
+	StdoutOverflow            bool `protobuf:"varint,6,opt,name=stdout_overflow,json=stdoutOverflow,proto3" json:"stdout_overflow,omitempty"`
+	StderrOverflow            bool `protobuf:"varint,7,opt,name=stderr_overflow,json=stderrOverflow,proto3" json:"stderr_overflow,omitempty"`
+	StdpipeTimeout            bool `protobuf:"varint,8,opt,name=stdpipe_timeout,json=stdpipeTimeout,proto3" json:"stdpipe_timeout,omitempty"`
+	TimeLimitHitPost          bool `protobuf:"varint,9,opt,name=time_limit_hit_post,json=timeLimitHitPost,proto3" json:"time_limit_hit_post,omitempty"`
+	MemoryLimitHitPost        bool `protobuf:"varint,10,opt,name=memory_limit_hit_post,json=memoryLimitHitPost,proto3" json:"memory_limit_hit_post,omitempty"`
+	ProcessLimitHit           bool `protobuf:"varint,11,opt,name=process_limit_hit,json=processLimitHit,proto3" json:"process_limit_hit,omitempty"`
+	StoppedBySignal           bool `protobuf:"varint,12,opt,name=stopped_by_signal,json=stoppedBySignal,proto3" json:"stopped_by_signal,omitempty"` // linux: SIGSTOP/PTRACE
+	KilledBySignal            bool `protobuf:"varint,13,opt,name=killed_by_signal,json=killedBySignal,proto3" json:"killed_by_signal,omitempty"`    // linux: WTERMSIG
+	KernelTimeLimitHit        bool `protobuf:"varint,14,opt,name=kernel_time_limit_hit,json=kernelTimeLimitHit,proto3" json:"kernel_time_limit_hit,omitempty"`
+	KernelTimeLimitHitPost    bool `protobuf:"varint,15,opt,name=kernel_time_limit_hit_post,json=kernelTimeLimitHitPost,proto3" json:"kernel_time_limit_hit_post,omitempty"`
+	WallTimeLimitHit          bool `protobuf:"varint,16,opt,name=wall_time_limit_hit,json=wallTimeLimitHit,proto3" json:"wall_time_limit_hit,omitempty"`
+	VirtualMemoryLimitHit     bool `protobuf:"varint,17,opt,name=virtual_memory_limit_hit,json=virtualMemoryLimitHit,proto3" json:"virtual_memory_limit_hit,omitempty"`
+	VirtualMemoryLimitHitPost bool `protobuf:"varint,18,opt,name=virtual_memory_limit_hit_post,json=virtualMemoryLimitHitPost,proto3" json:"virtual_memory_limit_hit_post,omitempty"`
+	ArtifactSizeLimitHit      bool `protobuf:"varint,19,opt,name=artifact_size_limit_hit,json=artifactSizeLimitHit,proto3" json:"artifact_size_limit_hit,omitempty"`
+	ArtifactSizeLimitHitPost  bool `protobuf:"varint,20,opt,name=artifact_size_limit_hit_post,json=artifactSizeLimitHitPost,proto3" json:"artifact_size_limit_hit_post,omitempty"`
+	// Stopped because the group it ran in was stopped, see LocalRunCommunication.
+	Aborted bool `protobuf:"varint,21,opt,name=aborted,proto3" json:"aborted,omitempty"`
+	// No process ran, see LocalExecutionResult.launch_error.
+	LaunchFailed bool `protobuf:"varint,22,opt,name=launch_failed,json=launchFailed,proto3" json:"launch_failed,omitempty"`
+	// See LocalExecutionParameters.deny_registry.
+	RegistryAccessDenied bool `protobuf:"varint,23,opt,name=registry_access_denied,json=registryAccessDenied,proto3" json:"registry_access_denied,omitempty"`
+	// The service lost track of the run and killed it; retry it, the result isn't the solution's fault.
+	JudgeStalled bool `protobuf:"varint,24,opt,name=judge_stalled,json=judgeStalled,proto3" json:"judge_stalled,omitempty"`
+	// Created more processes than LocalExecutionParameters.total_process_limit.
+	TotalProcessLimitHit bool `protobuf:"varint,25,opt,name=total_process_limit_hit,json=totalProcessLimitHit,proto3" json:"total_process_limit_hit,omitempty"`
+	// Had more handles open than LocalExecutionParameters.handle_limit.
+	HandleLimitHit bool `protobuf:"varint,26,opt,name=handle_limit_hit,json=handleLimitHit,proto3" json:"handle_limit_hit,omitempty"`
+	// A RedirectParameters.sources file failed to read midway, so the input was truncated; see
+	// LocalExecutionResult.input_error. Retry the run.
+	InputFailed bool `protobuf:"varint,27,opt,name=input_failed,json=inputFailed,proto3" json:"input_failed,omitempty"`
+	// Had more threads than LocalExecutionParameters.max_threads.
+	Multithreaded bool `protobuf:"varint,28,opt,name=multithreaded,proto3" json:"multithreaded,omitempty"`
+	// See LocalExecutionParameters.start_probe_micros; the reason says what's missing.
+	CannotStart bool `protobuf:"varint,29,opt,name=cannot_start,json=cannotStart,proto3" json:"cannot_start,omitempty"`
+	// Stopped reading stdin with input pending, see LocalExecutionParameters.input_stall_timeout_micros.
+	InputStalled bool `protobuf:"varint,30,opt,name=input_stalled,json=inputStalled,proto3" json:"input_stalled,omitempty"`
+	// See LocalExecutionParameters.max_wall_to_cpu_ratio.
+	WallToCpuRatioPost bool `protobuf:"varint,31,opt,name=wall_to_cpu_ratio_post,json=wallToCpuRatioPost,proto3" json:"wall_to_cpu_ratio_post,omitempty"`
+	// Opened more distinct files than LocalExecutionParameters.distinct_file_limit.
+	DistinctFileLimitHit bool `protobuf:"varint,32,opt,name=distinct_file_limit_hit,json=distinctFileLimitHit,proto3" json:"distinct_file_limit_hit,omitempty"`
 }
 
 func (x *ExecutionResultFlags) Reset() {
 	*x = ExecutionResultFlags{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_Execution_proto_msgTypes[1]
+		mi := &file_Execution_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -137,7 +417,7 @@ func (x *ExecutionResultFlags) String() string {
 func (*ExecutionResultFlags) ProtoMessage() {}
 
 func (x *ExecutionResultFlags) ProtoReflect() protoreflect.Message {
-	mi := &file_Execution_proto_msgTypes[1]
+	mi := &file_Execution_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -150,7 +430,7 @@ func (x *ExecutionResultFlags) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExecutionResultFlags.ProtoReflect.Descriptor instead.
 func (*ExecutionResultFlags) Descriptor() ([]byte, []int) {
-	return file_Execution_proto_rawDescGZIP(), []int{1}
+	return file_Execution_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *ExecutionResultFlags) GetKilled() bool {
@@ -258,6 +538,118 @@ func (x *ExecutionResultFlags) GetWallTimeLimitHit() bool {
 	return false
 }
 
+func (x *ExecutionResultFlags) GetVirtualMemoryLimitHit() bool {
+	if x != nil {
+		return x.VirtualMemoryLimitHit
+	}
+	return false
+}
+
+func (x *ExecutionResultFlags) GetVirtualMemoryLimitHitPost() bool {
+	if x != nil {
+		return x.VirtualMemoryLimitHitPost
+	}
+	return false
+}
+
+func (x *ExecutionResultFlags) GetArtifactSizeLimitHit() bool {
+	if x != nil {
+		return x.ArtifactSizeLimitHit
+	}
+	return false
+}
+
+func (x *ExecutionResultFlags) GetArtifactSizeLimitHitPost() bool {
+	if x != nil {
+		return x.ArtifactSizeLimitHitPost
+	}
+	return false
+}
+
+func (x *ExecutionResultFlags) GetAborted() bool {
+	if x != nil {
+		return x.Aborted
+	}
+	return false
+}
+
+func (x *ExecutionResultFlags) GetLaunchFailed() bool {
+	if x != nil {
+		return x.LaunchFailed
+	}
+	return false
+}
+
+func (x *ExecutionResultFlags) GetRegistryAccessDenied() bool {
+	if x != nil {
+		return x.RegistryAccessDenied
+	}
+	return false
+}
+
+func (x *ExecutionResultFlags) GetJudgeStalled() bool {
+	if x != nil {
+		return x.JudgeStalled
+	}
+	return false
+}
+
+func (x *ExecutionResultFlags) GetTotalProcessLimitHit() bool {
+	if x != nil {
+		return x.TotalProcessLimitHit
+	}
+	return false
+}
+
+func (x *ExecutionResultFlags) GetHandleLimitHit() bool {
+	if x != nil {
+		return x.HandleLimitHit
+	}
+	return false
+}
+
+func (x *ExecutionResultFlags) GetInputFailed() bool {
+	if x != nil {
+		return x.InputFailed
+	}
+	return false
+}
+
+func (x *ExecutionResultFlags) GetMultithreaded() bool {
+	if x != nil {
+		return x.Multithreaded
+	}
+	return false
+}
+
+func (x *ExecutionResultFlags) GetCannotStart() bool {
+	if x != nil {
+		return x.CannotStart
+	}
+	return false
+}
+
+func (x *ExecutionResultFlags) GetInputStalled() bool {
+	if x != nil {
+		return x.InputStalled
+	}
+	return false
+}
+
+func (x *ExecutionResultFlags) GetWallToCpuRatioPost() bool {
+	if x != nil {
+		return x.WallToCpuRatioPost
+	}
+	return false
+}
+
+func (x *ExecutionResultFlags) GetDistinctFileLimitHit() bool {
+	if x != nil {
+		return x.DistinctFileLimitHit
+	}
+	return false
+}
+
 type ExecutionResultTime struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -271,7 +663,7 @@ type ExecutionResultTime struct {
 func (x *ExecutionResultTime) Reset() {
 	*x = ExecutionResultTime{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_Execution_proto_msgTypes[2]
+		mi := &file_Execution_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -284,7 +676,7 @@ func (x *ExecutionResultTime) String() string {
 func (*ExecutionResultTime) ProtoMessage() {}
 
 func (x *ExecutionResultTime) ProtoReflect() protoreflect.Message {
-	mi := &file_Execution_proto_msgTypes[2]
+	mi := &file_Execution_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -297,7 +689,7 @@ func (x *ExecutionResultTime) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExecutionResultTime.ProtoReflect.Descriptor instead.
 func (*ExecutionResultTime) Descriptor() ([]byte, []int) {
-	return file_Execution_proto_rawDescGZIP(), []int{2}
+	return file_Execution_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *ExecutionResultTime) GetUserTimeMicros() uint64 {
@@ -327,7 +719,7 @@ var file_Execution_proto_rawDesc = []byte{
 	0x0a, 0x0f, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74,
 	0x6f, 0x12, 0x0f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x1a, 0x0b, 0x42, 0x6c, 0x6f, 0x62, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
-	0xde, 0x01, 0x0a, 0x12, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x50, 0x61, 0x72, 0x61,
+	0xe6, 0x05, 0x0a, 0x12, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x50, 0x61, 0x72, 0x61,
 	0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61,
 	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61,
 	0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01,
@@ -341,63 +733,157 @@ var file_Execution_proto_rawDesc = []byte{
 	0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
 	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x18, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x41, 0x75,
 	0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
-	0x22, 0x97, 0x05, 0x0a, 0x14, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
-	0x73, 0x75, 0x6c, 0x74, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6b, 0x69, 0x6c,
-	0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x6b, 0x69, 0x6c, 0x6c, 0x65,
-	0x64, 0x12, 0x24, 0x0a, 0x0e, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x5f,
-	0x68, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x74, 0x69, 0x6d, 0x65, 0x4c,
-	0x69, 0x6d, 0x69, 0x74, 0x48, 0x69, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x6d, 0x65, 0x6d, 0x6f, 0x72,
-	0x79, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x5f, 0x68, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x0e, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x48, 0x69,
-	0x74, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x6e, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x27, 0x0a,
-	0x0f, 0x73, 0x74, 0x64, 0x6f, 0x75, 0x74, 0x5f, 0x6f, 0x76, 0x65, 0x72, 0x66, 0x6c, 0x6f, 0x77,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x73, 0x74, 0x64, 0x6f, 0x75, 0x74, 0x4f, 0x76,
-	0x65, 0x72, 0x66, 0x6c, 0x6f, 0x77, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x74, 0x64, 0x65, 0x72, 0x72,
-	0x5f, 0x6f, 0x76, 0x65, 0x72, 0x66, 0x6c, 0x6f, 0x77, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x0e, 0x73, 0x74, 0x64, 0x65, 0x72, 0x72, 0x4f, 0x76, 0x65, 0x72, 0x66, 0x6c, 0x6f, 0x77, 0x12,
-	0x27, 0x0a, 0x0f, 0x73, 0x74, 0x64, 0x70, 0x69, 0x70, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f,
-	0x75, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x73, 0x74, 0x64, 0x70, 0x69, 0x70,
-	0x65, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x2d, 0x0a, 0x13, 0x74, 0x69, 0x6d, 0x65,
-	0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x5f, 0x68, 0x69, 0x74, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x18,
-	0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x74, 0x69, 0x6d, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74,
-	0x48, 0x69, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x31, 0x0a, 0x15, 0x6d, 0x65, 0x6d, 0x6f, 0x72,
-	0x79, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x5f, 0x68, 0x69, 0x74, 0x5f, 0x70, 0x6f, 0x73, 0x74,
-	0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4c, 0x69,
-	0x6d, 0x69, 0x74, 0x48, 0x69, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x70, 0x72,
-	0x6f, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x5f, 0x68, 0x69, 0x74, 0x18,
-	0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x4c, 0x69,
-	0x6d, 0x69, 0x74, 0x48, 0x69, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x73, 0x74, 0x6f, 0x70, 0x70, 0x65,
-	0x64, 0x5f, 0x62, 0x79, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x18, 0x0c, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x0f, 0x73, 0x74, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x42, 0x79, 0x53, 0x69, 0x67, 0x6e,
-	0x61, 0x6c, 0x12, 0x28, 0x0a, 0x10, 0x6b, 0x69, 0x6c, 0x6c, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x5f,
-	0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x6b, 0x69,
-	0x6c, 0x6c, 0x65, 0x64, 0x42, 0x79, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x12, 0x31, 0x0a, 0x15,
-	0x6b, 0x65, 0x72, 0x6e, 0x65, 0x6c, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69,
-	0x74, 0x5f, 0x68, 0x69, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x6b, 0x65, 0x72,
-	0x6e, 0x65, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x48, 0x69, 0x74, 0x12,
-	0x3a, 0x0a, 0x1a, 0x6b, 0x65, 0x72, 0x6e, 0x65, 0x6c, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6c,
-	0x69, 0x6d, 0x69, 0x74, 0x5f, 0x68, 0x69, 0x74, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x18, 0x0f, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x16, 0x6b, 0x65, 0x72, 0x6e, 0x65, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x4c,
-	0x69, 0x6d, 0x69, 0x74, 0x48, 0x69, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x2d, 0x0a, 0x13, 0x77,
-	0x61, 0x6c, 0x6c, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x5f, 0x68,
-	0x69, 0x74, 0x18, 0x10, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x77, 0x61, 0x6c, 0x6c, 0x54, 0x69,
-	0x6d, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x48, 0x69, 0x74, 0x22, 0x97, 0x01, 0x0a, 0x13, 0x45,
-	0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x54, 0x69,
-	0x6d, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f,
-	0x6d, 0x69, 0x63, 0x72, 0x6f, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x75, 0x73,
-	0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x4d, 0x69, 0x63, 0x72, 0x6f, 0x73, 0x12, 0x2c, 0x0a, 0x12,
-	0x6b, 0x65, 0x72, 0x6e, 0x65, 0x6c, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6d, 0x69, 0x63, 0x72,
-	0x6f, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x6b, 0x65, 0x72, 0x6e, 0x65, 0x6c,
-	0x54, 0x69, 0x6d, 0x65, 0x4d, 0x69, 0x63, 0x72, 0x6f, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x77, 0x61,
-	0x6c, 0x6c, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6d, 0x69, 0x63, 0x72, 0x6f, 0x73, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x77, 0x61, 0x6c, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x4d, 0x69,
-	0x63, 0x72, 0x6f, 0x73, 0x42, 0x4b, 0x0a, 0x1c, 0x6f, 0x72, 0x67, 0x2e, 0x73, 0x74, 0x69, 0x6e,
-	0x67, 0x72, 0x61, 0x79, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x5a, 0x2b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
-	0x2f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x2f, 0x72, 0x75, 0x6e, 0x6c, 0x69,
-	0x62, 0x2f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x12, 0x21, 0x0a, 0x0c, 0x63, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x63, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x53,
+	0x69, 0x7a, 0x65, 0x12, 0x24, 0x0a, 0x0e, 0x6d, 0x61, 0x78, 0x5f, 0x69, 0x6e, 0x70, 0x75, 0x74,
+	0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x6d, 0x61, 0x78,
+	0x49, 0x6e, 0x70, 0x75, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x61, 0x69,
+	0x6c, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x74, 0x61,
+	0x69, 0x6c, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x68, 0x65, 0x61, 0x64, 0x5f, 0x73,
+	0x69, 0x7a, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x68, 0x65, 0x61, 0x64, 0x53,
+	0x69, 0x7a, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x69, 0x6e, 0x5f, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x18,
+	0x10, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x69, 0x6e, 0x4c, 0x69, 0x6e, 0x65, 0x73, 0x12, 0x23,
+	0x0a, 0x0d, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x5f, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x73, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x43, 0x68, 0x75,
+	0x6e, 0x6b, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x5f, 0x61,
+	0x6e, 0x73, 0x77, 0x65, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x6d,
+	0x70, 0x61, 0x72, 0x65, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x12, 0x48, 0x0a, 0x0f, 0x63, 0x6f,
+	0x6d, 0x70, 0x61, 0x72, 0x65, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x4f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0e, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x4f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x31, 0x0a, 0x14, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x5f,
+	0x61, 0x6c, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x73, 0x18, 0x0e, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x13, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x41, 0x6c, 0x74, 0x65, 0x72,
+	0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x61, 0x6d, 0x65, 0x64,
+	0x5f, 0x70, 0x69, 0x70, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d,
+	0x65, 0x64, 0x50, 0x69, 0x70, 0x65, 0x12, 0x36, 0x0a, 0x07, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x73,
+	0x74, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x53,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x07, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x27,
+	0x0a, 0x0f, 0x6d, 0x61, 0x70, 0x70, 0x65, 0x64, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6d, 0x61, 0x70, 0x70, 0x65, 0x64, 0x46,
+	0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x70, 0x70, 0x65,
+	0x64, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x12, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x6d, 0x61,
+	0x70, 0x70, 0x65, 0x64, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x54, 0x0a, 0x0b, 0x49, 0x6e, 0x70, 0x75,
+	0x74, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x29, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x15, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x6c, 0x6f, 0x62, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x93,
+	0x01, 0x0a, 0x0e, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x70, 0x73, 0x69, 0x6c, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x07, 0x65, 0x70, 0x73, 0x69, 0x6c, 0x6f, 0x6e, 0x12, 0x2e, 0x0a, 0x13, 0x72,
+	0x65, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x5f, 0x6f, 0x75, 0x74, 0x70,
+	0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x62,
+	0x69, 0x6e, 0x61, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x62, 0x69, 0x6e,
+	0x61, 0x72, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x5f, 0x63, 0x61,
+	0x73, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65,
+	0x43, 0x61, 0x73, 0x65, 0x22, 0x80, 0x0b, 0x0a, 0x14, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x16, 0x0a,
+	0x06, 0x6b, 0x69, 0x6c, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x6b,
+	0x69, 0x6c, 0x6c, 0x65, 0x64, 0x12, 0x24, 0x0a, 0x0e, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x5f, 0x68, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x74,
+	0x69, 0x6d, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x48, 0x69, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x6d,
+	0x65, 0x6d, 0x6f, 0x72, 0x79, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x5f, 0x68, 0x69, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4c, 0x69, 0x6d,
+	0x69, 0x74, 0x48, 0x69, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x61, 0x63, 0x74, 0x69, 0x76,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x6e, 0x61, 0x63, 0x74, 0x69, 0x76,
+	0x65, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x74, 0x64, 0x6f, 0x75, 0x74, 0x5f, 0x6f, 0x76, 0x65, 0x72,
+	0x66, 0x6c, 0x6f, 0x77, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x73, 0x74, 0x64, 0x6f,
+	0x75, 0x74, 0x4f, 0x76, 0x65, 0x72, 0x66, 0x6c, 0x6f, 0x77, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x74,
+	0x64, 0x65, 0x72, 0x72, 0x5f, 0x6f, 0x76, 0x65, 0x72, 0x66, 0x6c, 0x6f, 0x77, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0e, 0x73, 0x74, 0x64, 0x65, 0x72, 0x72, 0x4f, 0x76, 0x65, 0x72, 0x66,
+	0x6c, 0x6f, 0x77, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x74, 0x64, 0x70, 0x69, 0x70, 0x65, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x73, 0x74,
+	0x64, 0x70, 0x69, 0x70, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x2d, 0x0a, 0x13,
+	0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x5f, 0x68, 0x69, 0x74, 0x5f, 0x70,
+	0x6f, 0x73, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x74, 0x69, 0x6d, 0x65, 0x4c,
+	0x69, 0x6d, 0x69, 0x74, 0x48, 0x69, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x31, 0x0a, 0x15, 0x6d,
+	0x65, 0x6d, 0x6f, 0x72, 0x79, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x5f, 0x68, 0x69, 0x74, 0x5f,
+	0x70, 0x6f, 0x73, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x6d, 0x65, 0x6d, 0x6f,
+	0x72, 0x79, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x48, 0x69, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x2a,
+	0x0a, 0x11, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x5f,
+	0x68, 0x69, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x70, 0x72, 0x6f, 0x63, 0x65,
+	0x73, 0x73, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x48, 0x69, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x73, 0x74,
+	0x6f, 0x70, 0x70, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x73, 0x74, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x42, 0x79,
+	0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x12, 0x28, 0x0a, 0x10, 0x6b, 0x69, 0x6c, 0x6c, 0x65, 0x64,
+	0x5f, 0x62, 0x79, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0e, 0x6b, 0x69, 0x6c, 0x6c, 0x65, 0x64, 0x42, 0x79, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c,
+	0x12, 0x31, 0x0a, 0x15, 0x6b, 0x65, 0x72, 0x6e, 0x65, 0x6c, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x5f, 0x68, 0x69, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x12, 0x6b, 0x65, 0x72, 0x6e, 0x65, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74,
+	0x48, 0x69, 0x74, 0x12, 0x3a, 0x0a, 0x1a, 0x6b, 0x65, 0x72, 0x6e, 0x65, 0x6c, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x5f, 0x68, 0x69, 0x74, 0x5f, 0x70, 0x6f, 0x73,
+	0x74, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x08, 0x52, 0x16, 0x6b, 0x65, 0x72, 0x6e, 0x65, 0x6c, 0x54,
+	0x69, 0x6d, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x48, 0x69, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x12,
+	0x2d, 0x0a, 0x13, 0x77, 0x61, 0x6c, 0x6c, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x5f, 0x68, 0x69, 0x74, 0x18, 0x10, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x77, 0x61,
+	0x6c, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x48, 0x69, 0x74, 0x12, 0x37,
+	0x0a, 0x18, 0x76, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x5f, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79,
+	0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x5f, 0x68, 0x69, 0x74, 0x18, 0x11, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x15, 0x76, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4c,
+	0x69, 0x6d, 0x69, 0x74, 0x48, 0x69, 0x74, 0x12, 0x40, 0x0a, 0x1d, 0x76, 0x69, 0x72, 0x74, 0x75,
+	0x61, 0x6c, 0x5f, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x5f,
+	0x68, 0x69, 0x74, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x18, 0x12, 0x20, 0x01, 0x28, 0x08, 0x52, 0x19,
+	0x76, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4c, 0x69, 0x6d,
+	0x69, 0x74, 0x48, 0x69, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x35, 0x0a, 0x17, 0x61, 0x72, 0x74,
+	0x69, 0x66, 0x61, 0x63, 0x74, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x5f, 0x68, 0x69, 0x74, 0x18, 0x13, 0x20, 0x01, 0x28, 0x08, 0x52, 0x14, 0x61, 0x72, 0x74, 0x69,
+	0x66, 0x61, 0x63, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x48, 0x69, 0x74,
+	0x12, 0x3e, 0x0a, 0x1c, 0x61, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x5f, 0x73, 0x69, 0x7a,
+	0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x5f, 0x68, 0x69, 0x74, 0x5f, 0x70, 0x6f, 0x73, 0x74,
+	0x18, 0x14, 0x20, 0x01, 0x28, 0x08, 0x52, 0x18, 0x61, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74,
+	0x53, 0x69, 0x7a, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x48, 0x69, 0x74, 0x50, 0x6f, 0x73, 0x74,
+	0x12, 0x18, 0x0a, 0x07, 0x61, 0x62, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x18, 0x15, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x07, 0x61, 0x62, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x6c, 0x61,
+	0x75, 0x6e, 0x63, 0x68, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x18, 0x16, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0c, 0x6c, 0x61, 0x75, 0x6e, 0x63, 0x68, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x12,
+	0x34, 0x0a, 0x16, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x5f, 0x61, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x5f, 0x64, 0x65, 0x6e, 0x69, 0x65, 0x64, 0x18, 0x17, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x14, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x44,
+	0x65, 0x6e, 0x69, 0x65, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x6a, 0x75, 0x64, 0x67, 0x65, 0x5f, 0x73,
+	0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x18, 0x18, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x6a, 0x75,
+	0x64, 0x67, 0x65, 0x53, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x12, 0x35, 0x0a, 0x17, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x5f, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x5f, 0x68, 0x69, 0x74, 0x18, 0x19, 0x20, 0x01, 0x28, 0x08, 0x52, 0x14, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x48, 0x69,
+	0x74, 0x12, 0x28, 0x0a, 0x10, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x5f, 0x68, 0x69, 0x74, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x68, 0x61, 0x6e,
+	0x64, 0x6c, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x48, 0x69, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x69,
+	0x6e, 0x70, 0x75, 0x74, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x18, 0x1b, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0b, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x12, 0x24,
+	0x0a, 0x0d, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x65, 0x64, 0x18,
+	0x1c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x74, 0x68, 0x72, 0x65,
+	0x61, 0x64, 0x65, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x5f, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x18, 0x1d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x63, 0x61, 0x6e, 0x6e,
+	0x6f, 0x74, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x6e, 0x70, 0x75, 0x74,
+	0x5f, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c,
+	0x69, 0x6e, 0x70, 0x75, 0x74, 0x53, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x12, 0x32, 0x0a, 0x16,
+	0x77, 0x61, 0x6c, 0x6c, 0x5f, 0x74, 0x6f, 0x5f, 0x63, 0x70, 0x75, 0x5f, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x18, 0x1f, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x77, 0x61,
+	0x6c, 0x6c, 0x54, 0x6f, 0x43, 0x70, 0x75, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x50, 0x6f, 0x73, 0x74,
+	0x12, 0x35, 0x0a, 0x17, 0x64, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x63, 0x74, 0x5f, 0x66, 0x69, 0x6c,
+	0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x5f, 0x68, 0x69, 0x74, 0x18, 0x20, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x14, 0x64, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x63, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x4c,
+	0x69, 0x6d, 0x69, 0x74, 0x48, 0x69, 0x74, 0x22, 0x97, 0x01, 0x0a, 0x13, 0x45, 0x78, 0x65, 0x63,
+	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12,
+	0x28, 0x0a, 0x10, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6d, 0x69, 0x63,
+	0x72, 0x6f, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x75, 0x73, 0x65, 0x72, 0x54,
+	0x69, 0x6d, 0x65, 0x4d, 0x69, 0x63, 0x72, 0x6f, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x6b, 0x65, 0x72,
+	0x6e, 0x65, 0x6c, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6d, 0x69, 0x63, 0x72, 0x6f, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x6b, 0x65, 0x72, 0x6e, 0x65, 0x6c, 0x54, 0x69, 0x6d,
+	0x65, 0x4d, 0x69, 0x63, 0x72, 0x6f, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x77, 0x61, 0x6c, 0x6c, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6d, 0x69, 0x63, 0x72, 0x6f, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0e, 0x77, 0x61, 0x6c, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x4d, 0x69, 0x63, 0x72, 0x6f,
+	0x73, 0x42, 0x4b, 0x0a, 0x1c, 0x6f, 0x72, 0x67, 0x2e, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x72, 0x61,
+	0x79, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x5a, 0x2b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f,
+	0x6e, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x2f, 0x72, 0x75, 0x6e, 0x6c, 0x69, 0x62, 0x2f, 0x63,
+	0x6f, 0x6e, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -412,20 +898,25 @@ func file_Execution_proto_rawDescGZIP() []byte {
 	return file_Execution_proto_rawDescData
 }
 
-var file_Execution_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_Execution_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
 var file_Execution_proto_goTypes = []interface{}{
 	(*RedirectParameters)(nil),   // 0: contester.proto.RedirectParameters
-	(*ExecutionResultFlags)(nil), // 1: contester.proto.ExecutionResultFlags
-	(*ExecutionResultTime)(nil),  // 2: contester.proto.ExecutionResultTime
-	(*Blob)(nil),                 // 3: contester.proto.Blob
+	(*InputSource)(nil),          // 1: contester.proto.InputSource
+	(*CompareOptions)(nil),       // 2: contester.proto.CompareOptions
+	(*ExecutionResultFlags)(nil), // 3: contester.proto.ExecutionResultFlags
+	(*ExecutionResultTime)(nil),  // 4: contester.proto.ExecutionResultTime
+	(*Blob)(nil),                 // 5: contester.proto.Blob
 }
 var file_Execution_proto_depIdxs = []int32{
-	3, // 0: contester.proto.RedirectParameters.buffer:type_name -> contester.proto.Blob
-	1, // [1:1] is the sub-list for method output_type
-	1, // [1:1] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	5, // 0: contester.proto.RedirectParameters.buffer:type_name -> contester.proto.Blob
+	2, // 1: contester.proto.RedirectParameters.compare_options:type_name -> contester.proto.CompareOptions
+	1, // 2: contester.proto.RedirectParameters.sources:type_name -> contester.proto.InputSource
+	5, // 3: contester.proto.InputSource.data:type_name -> contester.proto.Blob
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
 }
 
 func init() { file_Execution_proto_init() }
@@ -448,7 +939,7 @@ func file_Execution_proto_init() {
 			}
 		}
 		file_Execution_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ExecutionResultFlags); i {
+			switch v := v.(*InputSource); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -460,6 +951,30 @@ func file_Execution_proto_init() {
 			}
 		}
 		file_Execution_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CompareOptions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_Execution_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExecutionResultFlags); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_Execution_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ExecutionResultTime); i {
 			case 0:
 				return &v.state
@@ -478,7 +993,7 @@ func file_Execution_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_Execution_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   3,
+			NumMessages:   5,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -20,6 +20,241 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type LocalExecutionParameters_MemoryAccounting int32
+
+const (
+	LocalExecutionParameters_PRIVATE     LocalExecutionParameters_MemoryAccounting = 0
+	LocalExecutionParameters_WORKING_SET LocalExecutionParameters_MemoryAccounting = 1
+)
+
+// Enum value maps for LocalExecutionParameters_MemoryAccounting.
+var (
+	LocalExecutionParameters_MemoryAccounting_name = map[int32]string{
+		0: "PRIVATE",
+		1: "WORKING_SET",
+	}
+	LocalExecutionParameters_MemoryAccounting_value = map[string]int32{
+		"PRIVATE":     0,
+		"WORKING_SET": 1,
+	}
+)
+
+func (x LocalExecutionParameters_MemoryAccounting) Enum() *LocalExecutionParameters_MemoryAccounting {
+	p := new(LocalExecutionParameters_MemoryAccounting)
+	*p = x
+	return p
+}
+
+func (x LocalExecutionParameters_MemoryAccounting) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LocalExecutionParameters_MemoryAccounting) Descriptor() protoreflect.EnumDescriptor {
+	return file_Local_proto_enumTypes[0].Descriptor()
+}
+
+func (LocalExecutionParameters_MemoryAccounting) Type() protoreflect.EnumType {
+	return &file_Local_proto_enumTypes[0]
+}
+
+func (x LocalExecutionParameters_MemoryAccounting) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LocalExecutionParameters_MemoryAccounting.Descriptor instead.
+func (LocalExecutionParameters_MemoryAccounting) EnumDescriptor() ([]byte, []int) {
+	return file_Local_proto_rawDescGZIP(), []int{1, 0}
+}
+
+type LocalExecuteWithCheckerResult_Verdict int32
+
+const (
+	LocalExecuteWithCheckerResult_ACCEPTED           LocalExecuteWithCheckerResult_Verdict = 0
+	LocalExecuteWithCheckerResult_WRONG_ANSWER       LocalExecuteWithCheckerResult_Verdict = 1
+	LocalExecuteWithCheckerResult_PRESENTATION_ERROR LocalExecuteWithCheckerResult_Verdict = 2
+	LocalExecuteWithCheckerResult_FAIL               LocalExecuteWithCheckerResult_Verdict = 3
+	LocalExecuteWithCheckerResult_NOT_CHECKED        LocalExecuteWithCheckerResult_Verdict = 4
+	LocalExecuteWithCheckerResult_POINTS             LocalExecuteWithCheckerResult_Verdict = 5
+)
+
+// Enum value maps for LocalExecuteWithCheckerResult_Verdict.
+var (
+	LocalExecuteWithCheckerResult_Verdict_name = map[int32]string{
+		0: "ACCEPTED",
+		1: "WRONG_ANSWER",
+		2: "PRESENTATION_ERROR",
+		3: "FAIL",
+		4: "NOT_CHECKED",
+		5: "POINTS",
+	}
+	LocalExecuteWithCheckerResult_Verdict_value = map[string]int32{
+		"ACCEPTED":           0,
+		"WRONG_ANSWER":       1,
+		"PRESENTATION_ERROR": 2,
+		"FAIL":               3,
+		"NOT_CHECKED":        4,
+		"POINTS":             5,
+	}
+)
+
+func (x LocalExecuteWithCheckerResult_Verdict) Enum() *LocalExecuteWithCheckerResult_Verdict {
+	p := new(LocalExecuteWithCheckerResult_Verdict)
+	*p = x
+	return p
+}
+
+func (x LocalExecuteWithCheckerResult_Verdict) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LocalExecuteWithCheckerResult_Verdict) Descriptor() protoreflect.EnumDescriptor {
+	return file_Local_proto_enumTypes[1].Descriptor()
+}
+
+func (LocalExecuteWithCheckerResult_Verdict) Type() protoreflect.EnumType {
+	return &file_Local_proto_enumTypes[1]
+}
+
+func (x LocalExecuteWithCheckerResult_Verdict) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LocalExecuteWithCheckerResult_Verdict.Descriptor instead.
+func (LocalExecuteWithCheckerResult_Verdict) EnumDescriptor() ([]byte, []int) {
+	return file_Local_proto_rawDescGZIP(), []int{14, 0}
+}
+
+type LocalBatchRunResult_Status int32
+
+const (
+	LocalBatchRunResult_COMPLETED LocalBatchRunResult_Status = 0
+	LocalBatchRunResult_SKIPPED   LocalBatchRunResult_Status = 1
+)
+
+// Enum value maps for LocalBatchRunResult_Status.
+var (
+	LocalBatchRunResult_Status_name = map[int32]string{
+		0: "COMPLETED",
+		1: "SKIPPED",
+	}
+	LocalBatchRunResult_Status_value = map[string]int32{
+		"COMPLETED": 0,
+		"SKIPPED":   1,
+	}
+)
+
+func (x LocalBatchRunResult_Status) Enum() *LocalBatchRunResult_Status {
+	p := new(LocalBatchRunResult_Status)
+	*p = x
+	return p
+}
+
+func (x LocalBatchRunResult_Status) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LocalBatchRunResult_Status) Descriptor() protoreflect.EnumDescriptor {
+	return file_Local_proto_enumTypes[2].Descriptor()
+}
+
+func (LocalBatchRunResult_Status) Type() protoreflect.EnumType {
+	return &file_Local_proto_enumTypes[2]
+}
+
+func (x LocalBatchRunResult_Status) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LocalBatchRunResult_Status.Descriptor instead.
+func (LocalBatchRunResult_Status) EnumDescriptor() ([]byte, []int) {
+	return file_Local_proto_rawDescGZIP(), []int{16, 0}
+}
+
+// Only for COMPLETED tests.
+type LocalBatchRunResult_Verdict int32
+
+const (
+	LocalBatchRunResult_ACCEPTED                LocalBatchRunResult_Verdict = 0
+	LocalBatchRunResult_WRONG_ANSWER            LocalBatchRunResult_Verdict = 1
+	LocalBatchRunResult_TIME_LIMIT_EXCEEDED     LocalBatchRunResult_Verdict = 2
+	LocalBatchRunResult_MEMORY_LIMIT_EXCEEDED   LocalBatchRunResult_Verdict = 3
+	LocalBatchRunResult_IDLENESS_LIMIT_EXCEEDED LocalBatchRunResult_Verdict = 4
+	LocalBatchRunResult_OUTPUT_LIMIT_EXCEEDED   LocalBatchRunResult_Verdict = 5
+	LocalBatchRunResult_SECURITY_VIOLATION      LocalBatchRunResult_Verdict = 6
+	LocalBatchRunResult_RUNTIME_ERROR           LocalBatchRunResult_Verdict = 7
+	// Output couldn't be compared, or the run was aborted.
+	LocalBatchRunResult_FAIL LocalBatchRunResult_Verdict = 8
+	// More threads than max_threads allows.
+	LocalBatchRunResult_MULTITHREADED LocalBatchRunResult_Verdict = 9
+	// The start probe found the program can't be loaded on the host.
+	LocalBatchRunResult_CANNOT_START LocalBatchRunResult_Verdict = 10
+	// Exit code classified as the host's fault, see LocalExecutionResult.environment_error; rejudge elsewhere.
+	LocalBatchRunResult_ENVIRONMENT_ERROR LocalBatchRunResult_Verdict = 11
+	// Stopped reading its input while it had some, see ExecutionResultFlags.input_stalled.
+	LocalBatchRunResult_INPUT_STALLED LocalBatchRunResult_Verdict = 12
+)
+
+// Enum value maps for LocalBatchRunResult_Verdict.
+var (
+	LocalBatchRunResult_Verdict_name = map[int32]string{
+		0:  "ACCEPTED",
+		1:  "WRONG_ANSWER",
+		2:  "TIME_LIMIT_EXCEEDED",
+		3:  "MEMORY_LIMIT_EXCEEDED",
+		4:  "IDLENESS_LIMIT_EXCEEDED",
+		5:  "OUTPUT_LIMIT_EXCEEDED",
+		6:  "SECURITY_VIOLATION",
+		7:  "RUNTIME_ERROR",
+		8:  "FAIL",
+		9:  "MULTITHREADED",
+		10: "CANNOT_START",
+		11: "ENVIRONMENT_ERROR",
+		12: "INPUT_STALLED",
+	}
+	LocalBatchRunResult_Verdict_value = map[string]int32{
+		"ACCEPTED":                0,
+		"WRONG_ANSWER":            1,
+		"TIME_LIMIT_EXCEEDED":     2,
+		"MEMORY_LIMIT_EXCEEDED":   3,
+		"IDLENESS_LIMIT_EXCEEDED": 4,
+		"OUTPUT_LIMIT_EXCEEDED":   5,
+		"SECURITY_VIOLATION":      6,
+		"RUNTIME_ERROR":           7,
+		"FAIL":                    8,
+		"MULTITHREADED":           9,
+		"CANNOT_START":            10,
+		"ENVIRONMENT_ERROR":       11,
+		"INPUT_STALLED":           12,
+	}
+)
+
+func (x LocalBatchRunResult_Verdict) Enum() *LocalBatchRunResult_Verdict {
+	p := new(LocalBatchRunResult_Verdict)
+	*p = x
+	return p
+}
+
+func (x LocalBatchRunResult_Verdict) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LocalBatchRunResult_Verdict) Descriptor() protoreflect.EnumDescriptor {
+	return file_Local_proto_enumTypes[3].Descriptor()
+}
+
+func (LocalBatchRunResult_Verdict) Type() protoreflect.EnumType {
+	return &file_Local_proto_enumTypes[3]
+}
+
+func (x LocalBatchRunResult_Verdict) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LocalBatchRunResult_Verdict.Descriptor instead.
+func (LocalBatchRunResult_Verdict) EnumDescriptor() ([]byte, []int) {
+	return file_Local_proto_rawDescGZIP(), []int{16, 1}
+}
+
 type BinaryTypeResponse_Win32BinaryType int32
 
 const (
@@ -65,11 +300,11 @@ func (x BinaryTypeResponse_Win32BinaryType) String() string {
 }
 
 func (BinaryTypeResponse_Win32BinaryType) Descriptor() protoreflect.EnumDescriptor {
-	return file_Local_proto_enumTypes[0].Descriptor()
+	return file_Local_proto_enumTypes[4].Descriptor()
 }
 
 func (BinaryTypeResponse_Win32BinaryType) Type() protoreflect.EnumType {
-	return &file_Local_proto_enumTypes[0]
+	return &file_Local_proto_enumTypes[4]
 }
 
 func (x BinaryTypeResponse_Win32BinaryType) Number() protoreflect.EnumNumber {
@@ -78,7 +313,58 @@ func (x BinaryTypeResponse_Win32BinaryType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use BinaryTypeResponse_Win32BinaryType.Descriptor instead.
 func (BinaryTypeResponse_Win32BinaryType) EnumDescriptor() ([]byte, []int) {
-	return file_Local_proto_rawDescGZIP(), []int{7, 0}
+	return file_Local_proto_rawDescGZIP(), []int{24, 0}
+}
+
+type CheckLimitsResponse_Status int32
+
+const (
+	CheckLimitsResponse_HONORED CheckLimitsResponse_Status = 0
+	// Accepted, but the host can't give the whole amount.
+	CheckLimitsResponse_CLAMPED CheckLimitsResponse_Status = 1
+	// Not enforced on this host at all.
+	CheckLimitsResponse_IMPOSSIBLE CheckLimitsResponse_Status = 2
+)
+
+// Enum value maps for CheckLimitsResponse_Status.
+var (
+	CheckLimitsResponse_Status_name = map[int32]string{
+		0: "HONORED",
+		1: "CLAMPED",
+		2: "IMPOSSIBLE",
+	}
+	CheckLimitsResponse_Status_value = map[string]int32{
+		"HONORED":    0,
+		"CLAMPED":    1,
+		"IMPOSSIBLE": 2,
+	}
+)
+
+func (x CheckLimitsResponse_Status) Enum() *CheckLimitsResponse_Status {
+	p := new(CheckLimitsResponse_Status)
+	*p = x
+	return p
+}
+
+func (x CheckLimitsResponse_Status) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CheckLimitsResponse_Status) Descriptor() protoreflect.EnumDescriptor {
+	return file_Local_proto_enumTypes[5].Descriptor()
+}
+
+func (CheckLimitsResponse_Status) Type() protoreflect.EnumType {
+	return &file_Local_proto_enumTypes[5]
+}
+
+func (x CheckLimitsResponse_Status) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CheckLimitsResponse_Status.Descriptor instead.
+func (CheckLimitsResponse_Status) EnumDescriptor() ([]byte, []int) {
+	return file_Local_proto_rawDescGZIP(), []int{30, 0}
 }
 
 type LocalEnvironment struct {
@@ -141,24 +427,174 @@ type LocalExecutionParameters struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ApplicationName       string              `protobuf:"bytes,1,opt,name=application_name,json=applicationName,proto3" json:"application_name,omitempty"`
-	CommandLine           string              `protobuf:"bytes,2,opt,name=command_line,json=commandLine,proto3" json:"command_line,omitempty"`
-	CurrentDirectory      string              `protobuf:"bytes,3,opt,name=current_directory,json=currentDirectory,proto3" json:"current_directory,omitempty"`
-	TimeLimitMicros       uint64              `protobuf:"varint,4,opt,name=time_limit_micros,json=timeLimitMicros,proto3" json:"time_limit_micros,omitempty"`
-	MemoryLimit           uint64              `protobuf:"varint,5,opt,name=memory_limit,json=memoryLimit,proto3" json:"memory_limit,omitempty"`
-	CheckIdleness         bool                `protobuf:"varint,6,opt,name=check_idleness,json=checkIdleness,proto3" json:"check_idleness,omitempty"`
-	Environment           *LocalEnvironment   `protobuf:"bytes,7,opt,name=environment,proto3" json:"environment,omitempty"`
-	RestrictUi            bool                `protobuf:"varint,8,opt,name=restrict_ui,json=restrictUi,proto3" json:"restrict_ui,omitempty"`
-	NoJob                 bool                `protobuf:"varint,9,opt,name=no_job,json=noJob,proto3" json:"no_job,omitempty"`
-	ProcessLimit          uint32              `protobuf:"varint,10,opt,name=process_limit,json=processLimit,proto3" json:"process_limit,omitempty"`
-	KernelTimeLimitMicros uint64              `protobuf:"varint,19,opt,name=kernel_time_limit_micros,json=kernelTimeLimitMicros,proto3" json:"kernel_time_limit_micros,omitempty"`
-	WallTimeLimitMicros   uint64              `protobuf:"varint,20,opt,name=wall_time_limit_micros,json=wallTimeLimitMicros,proto3" json:"wall_time_limit_micros,omitempty"`
-	StdIn                 *RedirectParameters `protobuf:"bytes,12,opt,name=std_in,json=stdIn,proto3" json:"std_in,omitempty"`
-	StdOut                *RedirectParameters `protobuf:"bytes,13,opt,name=std_out,json=stdOut,proto3" json:"std_out,omitempty"`
-	StdErr                *RedirectParameters `protobuf:"bytes,14,opt,name=std_err,json=stdErr,proto3" json:"std_err,omitempty"`
-	CommandLineParameters []string            `protobuf:"bytes,16,rep,name=command_line_parameters,json=commandLineParameters,proto3" json:"command_line_parameters,omitempty"`
-	SandboxId             string              `protobuf:"bytes,17,opt,name=sandbox_id,json=sandboxId,proto3" json:"sandbox_id,omitempty"`
-	JoinStdoutStderr      bool                `protobuf:"varint,18,opt,name=join_stdout_stderr,json=joinStdoutStderr,proto3" json:"join_stdout_stderr,omitempty"`
+	ApplicationName  string            `protobuf:"bytes,1,opt,name=application_name,json=applicationName,proto3" json:"application_name,omitempty"`
+	CommandLine      string            `protobuf:"bytes,2,opt,name=command_line,json=commandLine,proto3" json:"command_line,omitempty"`
+	CurrentDirectory string            `protobuf:"bytes,3,opt,name=current_directory,json=currentDirectory,proto3" json:"current_directory,omitempty"`
+	TimeLimitMicros  uint64            `protobuf:"varint,4,opt,name=time_limit_micros,json=timeLimitMicros,proto3" json:"time_limit_micros,omitempty"`
+	MemoryLimit      uint64            `protobuf:"varint,5,opt,name=memory_limit,json=memoryLimit,proto3" json:"memory_limit,omitempty"`
+	CheckIdleness    bool              `protobuf:"varint,6,opt,name=check_idleness,json=checkIdleness,proto3" json:"check_idleness,omitempty"`
+	Environment      *LocalEnvironment `protobuf:"bytes,7,opt,name=environment,proto3" json:"environment,omitempty"`
+	RestrictUi       bool              `protobuf:"varint,8,opt,name=restrict_ui,json=restrictUi,proto3" json:"restrict_ui,omitempty"`
+	NoJob            bool              `protobuf:"varint,9,opt,name=no_job,json=noJob,proto3" json:"no_job,omitempty"`
+	ProcessLimit     uint32            `protobuf:"varint,10,opt,name=process_limit,json=processLimit,proto3" json:"process_limit,omitempty"`
+	// Limit on processes created over the run, the main one included, however few run at once. Windows, with a job.
+	TotalProcessLimit          uint32              `protobuf:"varint,63,opt,name=total_process_limit,json=totalProcessLimit,proto3" json:"total_process_limit,omitempty"`
+	KernelTimeLimitMicros      uint64              `protobuf:"varint,19,opt,name=kernel_time_limit_micros,json=kernelTimeLimitMicros,proto3" json:"kernel_time_limit_micros,omitempty"`
+	WallTimeLimitMicros        uint64              `protobuf:"varint,20,opt,name=wall_time_limit_micros,json=wallTimeLimitMicros,proto3" json:"wall_time_limit_micros,omitempty"`
+	StdIn                      *RedirectParameters `protobuf:"bytes,12,opt,name=std_in,json=stdIn,proto3" json:"std_in,omitempty"`
+	StdOut                     *RedirectParameters `protobuf:"bytes,13,opt,name=std_out,json=stdOut,proto3" json:"std_out,omitempty"`
+	StdErr                     *RedirectParameters `protobuf:"bytes,14,opt,name=std_err,json=stdErr,proto3" json:"std_err,omitempty"`
+	CommandLineParameters      []string            `protobuf:"bytes,16,rep,name=command_line_parameters,json=commandLineParameters,proto3" json:"command_line_parameters,omitempty"`
+	SandboxId                  string              `protobuf:"bytes,17,opt,name=sandbox_id,json=sandboxId,proto3" json:"sandbox_id,omitempty"`
+	JoinStdoutStderr           bool                `protobuf:"varint,18,opt,name=join_stdout_stderr,json=joinStdoutStderr,proto3" json:"join_stdout_stderr,omitempty"`
+	MemorySampleIntervalMicros uint64              `protobuf:"varint,21,opt,name=memory_sample_interval_micros,json=memorySampleIntervalMicros,proto3" json:"memory_sample_interval_micros,omitempty"`
+	VirtualMemoryLimit         uint64              `protobuf:"varint,22,opt,name=virtual_memory_limit,json=virtualMemoryLimit,proto3" json:"virtual_memory_limit,omitempty"`
+	// Windows only: extra directories for runtime DLL search, see runexe -dll-dir.
+	DllDirectories        []string `protobuf:"bytes,23,rep,name=dll_directories,json=dllDirectories,proto3" json:"dll_directories,omitempty"`
+	UnrestrictedDllSearch bool     `protobuf:"varint,24,opt,name=unrestricted_dll_search,json=unrestrictedDllSearch,proto3" json:"unrestricted_dll_search,omitempty"`
+	// Windows only: application manifest to run the program with, pinning the side-by-side assemblies (like the
+	// MSVC runtime) it binds to. Written next to the executable; programs with an embedded manifest are refused.
+	Manifest string `protobuf:"bytes,81,opt,name=manifest,proto3" json:"manifest,omitempty"`
+	// Stage input_file as input_name in current directory, and return contents of output_name.
+	FileIo        *FileIO `protobuf:"bytes,25,opt,name=file_io,json=fileIo,proto3" json:"file_io,omitempty"`
+	MeasureCycles bool    `protobuf:"varint,26,opt,name=measure_cycles,json=measureCycles,proto3" json:"measure_cycles,omitempty"`
+	// Only sets faster_than_expected in the result, never fails the run.
+	MinExpectedTimeMicros uint64 `protobuf:"varint,27,opt,name=min_expected_time_micros,json=minExpectedTimeMicros,proto3" json:"min_expected_time_micros,omitempty"`
+	// Runs of a second or more with wall time over this many times CPU time, as from sleeping to stay under the
+	// time limit, get wall_to_cpu_ratio_exceeded; a heuristic, so with fail_on_wall_to_cpu_ratio only they fail,
+	// with the wall_to_cpu_ratio_post flag.
+	MaxWallToCpuRatio    float64 `protobuf:"fixed64,77,opt,name=max_wall_to_cpu_ratio,json=maxWallToCpuRatio,proto3" json:"max_wall_to_cpu_ratio,omitempty"`
+	FailOnWallToCpuRatio bool    `protobuf:"varint,78,opt,name=fail_on_wall_to_cpu_ratio,json=failOnWallToCpuRatio,proto3" json:"fail_on_wall_to_cpu_ratio,omitempty"`
+	// What memory_limit and memory in the result mean. Windows only.
+	MemoryAccounting LocalExecutionParameters_MemoryAccounting `protobuf:"varint,28,opt,name=memory_accounting,json=memoryAccounting,proto3,enum=contester.proto.LocalExecutionParameters_MemoryAccounting" json:"memory_accounting,omitempty"`
+	// Windows only: process priority class (like 0x4000 for below normal, 0 is default) and priority of the
+	// main thread relative to it.
+	PriorityClass      uint32 `protobuf:"varint,29,opt,name=priority_class,json=priorityClass,proto3" json:"priority_class,omitempty"`
+	MainThreadPriority int32  `protobuf:"zigzag32,30,opt,name=main_thread_priority,json=mainThreadPriority,proto3" json:"main_thread_priority,omitempty"`
+	// Windows 8+: hard cap of CPU usage, in percent. Slows the process down, so affects wall time.
+	CpuRatePercent uint32 `protobuf:"varint,31,opt,name=cpu_rate_percent,json=cpuRatePercent,proto3" json:"cpu_rate_percent,omitempty"`
+	// Windows only: I/O priority, 1 normal, 2 low, 3 very low; 0 keeps the default. Lower ones keep the solution
+	// from starving the host's disk, but add to its wall time.
+	IoPriority uint32 `protobuf:"varint,80,opt,name=io_priority,json=ioPriority,proto3" json:"io_priority,omitempty"`
+	// Debug only: on wall time or idleness kill, write minidump into this directory and return kill_snapshot.
+	KillSnapshotDir string `protobuf:"bytes,32,opt,name=kill_snapshot_dir,json=killSnapshotDir,proto3" json:"kill_snapshot_dir,omitempty"`
+	// Report hard page faults, paging_time_micros (an estimate of wall time lost to them), and working set trims.
+	MeasurePaging bool `protobuf:"varint,37,opt,name=measure_paging,json=measurePaging,proto3" json:"measure_paging,omitempty"`
+	// Best effort reproducibility: single CPU, no priority boosts.
+	Deterministic bool `protobuf:"varint,38,opt,name=deterministic,proto3" json:"deterministic,omitempty"`
+	// Return host_load: CPU utilization, concurrent runs and CPU frequency on the host during this run.
+	MeasureHostLoad bool `protobuf:"varint,39,opt,name=measure_host_load,json=measureHostLoad,proto3" json:"measure_host_load,omitempty"`
+	// Windows 10 1809+: run in a pseudo console, so the process sees a TTY. std_err is joined to std_out.
+	PseudoConsole bool `protobuf:"varint,40,opt,name=pseudo_console,json=pseudoConsole,proto3" json:"pseudo_console,omitempty"`
+	// Count distinct modules (DLLs, shared objects) the process loads; module_limit_exceeded is set above the limit,
+	// but the run isn't stopped. Sampled, so can miss short-lived loads.
+	CountModules bool   `protobuf:"varint,41,opt,name=count_modules,json=countModules,proto3" json:"count_modules,omitempty"`
+	ModuleLimit  uint32 `protobuf:"varint,42,opt,name=module_limit,json=moduleLimit,proto3" json:"module_limit,omitempty"`
+	// Windows 8+: run in this AppContainer profile instead of under the sandbox login, with capabilities given as SID
+	// strings or names like internetClientServer. No capabilities by default.
+	AppContainer             string   `protobuf:"bytes,43,opt,name=app_container,json=appContainer,proto3" json:"app_container,omitempty"`
+	AppContainerCapabilities []string `protobuf:"bytes,44,rep,name=app_container_capabilities,json=appContainerCapabilities,proto3" json:"app_container_capabilities,omitempty"`
+	// Return io_wait_estimate_micros.
+	MeasureIoWait bool `protobuf:"varint,45,opt,name=measure_io_wait,json=measureIoWait,proto3" json:"measure_io_wait,omitempty"`
+	// Return scheduler_delay_micros: time the process was ready to run, but waiting for a CPU. Runs with a high one
+	// were slowed down by an oversubscribed host, and are candidates for rejudging.
+	MeasureSchedulerDelay bool `protobuf:"varint,79,opt,name=measure_scheduler_delay,json=measureSchedulerDelay,proto3" json:"measure_scheduler_delay,omitempty"`
+	// Windows only: privileges removed from the token before the start. If empty, runs under the sandbox user lose
+	// all but SeChangeNotifyPrivilege, unless keep_privileges is set. Remaining ones are returned in privileges.
+	RemovePrivileges []string `protobuf:"bytes,46,rep,name=remove_privileges,json=removePrivileges,proto3" json:"remove_privileges,omitempty"`
+	KeepPrivileges   bool     `protobuf:"varint,47,opt,name=keep_privileges,json=keepPrivileges,proto3" json:"keep_privileges,omitempty"`
+	// Windows only: hardening features ("desktop", "privileges", "dll-search") which, if the host can't apply them,
+	// let the run go on without them (listed in downgraded_features) or refuse it. Unlisted ones use their default:
+	// desktop and privileges fail closed (desktop fails open on hosts running with AllowNoDesktop), dll-search fails
+	// open unless dll_directories are set.
+	FailOpen   []string `protobuf:"bytes,86,rep,name=fail_open,json=failOpen,proto3" json:"fail_open,omitempty"`
+	FailClosed []string `protobuf:"bytes,87,rep,name=fail_closed,json=failClosed,proto3" json:"fail_closed,omitempty"`
+	// Per-run value the solution has to echo, passed in the nonce_env variable and/or as the first line of stdin;
+	// random if empty. With verify_nonce, nonce_found tells if stdout had it, the verdict is up to the caller.
+	Nonce          string `protobuf:"bytes,48,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	NonceEnv       string `protobuf:"bytes,49,opt,name=nonce_env,json=nonceEnv,proto3" json:"nonce_env,omitempty"`
+	NonceFirstLine bool   `protobuf:"varint,50,opt,name=nonce_first_line,json=nonceFirstLine,proto3" json:"nonce_first_line,omitempty"`
+	VerifyNonce    bool   `protobuf:"varint,51,opt,name=verify_nonce,json=verifyNonce,proto3" json:"verify_nonce,omitempty"`
+	// Locale of the process, in LANG and LC_ALL, and on Windows passed to injected DLLs to set; "C" if empty, "host"
+	// to keep the host one.
+	Locale string `protobuf:"bytes,74,opt,name=locale,proto3" json:"locale,omitempty"`
+	// Caller's id of the run, for the audit log.
+	RunId string `protobuf:"bytes,52,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	// Anything the caller wants back in the result, like its submission and test ids. Never looked at otherwise.
+	Metadata map[string]string `protobuf:"bytes,68,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Windows only: for runs without a job, check memory at least this many times in the first time quantum.
+	MinMemoryChecks uint32 `protobuf:"varint,53,opt,name=min_memory_checks,json=minMemoryChecks,proto3" json:"min_memory_checks,omitempty"`
+	// Windows 10 1703+, with app_container: close the registry to the process, but for keys in registry_allowlist
+	// (like MACHINE\SOFTWARE\Microsoft\.NETFramework), which it can read. Denied accesses set
+	// registry_access_denied if the host audits them.
+	DenyRegistry      bool     `protobuf:"varint,54,opt,name=deny_registry,json=denyRegistry,proto3" json:"deny_registry,omitempty"`
+	RegistryAllowlist []string `protobuf:"bytes,55,rep,name=registry_allowlist,json=registryAllowlist,proto3" json:"registry_allowlist,omitempty"`
+	// Return only flags, reason, time, memory, return code and metadata, plus outputs, comparison and diagnostics if
+	// asked for; for judging at scale, where the rest is requested again only for runs under review.
+	LeanResult bool `protobuf:"varint,56,opt,name=lean_result,json=leanResult,proto3" json:"lean_result,omitempty"`
+	// Windows: keep SeLockMemoryPrivilege, which is removed otherwise, so the process can allocate large pages.
+	// They're invisible to WORKING_SET memory accounting.
+	AllowLargePages bool `protobuf:"varint,57,opt,name=allow_large_pages,json=allowLargePages,proto3" json:"allow_large_pages,omitempty"`
+	// Name of a [profile "name"] section of server.ini, like a language id, with defaults for fields not set here.
+	Profile string `protobuf:"bytes,58,opt,name=profile,proto3" json:"profile,omitempty"`
+	// Report peak_thread_count. Thread counts are sampled (on Windows also when a process joins the job), so
+	// threads living shorter than the check interval (a quarter second) may be missed.
+	CountThreads bool `protobuf:"varint,59,opt,name=count_threads,json=countThreads,proto3" json:"count_threads,omitempty"`
+	// Stop the run with multithreaded set, once it's seen with more threads than this (sampled like count_threads).
+	// On Windows 10+ the loader's own worker threads count too, up to 4 in a single-threaded program.
+	MaxThreads uint32 `protobuf:"varint,69,opt,name=max_threads,json=maxThreads,proto3" json:"max_threads,omitempty"`
+	// Report peak_handle_count (open file descriptors on Linux), sampled like threads. Above handle_limit the run is
+	// stopped with handle_limit_hit.
+	CountHandles bool   `protobuf:"varint,64,opt,name=count_handles,json=countHandles,proto3" json:"count_handles,omitempty"`
+	HandleLimit  uint32 `protobuf:"varint,65,opt,name=handle_limit,json=handleLimit,proto3" json:"handle_limit,omitempty"`
+	// Windows: report named kernel objects (events, mutexes, sections...) the process created or opened, read from the
+	// Security log after the run if the host audits kernel objects. Above named_object_limit the run is only flagged.
+	AuditNamedObjects bool   `protobuf:"varint,66,opt,name=audit_named_objects,json=auditNamedObjects,proto3" json:"audit_named_objects,omitempty"`
+	NamedObjectLimit  uint32 `protobuf:"varint,67,opt,name=named_object_limit,json=namedObjectLimit,proto3" json:"named_object_limit,omitempty"`
+	// Report peak_socket_count: TCP and UDP sockets of the job on Windows, socket descriptors on Linux, sampled like
+	// handles. For hosts which allow loopback, as for a TCP interactor. Above socket_limit the run is only flagged.
+	CountSockets bool   `protobuf:"varint,71,opt,name=count_sockets,json=countSockets,proto3" json:"count_sockets,omitempty"`
+	SocketLimit  uint32 `protobuf:"varint,72,opt,name=socket_limit,json=socketLimit,proto3" json:"socket_limit,omitempty"`
+	// Report distinct_file_count: files opened over the whole run, from open descriptors sampled like handles on
+	// Linux, and from the Security log on Windows if the host audits the file system. Above distinct_file_limit the
+	// run is stopped with ExecutionResultFlags.distinct_file_limit_hit.
+	CountDistinctFiles bool   `protobuf:"varint,84,opt,name=count_distinct_files,json=countDistinctFiles,proto3" json:"count_distinct_files,omitempty"`
+	DistinctFileLimit  uint32 `protobuf:"varint,85,opt,name=distinct_file_limit,json=distinctFileLimit,proto3" json:"distinct_file_limit,omitempty"`
+	// Hex SHA-256 of application_name: it's hashed and held open until the process is created, so it can't be
+	// swapped after validation (on Linux, only replacing it while it's hashed is caught). Launch fails on mismatch.
+	ExecutableSha256 string `protobuf:"bytes,60,opt,name=executable_sha256,json=executableSha256,proto3" json:"executable_sha256,omitempty"`
+	// Memory limit as a part of host physical memory, like 0.5, used if memory_limit isn't set. It never resolves
+	// below 64M; the result has the limit it resolved to in applied_memory_limit.
+	MemoryLimitFraction float64 `protobuf:"fixed64,61,opt,name=memory_limit_fraction,json=memoryLimitFraction,proto3" json:"memory_limit_fraction,omitempty"`
+	// After a time, wall time or idleness limit hit, ask the process to stop (SIGTERM; CTRL_BREAK on Windows, if
+	// the service runs without a console) and wait this long before killing it. Its output meanwhile is kept.
+	KillGracePeriodMicros uint64 `protobuf:"varint,62,opt,name=kill_grace_period_micros,json=killGracePeriodMicros,proto3" json:"kill_grace_period_micros,omitempty"`
+	// By default a process which closes stdout to an interactor (pipe or socket) but keeps running is done
+	// communicating: it's stopped shortly after, with stdout_closed_early, and judged as if it exited. With this
+	// it runs on until it exits or hits a limit.
+	ContinueAfterStdoutClose bool `protobuf:"varint,73,opt,name=continue_after_stdout_close,json=continueAfterStdoutClose,proto3" json:"continue_after_stdout_close,omitempty"`
+	// With stdin from an interactor: stop the process with input_stalled once input sits unread this long.
+	InputStallTimeoutMicros uint64 `protobuf:"varint,75,opt,name=input_stall_timeout_micros,json=inputStallTimeoutMicros,proto3" json:"input_stall_timeout_micros,omitempty"`
+	// With stdin from an interactor: time spent waiting for input (sampled, see input_wait_micros) isn't counted
+	// against wall_time_limit_micros, which then limits wall time minus input wait. Off by default.
+	ExcludeInputWait bool `protobuf:"varint,82,opt,name=exclude_input_wait,json=excludeInputWait,proto3" json:"exclude_input_wait,omitempty"`
+	// Run again, up to this many times, when the run ends with environment_error. Runs with stdio from or to a
+	// pipe or socket aren't repeated.
+	RetryOnEnvironmentError uint32 `protobuf:"varint,83,opt,name=retry_on_environment_error,json=retryOnEnvironmentError,proto3" json:"retry_on_environment_error,omitempty"`
+	// Let the OS write a core or crash dump if the process crashes. Off by default, as it makes crashes slow.
+	CrashDumps bool `protobuf:"varint,76,opt,name=crash_dumps,json=crashDumps,proto3" json:"crash_dumps,omitempty"`
+	// First start the program with no input for this long, to tell if it can be loaded at all (a runtime DLL may be
+	// missing on the host). If not, cannot_start is set and the run is skipped; otherwise it runs as usual, so it
+	// starts twice.
+	StartProbeMicros uint64 `protobuf:"varint,70,opt,name=start_probe_micros,json=startProbeMicros,proto3" json:"start_probe_micros,omitempty"`
+	// Names of parsers (gcc, msvc, or configured in [diagnostics "name"] sections of server.ini) applied to stdout and
+	// stderr to fill diagnostics in the result. Raw output is returned as usual.
+	DiagnosticParsers []string `protobuf:"bytes,33,rep,name=diagnostic_parsers,json=diagnosticParsers,proto3" json:"diagnostic_parsers,omitempty"`
+	// Limit on total size of files in current_directory, for compiles.
+	ArtifactSizeLimit uint64 `protobuf:"varint,34,opt,name=artifact_size_limit,json=artifactSizeLimit,proto3" json:"artifact_size_limit,omitempty"`
+	// Put into current_directory before the start, readable for the sandbox user.
+	StageFiles        []*StagedFile `protobuf:"bytes,35,rep,name=stage_files,json=stageFiles,proto3" json:"stage_files,omitempty"`
+	RemoveStagedFiles bool          `protobuf:"varint,36,opt,name=remove_staged_files,json=removeStagedFiles,proto3" json:"remove_staged_files,omitempty"`
 }
 
 func (x *LocalExecutionParameters) Reset() {
@@ -263,6 +699,13 @@ func (x *LocalExecutionParameters) GetProcessLimit() uint32 {
 	return 0
 }
 
+func (x *LocalExecutionParameters) GetTotalProcessLimit() uint32 {
+	if x != nil {
+		return x.TotalProcessLimit
+	}
+	return 0
+}
+
 func (x *LocalExecutionParameters) GetKernelTimeLimitMicros() uint64 {
 	if x != nil {
 		return x.KernelTimeLimitMicros
@@ -319,178 +762,1774 @@ func (x *LocalExecutionParameters) GetJoinStdoutStderr() bool {
 	return false
 }
 
-type LocalExecuteConnected struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	First  *LocalExecutionParameters `protobuf:"bytes,1,opt,name=first,proto3" json:"first,omitempty"`
-	Second *LocalExecutionParameters `protobuf:"bytes,2,opt,name=second,proto3" json:"second,omitempty"`
-}
-
-func (x *LocalExecuteConnected) Reset() {
-	*x = LocalExecuteConnected{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_Local_proto_msgTypes[2]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *LocalExecutionParameters) GetMemorySampleIntervalMicros() uint64 {
+	if x != nil {
+		return x.MemorySampleIntervalMicros
 	}
+	return 0
 }
 
-func (x *LocalExecuteConnected) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *LocalExecutionParameters) GetVirtualMemoryLimit() uint64 {
+	if x != nil {
+		return x.VirtualMemoryLimit
+	}
+	return 0
 }
 
-func (*LocalExecuteConnected) ProtoMessage() {}
-
-func (x *LocalExecuteConnected) ProtoReflect() protoreflect.Message {
-	mi := &file_Local_proto_msgTypes[2]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *LocalExecutionParameters) GetDllDirectories() []string {
+	if x != nil {
+		return x.DllDirectories
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use LocalExecuteConnected.ProtoReflect.Descriptor instead.
-func (*LocalExecuteConnected) Descriptor() ([]byte, []int) {
-	return file_Local_proto_rawDescGZIP(), []int{2}
+func (x *LocalExecutionParameters) GetUnrestrictedDllSearch() bool {
+	if x != nil {
+		return x.UnrestrictedDllSearch
+	}
+	return false
 }
 
-func (x *LocalExecuteConnected) GetFirst() *LocalExecutionParameters {
+func (x *LocalExecutionParameters) GetManifest() string {
 	if x != nil {
-		return x.First
+		return x.Manifest
 	}
-	return nil
+	return ""
 }
 
-func (x *LocalExecuteConnected) GetSecond() *LocalExecutionParameters {
+func (x *LocalExecutionParameters) GetFileIo() *FileIO {
 	if x != nil {
-		return x.Second
+		return x.FileIo
 	}
 	return nil
 }
 
-type LocalExecutionResult struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Flags          *ExecutionResultFlags `protobuf:"bytes,1,opt,name=flags,proto3" json:"flags,omitempty"`
-	Time           *ExecutionResultTime  `protobuf:"bytes,2,opt,name=time,proto3" json:"time,omitempty"`
-	Memory         uint64                `protobuf:"varint,3,opt,name=memory,proto3" json:"memory,omitempty"`
-	ReturnCode     uint32                `protobuf:"varint,4,opt,name=return_code,json=returnCode,proto3" json:"return_code,omitempty"`
-	StdOut         *Blob                 `protobuf:"bytes,5,opt,name=std_out,json=stdOut,proto3" json:"std_out,omitempty"`
-	StdErr         *Blob                 `protobuf:"bytes,6,opt,name=std_err,json=stdErr,proto3" json:"std_err,omitempty"`
-	TotalProcesses uint64                `protobuf:"varint,7,opt,name=total_processes,json=totalProcesses,proto3" json:"total_processes,omitempty"`
-	KillSignal     int32                 `protobuf:"varint,8,opt,name=kill_signal,json=killSignal,proto3" json:"kill_signal,omitempty"`
-	StopSignal     int32                 `protobuf:"varint,9,opt,name=stop_signal,json=stopSignal,proto3" json:"stop_signal,omitempty"`
-	Error          string                `protobuf:"bytes,10,opt,name=error,proto3" json:"error,omitempty"`
+func (x *LocalExecutionParameters) GetMeasureCycles() bool {
+	if x != nil {
+		return x.MeasureCycles
+	}
+	return false
 }
 
-func (x *LocalExecutionResult) Reset() {
-	*x = LocalExecutionResult{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_Local_proto_msgTypes[3]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *LocalExecutionParameters) GetMinExpectedTimeMicros() uint64 {
+	if x != nil {
+		return x.MinExpectedTimeMicros
 	}
+	return 0
 }
 
-func (x *LocalExecutionResult) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *LocalExecutionParameters) GetMaxWallToCpuRatio() float64 {
+	if x != nil {
+		return x.MaxWallToCpuRatio
+	}
+	return 0
 }
 
-func (*LocalExecutionResult) ProtoMessage() {}
-
-func (x *LocalExecutionResult) ProtoReflect() protoreflect.Message {
-	mi := &file_Local_proto_msgTypes[3]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *LocalExecutionParameters) GetFailOnWallToCpuRatio() bool {
+	if x != nil {
+		return x.FailOnWallToCpuRatio
 	}
-	return mi.MessageOf(x)
+	return false
 }
 
-// Deprecated: Use LocalExecutionResult.ProtoReflect.Descriptor instead.
-func (*LocalExecutionResult) Descriptor() ([]byte, []int) {
-	return file_Local_proto_rawDescGZIP(), []int{3}
+func (x *LocalExecutionParameters) GetMemoryAccounting() LocalExecutionParameters_MemoryAccounting {
+	if x != nil {
+		return x.MemoryAccounting
+	}
+	return LocalExecutionParameters_PRIVATE
 }
 
-func (x *LocalExecutionResult) GetFlags() *ExecutionResultFlags {
+func (x *LocalExecutionParameters) GetPriorityClass() uint32 {
 	if x != nil {
-		return x.Flags
+		return x.PriorityClass
 	}
-	return nil
+	return 0
 }
 
-func (x *LocalExecutionResult) GetTime() *ExecutionResultTime {
+func (x *LocalExecutionParameters) GetMainThreadPriority() int32 {
 	if x != nil {
-		return x.Time
+		return x.MainThreadPriority
 	}
-	return nil
+	return 0
 }
 
-func (x *LocalExecutionResult) GetMemory() uint64 {
+func (x *LocalExecutionParameters) GetCpuRatePercent() uint32 {
 	if x != nil {
-		return x.Memory
+		return x.CpuRatePercent
 	}
 	return 0
 }
 
-func (x *LocalExecutionResult) GetReturnCode() uint32 {
+func (x *LocalExecutionParameters) GetIoPriority() uint32 {
 	if x != nil {
-		return x.ReturnCode
+		return x.IoPriority
 	}
 	return 0
 }
 
-func (x *LocalExecutionResult) GetStdOut() *Blob {
+func (x *LocalExecutionParameters) GetKillSnapshotDir() string {
 	if x != nil {
-		return x.StdOut
+		return x.KillSnapshotDir
 	}
-	return nil
+	return ""
 }
 
-func (x *LocalExecutionResult) GetStdErr() *Blob {
+func (x *LocalExecutionParameters) GetMeasurePaging() bool {
 	if x != nil {
-		return x.StdErr
+		return x.MeasurePaging
 	}
-	return nil
+	return false
 }
 
-func (x *LocalExecutionResult) GetTotalProcesses() uint64 {
+func (x *LocalExecutionParameters) GetDeterministic() bool {
 	if x != nil {
-		return x.TotalProcesses
+		return x.Deterministic
 	}
-	return 0
+	return false
 }
 
-func (x *LocalExecutionResult) GetKillSignal() int32 {
+func (x *LocalExecutionParameters) GetMeasureHostLoad() bool {
 	if x != nil {
-		return x.KillSignal
+		return x.MeasureHostLoad
 	}
-	return 0
+	return false
 }
 
-func (x *LocalExecutionResult) GetStopSignal() int32 {
+func (x *LocalExecutionParameters) GetPseudoConsole() bool {
 	if x != nil {
-		return x.StopSignal
+		return x.PseudoConsole
 	}
-	return 0
+	return false
 }
 
-func (x *LocalExecutionResult) GetError() string {
+func (x *LocalExecutionParameters) GetCountModules() bool {
 	if x != nil {
-		return x.Error
+		return x.CountModules
 	}
-	return ""
+	return false
+}
+
+func (x *LocalExecutionParameters) GetModuleLimit() uint32 {
+	if x != nil {
+		return x.ModuleLimit
+	}
+	return 0
+}
+
+func (x *LocalExecutionParameters) GetAppContainer() string {
+	if x != nil {
+		return x.AppContainer
+	}
+	return ""
+}
+
+func (x *LocalExecutionParameters) GetAppContainerCapabilities() []string {
+	if x != nil {
+		return x.AppContainerCapabilities
+	}
+	return nil
+}
+
+func (x *LocalExecutionParameters) GetMeasureIoWait() bool {
+	if x != nil {
+		return x.MeasureIoWait
+	}
+	return false
+}
+
+func (x *LocalExecutionParameters) GetMeasureSchedulerDelay() bool {
+	if x != nil {
+		return x.MeasureSchedulerDelay
+	}
+	return false
+}
+
+func (x *LocalExecutionParameters) GetRemovePrivileges() []string {
+	if x != nil {
+		return x.RemovePrivileges
+	}
+	return nil
+}
+
+func (x *LocalExecutionParameters) GetKeepPrivileges() bool {
+	if x != nil {
+		return x.KeepPrivileges
+	}
+	return false
+}
+
+func (x *LocalExecutionParameters) GetFailOpen() []string {
+	if x != nil {
+		return x.FailOpen
+	}
+	return nil
+}
+
+func (x *LocalExecutionParameters) GetFailClosed() []string {
+	if x != nil {
+		return x.FailClosed
+	}
+	return nil
+}
+
+func (x *LocalExecutionParameters) GetNonce() string {
+	if x != nil {
+		return x.Nonce
+	}
+	return ""
+}
+
+func (x *LocalExecutionParameters) GetNonceEnv() string {
+	if x != nil {
+		return x.NonceEnv
+	}
+	return ""
+}
+
+func (x *LocalExecutionParameters) GetNonceFirstLine() bool {
+	if x != nil {
+		return x.NonceFirstLine
+	}
+	return false
+}
+
+func (x *LocalExecutionParameters) GetVerifyNonce() bool {
+	if x != nil {
+		return x.VerifyNonce
+	}
+	return false
+}
+
+func (x *LocalExecutionParameters) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+func (x *LocalExecutionParameters) GetRunId() string {
+	if x != nil {
+		return x.RunId
+	}
+	return ""
+}
+
+func (x *LocalExecutionParameters) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *LocalExecutionParameters) GetMinMemoryChecks() uint32 {
+	if x != nil {
+		return x.MinMemoryChecks
+	}
+	return 0
+}
+
+func (x *LocalExecutionParameters) GetDenyRegistry() bool {
+	if x != nil {
+		return x.DenyRegistry
+	}
+	return false
+}
+
+func (x *LocalExecutionParameters) GetRegistryAllowlist() []string {
+	if x != nil {
+		return x.RegistryAllowlist
+	}
+	return nil
+}
+
+func (x *LocalExecutionParameters) GetLeanResult() bool {
+	if x != nil {
+		return x.LeanResult
+	}
+	return false
+}
+
+func (x *LocalExecutionParameters) GetAllowLargePages() bool {
+	if x != nil {
+		return x.AllowLargePages
+	}
+	return false
+}
+
+func (x *LocalExecutionParameters) GetProfile() string {
+	if x != nil {
+		return x.Profile
+	}
+	return ""
+}
+
+func (x *LocalExecutionParameters) GetCountThreads() bool {
+	if x != nil {
+		return x.CountThreads
+	}
+	return false
+}
+
+func (x *LocalExecutionParameters) GetMaxThreads() uint32 {
+	if x != nil {
+		return x.MaxThreads
+	}
+	return 0
+}
+
+func (x *LocalExecutionParameters) GetCountHandles() bool {
+	if x != nil {
+		return x.CountHandles
+	}
+	return false
+}
+
+func (x *LocalExecutionParameters) GetHandleLimit() uint32 {
+	if x != nil {
+		return x.HandleLimit
+	}
+	return 0
+}
+
+func (x *LocalExecutionParameters) GetAuditNamedObjects() bool {
+	if x != nil {
+		return x.AuditNamedObjects
+	}
+	return false
+}
+
+func (x *LocalExecutionParameters) GetNamedObjectLimit() uint32 {
+	if x != nil {
+		return x.NamedObjectLimit
+	}
+	return 0
+}
+
+func (x *LocalExecutionParameters) GetCountSockets() bool {
+	if x != nil {
+		return x.CountSockets
+	}
+	return false
+}
+
+func (x *LocalExecutionParameters) GetSocketLimit() uint32 {
+	if x != nil {
+		return x.SocketLimit
+	}
+	return 0
+}
+
+func (x *LocalExecutionParameters) GetCountDistinctFiles() bool {
+	if x != nil {
+		return x.CountDistinctFiles
+	}
+	return false
+}
+
+func (x *LocalExecutionParameters) GetDistinctFileLimit() uint32 {
+	if x != nil {
+		return x.DistinctFileLimit
+	}
+	return 0
+}
+
+func (x *LocalExecutionParameters) GetExecutableSha256() string {
+	if x != nil {
+		return x.ExecutableSha256
+	}
+	return ""
+}
+
+func (x *LocalExecutionParameters) GetMemoryLimitFraction() float64 {
+	if x != nil {
+		return x.MemoryLimitFraction
+	}
+	return 0
+}
+
+func (x *LocalExecutionParameters) GetKillGracePeriodMicros() uint64 {
+	if x != nil {
+		return x.KillGracePeriodMicros
+	}
+	return 0
+}
+
+func (x *LocalExecutionParameters) GetContinueAfterStdoutClose() bool {
+	if x != nil {
+		return x.ContinueAfterStdoutClose
+	}
+	return false
+}
+
+func (x *LocalExecutionParameters) GetInputStallTimeoutMicros() uint64 {
+	if x != nil {
+		return x.InputStallTimeoutMicros
+	}
+	return 0
+}
+
+func (x *LocalExecutionParameters) GetExcludeInputWait() bool {
+	if x != nil {
+		return x.ExcludeInputWait
+	}
+	return false
+}
+
+func (x *LocalExecutionParameters) GetRetryOnEnvironmentError() uint32 {
+	if x != nil {
+		return x.RetryOnEnvironmentError
+	}
+	return 0
+}
+
+func (x *LocalExecutionParameters) GetCrashDumps() bool {
+	if x != nil {
+		return x.CrashDumps
+	}
+	return false
+}
+
+func (x *LocalExecutionParameters) GetStartProbeMicros() uint64 {
+	if x != nil {
+		return x.StartProbeMicros
+	}
+	return 0
+}
+
+func (x *LocalExecutionParameters) GetDiagnosticParsers() []string {
+	if x != nil {
+		return x.DiagnosticParsers
+	}
+	return nil
+}
+
+func (x *LocalExecutionParameters) GetArtifactSizeLimit() uint64 {
+	if x != nil {
+		return x.ArtifactSizeLimit
+	}
+	return 0
+}
+
+func (x *LocalExecutionParameters) GetStageFiles() []*StagedFile {
+	if x != nil {
+		return x.StageFiles
+	}
+	return nil
+}
+
+func (x *LocalExecutionParameters) GetRemoveStagedFiles() bool {
+	if x != nil {
+		return x.RemoveStagedFiles
+	}
+	return false
+}
+
+type StagedFile struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Relative to current_directory.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Local path to copy from; if empty, data is written.
+	Source string `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+	Data   []byte `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *StagedFile) Reset() {
+	*x = StagedFile{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_Local_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StagedFile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StagedFile) ProtoMessage() {}
+
+func (x *StagedFile) ProtoReflect() protoreflect.Message {
+	mi := &file_Local_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StagedFile.ProtoReflect.Descriptor instead.
+func (*StagedFile) Descriptor() ([]byte, []int) {
+	return file_Local_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *StagedFile) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *StagedFile) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *StagedFile) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type FileIO struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InputName     string `protobuf:"bytes,1,opt,name=input_name,json=inputName,proto3" json:"input_name,omitempty"`
+	OutputName    string `protobuf:"bytes,2,opt,name=output_name,json=outputName,proto3" json:"output_name,omitempty"`
+	InputFile     string `protobuf:"bytes,3,opt,name=input_file,json=inputFile,proto3" json:"input_file,omitempty"`
+	OutputMaxSize uint64 `protobuf:"varint,4,opt,name=output_max_size,json=outputMaxSize,proto3" json:"output_max_size,omitempty"`
+}
+
+func (x *FileIO) Reset() {
+	*x = FileIO{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_Local_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FileIO) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileIO) ProtoMessage() {}
+
+func (x *FileIO) ProtoReflect() protoreflect.Message {
+	mi := &file_Local_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileIO.ProtoReflect.Descriptor instead.
+func (*FileIO) Descriptor() ([]byte, []int) {
+	return file_Local_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *FileIO) GetInputName() string {
+	if x != nil {
+		return x.InputName
+	}
+	return ""
+}
+
+func (x *FileIO) GetOutputName() string {
+	if x != nil {
+		return x.OutputName
+	}
+	return ""
+}
+
+func (x *FileIO) GetInputFile() string {
+	if x != nil {
+		return x.InputFile
+	}
+	return ""
+}
+
+func (x *FileIO) GetOutputMaxSize() uint64 {
+	if x != nil {
+		return x.OutputMaxSize
+	}
+	return 0
+}
+
+type LocalExecuteConnected struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	First  *LocalExecutionParameters `protobuf:"bytes,1,opt,name=first,proto3" json:"first,omitempty"`
+	Second *LocalExecutionParameters `protobuf:"bytes,2,opt,name=second,proto3" json:"second,omitempty"`
+}
+
+func (x *LocalExecuteConnected) Reset() {
+	*x = LocalExecuteConnected{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_Local_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LocalExecuteConnected) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LocalExecuteConnected) ProtoMessage() {}
+
+func (x *LocalExecuteConnected) ProtoReflect() protoreflect.Message {
+	mi := &file_Local_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LocalExecuteConnected.ProtoReflect.Descriptor instead.
+func (*LocalExecuteConnected) Descriptor() ([]byte, []int) {
+	return file_Local_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *LocalExecuteConnected) GetFirst() *LocalExecutionParameters {
+	if x != nil {
+		return x.First
+	}
+	return nil
+}
+
+func (x *LocalExecuteConnected) GetSecond() *LocalExecutionParameters {
+	if x != nil {
+		return x.Second
+	}
+	return nil
+}
+
+type LocalExecutionResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Flags      *ExecutionResultFlags `protobuf:"bytes,1,opt,name=flags,proto3" json:"flags,omitempty"`
+	Time       *ExecutionResultTime  `protobuf:"bytes,2,opt,name=time,proto3" json:"time,omitempty"`
+	Memory     uint64                `protobuf:"varint,3,opt,name=memory,proto3" json:"memory,omitempty"`
+	ReturnCode uint32                `protobuf:"varint,4,opt,name=return_code,json=returnCode,proto3" json:"return_code,omitempty"`
+	// return_code as a signed value: -1 for 0xFFFFFFFF on Windows, or for 255 on Linux.
+	SignedReturnCode    int32           `protobuf:"zigzag32,70,opt,name=signed_return_code,json=signedReturnCode,proto3" json:"signed_return_code,omitempty"`
+	StdOut              *Blob           `protobuf:"bytes,5,opt,name=std_out,json=stdOut,proto3" json:"std_out,omitempty"`
+	StdErr              *Blob           `protobuf:"bytes,6,opt,name=std_err,json=stdErr,proto3" json:"std_err,omitempty"`
+	TotalProcesses      uint64          `protobuf:"varint,7,opt,name=total_processes,json=totalProcesses,proto3" json:"total_processes,omitempty"`
+	KillSignal          int32           `protobuf:"varint,8,opt,name=kill_signal,json=killSignal,proto3" json:"kill_signal,omitempty"`
+	StopSignal          int32           `protobuf:"varint,9,opt,name=stop_signal,json=stopSignal,proto3" json:"stop_signal,omitempty"`
+	Error               string          `protobuf:"bytes,10,opt,name=error,proto3" json:"error,omitempty"`
+	MemorySamples       []*MemorySample `protobuf:"bytes,11,rep,name=memory_samples,json=memorySamples,proto3" json:"memory_samples,omitempty"`
+	PeakVirtualMemory   uint64          `protobuf:"varint,12,opt,name=peak_virtual_memory,json=peakVirtualMemory,proto3" json:"peak_virtual_memory,omitempty"`
+	IsolationDowngraded bool            `protobuf:"varint,13,opt,name=isolation_downgraded,json=isolationDowngraded,proto3" json:"isolation_downgraded,omitempty"`
+	// Features from fail_open the run went without.
+	DowngradedFeatures     []string       `protobuf:"bytes,73,rep,name=downgraded_features,json=downgradedFeatures,proto3" json:"downgraded_features,omitempty"`
+	StdIn                  *Blob          `protobuf:"bytes,14,opt,name=std_in,json=stdIn,proto3" json:"std_in,omitempty"`
+	KillWaitMicros         uint64         `protobuf:"varint,15,opt,name=kill_wait_micros,json=killWaitMicros,proto3" json:"kill_wait_micros,omitempty"`
+	CloseMicros            uint64         `protobuf:"varint,16,opt,name=close_micros,json=closeMicros,proto3" json:"close_micros,omitempty"`
+	FileOutput             *Blob          `protobuf:"bytes,17,opt,name=file_output,json=fileOutput,proto3" json:"file_output,omitempty"`
+	CpuCycles              uint64         `protobuf:"varint,18,opt,name=cpu_cycles,json=cpuCycles,proto3" json:"cpu_cycles,omitempty"`
+	EnergyMicrojoules      uint64         `protobuf:"varint,19,opt,name=energy_microjoules,json=energyMicrojoules,proto3" json:"energy_microjoules,omitempty"`
+	StdoutCleanlyClosed    bool           `protobuf:"varint,20,opt,name=stdout_cleanly_closed,json=stdoutCleanlyClosed,proto3" json:"stdout_cleanly_closed,omitempty"`
+	FasterThanExpected     bool           `protobuf:"varint,21,opt,name=faster_than_expected,json=fasterThanExpected,proto3" json:"faster_than_expected,omitempty"`
+	WallToCpuRatioExceeded bool           `protobuf:"varint,64,opt,name=wall_to_cpu_ratio_exceeded,json=wallToCpuRatioExceeded,proto3" json:"wall_to_cpu_ratio_exceeded,omitempty"`
+	StdOutChunks           []*OutputChunk `protobuf:"bytes,22,rep,name=std_out_chunks,json=stdOutChunks,proto3" json:"std_out_chunks,omitempty"`
+	StdErrChunks           []*OutputChunk `protobuf:"bytes,23,rep,name=std_err_chunks,json=stdErrChunks,proto3" json:"std_err_chunks,omitempty"`
+	Comparison             *Comparison    `protobuf:"bytes,24,opt,name=comparison,proto3" json:"comparison,omitempty"`
+	// CPU rate cap that was actually applied.
+	CpuRatePercent uint32 `protobuf:"varint,25,opt,name=cpu_rate_percent,json=cpuRatePercent,proto3" json:"cpu_rate_percent,omitempty"`
+	// I/O priority that was actually applied, as in io_priority.
+	IoPriority   uint32        `protobuf:"varint,66,opt,name=io_priority,json=ioPriority,proto3" json:"io_priority,omitempty"`
+	KillSnapshot *KillSnapshot `protobuf:"bytes,26,opt,name=kill_snapshot,json=killSnapshot,proto3" json:"kill_snapshot,omitempty"`
+	Diagnostics  []*Diagnostic `protobuf:"bytes,27,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
+	// Set if artifact_size_limit was.
+	ArtifactSize uint64 `protobuf:"varint,28,opt,name=artifact_size,json=artifactSize,proto3" json:"artifact_size,omitempty"`
+	// Peak commit of the main process, and total peak of all processes in the job; memory is one of these,
+	// depending on memory_accounting.
+	PeakProcessMemory   uint64    `protobuf:"varint,29,opt,name=peak_process_memory,json=peakProcessMemory,proto3" json:"peak_process_memory,omitempty"`
+	PeakJobMemory       uint64    `protobuf:"varint,30,opt,name=peak_job_memory,json=peakJobMemory,proto3" json:"peak_job_memory,omitempty"`
+	HardFaults          uint64    `protobuf:"varint,31,opt,name=hard_faults,json=hardFaults,proto3" json:"hard_faults,omitempty"`
+	PagingTimeMicros    uint64    `protobuf:"varint,32,opt,name=paging_time_micros,json=pagingTimeMicros,proto3" json:"paging_time_micros,omitempty"`
+	WorkingSetTrims     uint32    `protobuf:"varint,33,opt,name=working_set_trims,json=workingSetTrims,proto3" json:"working_set_trims,omitempty"`
+	HostLoad            *HostLoad `protobuf:"bytes,34,opt,name=host_load,json=hostLoad,proto3" json:"host_load,omitempty"`
+	ModulesLoadedCount  uint32    `protobuf:"varint,35,opt,name=modules_loaded_count,json=modulesLoadedCount,proto3" json:"modules_loaded_count,omitempty"`
+	ModuleLimitExceeded bool      `protobuf:"varint,36,opt,name=module_limit_exceeded,json=moduleLimitExceeded,proto3" json:"module_limit_exceeded,omitempty"`
+	// Estimate: wall time minus CPU time minus time seen idle (no CPU and no I/O operations).
+	IoWaitEstimateMicros uint64 `protobuf:"varint,37,opt,name=io_wait_estimate_micros,json=ioWaitEstimateMicros,proto3" json:"io_wait_estimate_micros,omitempty"`
+	// Exact on Linux; on Windows estimated from threads seen ready every time quantum.
+	SchedulerDelayMicros uint64   `protobuf:"varint,65,opt,name=scheduler_delay_micros,json=schedulerDelayMicros,proto3" json:"scheduler_delay_micros,omitempty"`
+	StdinLimitHit        bool     `protobuf:"varint,38,opt,name=stdin_limit_hit,json=stdinLimitHit,proto3" json:"stdin_limit_hit,omitempty"`
+	Privileges           []string `protobuf:"bytes,39,rep,name=privileges,proto3" json:"privileges,omitempty"`
+	// LocalExecute: why the process couldn't be started. Only flags, launch_error and output written before the
+	// failure (by a launcher or injected DLL, for in-memory redirects) are set then.
+	LaunchError string `protobuf:"bytes,40,opt,name=launch_error,json=launchError,proto3" json:"launch_error,omitempty"`
+	Nonce       string `protobuf:"bytes,41,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	NonceFound  bool   `protobuf:"varint,42,opt,name=nonce_found,json=nonceFound,proto3" json:"nonce_found,omitempty"`
+	// CPU masks the run got, in order, when the service shares cores between concurrent runs (DynamicAffinity).
+	AffinityMasks []uint64 `protobuf:"varint,43,rep,packed,name=affinity_masks,json=affinityMasks,proto3" json:"affinity_masks,omitempty"`
+	// Short explanation of the flags or exit code, with measured values, for showing to contestants.
+	Reason string `protobuf:"bytes,44,opt,name=reason,proto3" json:"reason,omitempty"`
+	// Most threads seen running at once, in all processes of the job; a lower bound. Set with count_threads.
+	PeakThreadCount uint32 `protobuf:"varint,45,opt,name=peak_thread_count,json=peakThreadCount,proto3" json:"peak_thread_count,omitempty"`
+	// The run got lower limits than it asked for, cut down to the host bounds (MaxMemoryLimit and others in
+	// server.ini).
+	LimitsClamped bool `protobuf:"varint,46,opt,name=limits_clamped,json=limitsClamped,proto3" json:"limits_clamped,omitempty"`
+	// Successful runs only: which limit (time, kernel_time, wall_time, memory, virtual_memory, artifact_size) the run
+	// used the largest part of, and that part, from 0 to 1.
+	TightestLimit      string  `protobuf:"bytes,47,opt,name=tightest_limit,json=tightestLimit,proto3" json:"tightest_limit,omitempty"`
+	TightestLimitUsage float64 `protobuf:"fixed64,48,opt,name=tightest_limit_usage,json=tightestLimitUsage,proto3" json:"tightest_limit_usage,omitempty"`
+	// Memory limit the run got, after profile, memory_limit_fraction and host bounds.
+	AppliedMemoryLimit uint64 `protobuf:"varint,49,opt,name=applied_memory_limit,json=appliedMemoryLimit,proto3" json:"applied_memory_limit,omitempty"`
+	// The process exited within kill_grace_period_micros after it was asked to stop.
+	ExitedInGrace bool `protobuf:"varint,50,opt,name=exited_in_grace,json=exitedInGrace,proto3" json:"exited_in_grace,omitempty"`
+	// The process closed stdout and was stopped, see continue_after_stdout_close.
+	StdoutClosedEarly bool `protobuf:"varint,62,opt,name=stdout_closed_early,json=stdoutClosedEarly,proto3" json:"stdout_closed_early,omitempty"`
+	// Most processes running at once, set with total_process_limit; total_processes is the number created.
+	PeakActiveProcesses      uint32 `protobuf:"varint,51,opt,name=peak_active_processes,json=peakActiveProcesses,proto3" json:"peak_active_processes,omitempty"`
+	PeakHandleCount          uint32 `protobuf:"varint,52,opt,name=peak_handle_count,json=peakHandleCount,proto3" json:"peak_handle_count,omitempty"`
+	NamedObjectCount         uint32 `protobuf:"varint,53,opt,name=named_object_count,json=namedObjectCount,proto3" json:"named_object_count,omitempty"`
+	NamedObjectLimitExceeded bool   `protobuf:"varint,54,opt,name=named_object_limit_exceeded,json=namedObjectLimitExceeded,proto3" json:"named_object_limit_exceeded,omitempty"`
+	DistinctFileCount        uint32 `protobuf:"varint,71,opt,name=distinct_file_count,json=distinctFileCount,proto3" json:"distinct_file_count,omitempty"`
+	// LocalExecutionParameters.metadata, unchanged, also in lean results and launch failures.
+	Metadata map[string]string `protobuf:"bytes,55,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// With input_failed: which stdin source failed and why.
+	InputError string `protobuf:"bytes,56,opt,name=input_error,json=inputError,proto3" json:"input_error,omitempty"`
+	// With input_stalled: stdin bytes left unread.
+	UnreadInput uint32 `protobuf:"varint,63,opt,name=unread_input,json=unreadInput,proto3" json:"unread_input,omitempty"`
+	// With exclude_input_wait: wall time not counted against the wall time limit. Time.wall_time_micros still has it.
+	InputWaitMicros uint64 `protobuf:"varint,67,opt,name=input_wait_micros,json=inputWaitMicros,proto3" json:"input_wait_micros,omitempty"`
+	// Bytes written to stdout and stderr, whatever the redirect: files are measured after the run, the rest counted
+	// as it's read. Not counted for named pipes, which the process writes directly.
+	StdOutSize uint64 `protobuf:"varint,57,opt,name=std_out_size,json=stdOutSize,proto3" json:"std_out_size,omitempty"`
+	StdErrSize uint64 `protobuf:"varint,58,opt,name=std_err_size,json=stdErrSize,proto3" json:"std_err_size,omitempty"`
+	// The process exited with a code that means the host failed it (a missing runtime DLL, by default, or
+	// ExitCodeClasses in server.ini), not the solution.
+	EnvironmentError bool `protobuf:"varint,59,opt,name=environment_error,json=environmentError,proto3" json:"environment_error,omitempty"`
+	// With retry_on_environment_error: times the run was repeated, and the reason of the first attempt.
+	EnvironmentRetries    uint32 `protobuf:"varint,68,opt,name=environment_retries,json=environmentRetries,proto3" json:"environment_retries,omitempty"`
+	FirstEnvironmentError string `protobuf:"bytes,69,opt,name=first_environment_error,json=firstEnvironmentError,proto3" json:"first_environment_error,omitempty"`
+	PeakSocketCount       uint32 `protobuf:"varint,60,opt,name=peak_socket_count,json=peakSocketCount,proto3" json:"peak_socket_count,omitempty"`
+	SocketLimitExceeded   bool   `protobuf:"varint,61,opt,name=socket_limit_exceeded,json=socketLimitExceeded,proto3" json:"socket_limit_exceeded,omitempty"`
+}
+
+func (x *LocalExecutionResult) Reset() {
+	*x = LocalExecutionResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_Local_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LocalExecutionResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LocalExecutionResult) ProtoMessage() {}
+
+func (x *LocalExecutionResult) ProtoReflect() protoreflect.Message {
+	mi := &file_Local_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LocalExecutionResult.ProtoReflect.Descriptor instead.
+func (*LocalExecutionResult) Descriptor() ([]byte, []int) {
+	return file_Local_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *LocalExecutionResult) GetFlags() *ExecutionResultFlags {
+	if x != nil {
+		return x.Flags
+	}
+	return nil
+}
+
+func (x *LocalExecutionResult) GetTime() *ExecutionResultTime {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *LocalExecutionResult) GetMemory() uint64 {
+	if x != nil {
+		return x.Memory
+	}
+	return 0
+}
+
+func (x *LocalExecutionResult) GetReturnCode() uint32 {
+	if x != nil {
+		return x.ReturnCode
+	}
+	return 0
+}
+
+func (x *LocalExecutionResult) GetSignedReturnCode() int32 {
+	if x != nil {
+		return x.SignedReturnCode
+	}
+	return 0
+}
+
+func (x *LocalExecutionResult) GetStdOut() *Blob {
+	if x != nil {
+		return x.StdOut
+	}
+	return nil
+}
+
+func (x *LocalExecutionResult) GetStdErr() *Blob {
+	if x != nil {
+		return x.StdErr
+	}
+	return nil
+}
+
+func (x *LocalExecutionResult) GetTotalProcesses() uint64 {
+	if x != nil {
+		return x.TotalProcesses
+	}
+	return 0
+}
+
+func (x *LocalExecutionResult) GetKillSignal() int32 {
+	if x != nil {
+		return x.KillSignal
+	}
+	return 0
+}
+
+func (x *LocalExecutionResult) GetStopSignal() int32 {
+	if x != nil {
+		return x.StopSignal
+	}
+	return 0
+}
+
+func (x *LocalExecutionResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *LocalExecutionResult) GetMemorySamples() []*MemorySample {
+	if x != nil {
+		return x.MemorySamples
+	}
+	return nil
+}
+
+func (x *LocalExecutionResult) GetPeakVirtualMemory() uint64 {
+	if x != nil {
+		return x.PeakVirtualMemory
+	}
+	return 0
+}
+
+func (x *LocalExecutionResult) GetIsolationDowngraded() bool {
+	if x != nil {
+		return x.IsolationDowngraded
+	}
+	return false
+}
+
+func (x *LocalExecutionResult) GetDowngradedFeatures() []string {
+	if x != nil {
+		return x.DowngradedFeatures
+	}
+	return nil
+}
+
+func (x *LocalExecutionResult) GetStdIn() *Blob {
+	if x != nil {
+		return x.StdIn
+	}
+	return nil
+}
+
+func (x *LocalExecutionResult) GetKillWaitMicros() uint64 {
+	if x != nil {
+		return x.KillWaitMicros
+	}
+	return 0
+}
+
+func (x *LocalExecutionResult) GetCloseMicros() uint64 {
+	if x != nil {
+		return x.CloseMicros
+	}
+	return 0
+}
+
+func (x *LocalExecutionResult) GetFileOutput() *Blob {
+	if x != nil {
+		return x.FileOutput
+	}
+	return nil
+}
+
+func (x *LocalExecutionResult) GetCpuCycles() uint64 {
+	if x != nil {
+		return x.CpuCycles
+	}
+	return 0
+}
+
+func (x *LocalExecutionResult) GetEnergyMicrojoules() uint64 {
+	if x != nil {
+		return x.EnergyMicrojoules
+	}
+	return 0
+}
+
+func (x *LocalExecutionResult) GetStdoutCleanlyClosed() bool {
+	if x != nil {
+		return x.StdoutCleanlyClosed
+	}
+	return false
+}
+
+func (x *LocalExecutionResult) GetFasterThanExpected() bool {
+	if x != nil {
+		return x.FasterThanExpected
+	}
+	return false
+}
+
+func (x *LocalExecutionResult) GetWallToCpuRatioExceeded() bool {
+	if x != nil {
+		return x.WallToCpuRatioExceeded
+	}
+	return false
+}
+
+func (x *LocalExecutionResult) GetStdOutChunks() []*OutputChunk {
+	if x != nil {
+		return x.StdOutChunks
+	}
+	return nil
+}
+
+func (x *LocalExecutionResult) GetStdErrChunks() []*OutputChunk {
+	if x != nil {
+		return x.StdErrChunks
+	}
+	return nil
+}
+
+func (x *LocalExecutionResult) GetComparison() *Comparison {
+	if x != nil {
+		return x.Comparison
+	}
+	return nil
+}
+
+func (x *LocalExecutionResult) GetCpuRatePercent() uint32 {
+	if x != nil {
+		return x.CpuRatePercent
+	}
+	return 0
+}
+
+func (x *LocalExecutionResult) GetIoPriority() uint32 {
+	if x != nil {
+		return x.IoPriority
+	}
+	return 0
+}
+
+func (x *LocalExecutionResult) GetKillSnapshot() *KillSnapshot {
+	if x != nil {
+		return x.KillSnapshot
+	}
+	return nil
+}
+
+func (x *LocalExecutionResult) GetDiagnostics() []*Diagnostic {
+	if x != nil {
+		return x.Diagnostics
+	}
+	return nil
+}
+
+func (x *LocalExecutionResult) GetArtifactSize() uint64 {
+	if x != nil {
+		return x.ArtifactSize
+	}
+	return 0
+}
+
+func (x *LocalExecutionResult) GetPeakProcessMemory() uint64 {
+	if x != nil {
+		return x.PeakProcessMemory
+	}
+	return 0
+}
+
+func (x *LocalExecutionResult) GetPeakJobMemory() uint64 {
+	if x != nil {
+		return x.PeakJobMemory
+	}
+	return 0
+}
+
+func (x *LocalExecutionResult) GetHardFaults() uint64 {
+	if x != nil {
+		return x.HardFaults
+	}
+	return 0
+}
+
+func (x *LocalExecutionResult) GetPagingTimeMicros() uint64 {
+	if x != nil {
+		return x.PagingTimeMicros
+	}
+	return 0
+}
+
+func (x *LocalExecutionResult) GetWorkingSetTrims() uint32 {
+	if x != nil {
+		return x.WorkingSetTrims
+	}
+	return 0
+}
+
+func (x *LocalExecutionResult) GetHostLoad() *HostLoad {
+	if x != nil {
+		return x.HostLoad
+	}
+	return nil
+}
+
+func (x *LocalExecutionResult) GetModulesLoadedCount() uint32 {
+	if x != nil {
+		return x.ModulesLoadedCount
+	}
+	return 0
+}
+
+func (x *LocalExecutionResult) GetModuleLimitExceeded() bool {
+	if x != nil {
+		return x.ModuleLimitExceeded
+	}
+	return false
+}
+
+func (x *LocalExecutionResult) GetIoWaitEstimateMicros() uint64 {
+	if x != nil {
+		return x.IoWaitEstimateMicros
+	}
+	return 0
+}
+
+func (x *LocalExecutionResult) GetSchedulerDelayMicros() uint64 {
+	if x != nil {
+		return x.SchedulerDelayMicros
+	}
+	return 0
+}
+
+func (x *LocalExecutionResult) GetStdinLimitHit() bool {
+	if x != nil {
+		return x.StdinLimitHit
+	}
+	return false
+}
+
+func (x *LocalExecutionResult) GetPrivileges() []string {
+	if x != nil {
+		return x.Privileges
+	}
+	return nil
+}
+
+func (x *LocalExecutionResult) GetLaunchError() string {
+	if x != nil {
+		return x.LaunchError
+	}
+	return ""
+}
+
+func (x *LocalExecutionResult) GetNonce() string {
+	if x != nil {
+		return x.Nonce
+	}
+	return ""
+}
+
+func (x *LocalExecutionResult) GetNonceFound() bool {
+	if x != nil {
+		return x.NonceFound
+	}
+	return false
+}
+
+func (x *LocalExecutionResult) GetAffinityMasks() []uint64 {
+	if x != nil {
+		return x.AffinityMasks
+	}
+	return nil
+}
+
+func (x *LocalExecutionResult) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *LocalExecutionResult) GetPeakThreadCount() uint32 {
+	if x != nil {
+		return x.PeakThreadCount
+	}
+	return 0
+}
+
+func (x *LocalExecutionResult) GetLimitsClamped() bool {
+	if x != nil {
+		return x.LimitsClamped
+	}
+	return false
+}
+
+func (x *LocalExecutionResult) GetTightestLimit() string {
+	if x != nil {
+		return x.TightestLimit
+	}
+	return ""
+}
+
+func (x *LocalExecutionResult) GetTightestLimitUsage() float64 {
+	if x != nil {
+		return x.TightestLimitUsage
+	}
+	return 0
+}
+
+func (x *LocalExecutionResult) GetAppliedMemoryLimit() uint64 {
+	if x != nil {
+		return x.AppliedMemoryLimit
+	}
+	return 0
+}
+
+func (x *LocalExecutionResult) GetExitedInGrace() bool {
+	if x != nil {
+		return x.ExitedInGrace
+	}
+	return false
+}
+
+func (x *LocalExecutionResult) GetStdoutClosedEarly() bool {
+	if x != nil {
+		return x.StdoutClosedEarly
+	}
+	return false
+}
+
+func (x *LocalExecutionResult) GetPeakActiveProcesses() uint32 {
+	if x != nil {
+		return x.PeakActiveProcesses
+	}
+	return 0
+}
+
+func (x *LocalExecutionResult) GetPeakHandleCount() uint32 {
+	if x != nil {
+		return x.PeakHandleCount
+	}
+	return 0
+}
+
+func (x *LocalExecutionResult) GetNamedObjectCount() uint32 {
+	if x != nil {
+		return x.NamedObjectCount
+	}
+	return 0
+}
+
+func (x *LocalExecutionResult) GetNamedObjectLimitExceeded() bool {
+	if x != nil {
+		return x.NamedObjectLimitExceeded
+	}
+	return false
+}
+
+func (x *LocalExecutionResult) GetDistinctFileCount() uint32 {
+	if x != nil {
+		return x.DistinctFileCount
+	}
+	return 0
+}
+
+func (x *LocalExecutionResult) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *LocalExecutionResult) GetInputError() string {
+	if x != nil {
+		return x.InputError
+	}
+	return ""
+}
+
+func (x *LocalExecutionResult) GetUnreadInput() uint32 {
+	if x != nil {
+		return x.UnreadInput
+	}
+	return 0
+}
+
+func (x *LocalExecutionResult) GetInputWaitMicros() uint64 {
+	if x != nil {
+		return x.InputWaitMicros
+	}
+	return 0
+}
+
+func (x *LocalExecutionResult) GetStdOutSize() uint64 {
+	if x != nil {
+		return x.StdOutSize
+	}
+	return 0
+}
+
+func (x *LocalExecutionResult) GetStdErrSize() uint64 {
+	if x != nil {
+		return x.StdErrSize
+	}
+	return 0
+}
+
+func (x *LocalExecutionResult) GetEnvironmentError() bool {
+	if x != nil {
+		return x.EnvironmentError
+	}
+	return false
+}
+
+func (x *LocalExecutionResult) GetEnvironmentRetries() uint32 {
+	if x != nil {
+		return x.EnvironmentRetries
+	}
+	return 0
+}
+
+func (x *LocalExecutionResult) GetFirstEnvironmentError() string {
+	if x != nil {
+		return x.FirstEnvironmentError
+	}
+	return ""
+}
+
+func (x *LocalExecutionResult) GetPeakSocketCount() uint32 {
+	if x != nil {
+		return x.PeakSocketCount
+	}
+	return 0
+}
+
+func (x *LocalExecutionResult) GetSocketLimitExceeded() bool {
+	if x != nil {
+		return x.SocketLimitExceeded
+	}
+	return false
+}
+
+type Diagnostic struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	File     string `protobuf:"bytes,1,opt,name=file,proto3" json:"file,omitempty"`
+	Line     uint32 `protobuf:"varint,2,opt,name=line,proto3" json:"line,omitempty"`
+	Column   uint32 `protobuf:"varint,3,opt,name=column,proto3" json:"column,omitempty"`
+	Severity string `protobuf:"bytes,4,opt,name=severity,proto3" json:"severity,omitempty"`
+	Message  string `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *Diagnostic) Reset() {
+	*x = Diagnostic{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_Local_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Diagnostic) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Diagnostic) ProtoMessage() {}
+
+func (x *Diagnostic) ProtoReflect() protoreflect.Message {
+	mi := &file_Local_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Diagnostic.ProtoReflect.Descriptor instead.
+func (*Diagnostic) Descriptor() ([]byte, []int) {
+	return file_Local_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Diagnostic) GetFile() string {
+	if x != nil {
+		return x.File
+	}
+	return ""
+}
+
+func (x *Diagnostic) GetLine() uint32 {
+	if x != nil {
+		return x.Line
+	}
+	return 0
+}
+
+func (x *Diagnostic) GetColumn() uint32 {
+	if x != nil {
+		return x.Column
+	}
+	return 0
+}
+
+func (x *Diagnostic) GetSeverity() string {
+	if x != nil {
+		return x.Severity
+	}
+	return ""
+}
+
+func (x *Diagnostic) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type HostLoad struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Average over the run, 0 to 1.
+	CpuUtilization float64 `protobuf:"fixed64,1,opt,name=cpu_utilization,json=cpuUtilization,proto3" json:"cpu_utilization,omitempty"`
+	ConcurrentRuns uint32  `protobuf:"varint,2,opt,name=concurrent_runs,json=concurrentRuns,proto3" json:"concurrent_runs,omitempty"`
+	// Rated and average current CPU frequency, in MHz, see subprocess.HostLoad.
+	BaseFrequencyMhz    uint32 `protobuf:"varint,3,opt,name=base_frequency_mhz,json=baseFrequencyMhz,proto3" json:"base_frequency_mhz,omitempty"`
+	CurrentFrequencyMhz uint32 `protobuf:"varint,4,opt,name=current_frequency_mhz,json=currentFrequencyMhz,proto3" json:"current_frequency_mhz,omitempty"`
+}
+
+func (x *HostLoad) Reset() {
+	*x = HostLoad{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_Local_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HostLoad) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HostLoad) ProtoMessage() {}
+
+func (x *HostLoad) ProtoReflect() protoreflect.Message {
+	mi := &file_Local_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HostLoad.ProtoReflect.Descriptor instead.
+func (*HostLoad) Descriptor() ([]byte, []int) {
+	return file_Local_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *HostLoad) GetCpuUtilization() float64 {
+	if x != nil {
+		return x.CpuUtilization
+	}
+	return 0
+}
+
+func (x *HostLoad) GetConcurrentRuns() uint32 {
+	if x != nil {
+		return x.ConcurrentRuns
+	}
+	return 0
+}
+
+func (x *HostLoad) GetBaseFrequencyMhz() uint32 {
+	if x != nil {
+		return x.BaseFrequencyMhz
+	}
+	return 0
+}
+
+func (x *HostLoad) GetCurrentFrequencyMhz() uint32 {
+	if x != nil {
+		return x.CurrentFrequencyMhz
+	}
+	return 0
+}
+
+type KillSnapshot struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DumpFile string                 `protobuf:"bytes,1,opt,name=dump_file,json=dumpFile,proto3" json:"dump_file,omitempty"`
+	Threads  []*KillSnapshot_Thread `protobuf:"bytes,2,rep,name=threads,proto3" json:"threads,omitempty"`
+}
+
+func (x *KillSnapshot) Reset() {
+	*x = KillSnapshot{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_Local_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KillSnapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KillSnapshot) ProtoMessage() {}
+
+func (x *KillSnapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_Local_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KillSnapshot.ProtoReflect.Descriptor instead.
+func (*KillSnapshot) Descriptor() ([]byte, []int) {
+	return file_Local_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *KillSnapshot) GetDumpFile() string {
+	if x != nil {
+		return x.DumpFile
+	}
+	return ""
+}
+
+func (x *KillSnapshot) GetThreads() []*KillSnapshot_Thread {
+	if x != nil {
+		return x.Threads
+	}
+	return nil
+}
+
+type Comparison struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Equal    bool   `protobuf:"varint,1,opt,name=equal,proto3" json:"equal,omitempty"`
+	Token    uint64 `protobuf:"varint,2,opt,name=token,proto3" json:"token,omitempty"`
+	Expected string `protobuf:"bytes,3,opt,name=expected,proto3" json:"expected,omitempty"`
+	Actual   string `protobuf:"bytes,4,opt,name=actual,proto3" json:"actual,omitempty"`
+	Message  string `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
+	// On mismatch: lines of the differing token, and a few tokens around it on both sides.
+	ExpectedLine   uint64   `protobuf:"varint,6,opt,name=expected_line,json=expectedLine,proto3" json:"expected_line,omitempty"`
+	ActualLine     uint64   `protobuf:"varint,7,opt,name=actual_line,json=actualLine,proto3" json:"actual_line,omitempty"`
+	ExpectedBefore []string `protobuf:"bytes,8,rep,name=expected_before,json=expectedBefore,proto3" json:"expected_before,omitempty"`
+	ActualBefore   []string `protobuf:"bytes,9,rep,name=actual_before,json=actualBefore,proto3" json:"actual_before,omitempty"`
+	ExpectedAfter  []string `protobuf:"bytes,10,rep,name=expected_after,json=expectedAfter,proto3" json:"expected_after,omitempty"`
+	ActualAfter    []string `protobuf:"bytes,11,rep,name=actual_after,json=actualAfter,proto3" json:"actual_after,omitempty"`
+	EmptyOutput    bool     `protobuf:"varint,12,opt,name=empty_output,json=emptyOutput,proto3" json:"empty_output,omitempty"`
+	// 1-based index of the answer compared with: compare_answer is 1, then compare_alternatives. On mismatch it's
+	// the one matched the longest.
+	Answer uint32 `protobuf:"varint,13,opt,name=answer,proto3" json:"answer,omitempty"`
+}
+
+func (x *Comparison) Reset() {
+	*x = Comparison{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_Local_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Comparison) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Comparison) ProtoMessage() {}
+
+func (x *Comparison) ProtoReflect() protoreflect.Message {
+	mi := &file_Local_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Comparison.ProtoReflect.Descriptor instead.
+func (*Comparison) Descriptor() ([]byte, []int) {
+	return file_Local_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *Comparison) GetEqual() bool {
+	if x != nil {
+		return x.Equal
+	}
+	return false
+}
+
+func (x *Comparison) GetToken() uint64 {
+	if x != nil {
+		return x.Token
+	}
+	return 0
+}
+
+func (x *Comparison) GetExpected() string {
+	if x != nil {
+		return x.Expected
+	}
+	return ""
+}
+
+func (x *Comparison) GetActual() string {
+	if x != nil {
+		return x.Actual
+	}
+	return ""
+}
+
+func (x *Comparison) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *Comparison) GetExpectedLine() uint64 {
+	if x != nil {
+		return x.ExpectedLine
+	}
+	return 0
+}
+
+func (x *Comparison) GetActualLine() uint64 {
+	if x != nil {
+		return x.ActualLine
+	}
+	return 0
+}
+
+func (x *Comparison) GetExpectedBefore() []string {
+	if x != nil {
+		return x.ExpectedBefore
+	}
+	return nil
+}
+
+func (x *Comparison) GetActualBefore() []string {
+	if x != nil {
+		return x.ActualBefore
+	}
+	return nil
+}
+
+func (x *Comparison) GetExpectedAfter() []string {
+	if x != nil {
+		return x.ExpectedAfter
+	}
+	return nil
+}
+
+func (x *Comparison) GetActualAfter() []string {
+	if x != nil {
+		return x.ActualAfter
+	}
+	return nil
+}
+
+func (x *Comparison) GetEmptyOutput() bool {
+	if x != nil {
+		return x.EmptyOutput
+	}
+	return false
+}
+
+func (x *Comparison) GetAnswer() uint32 {
+	if x != nil {
+		return x.Answer
+	}
+	return 0
+}
+
+type OutputChunk struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OffsetMicros uint64 `protobuf:"varint,1,opt,name=offset_micros,json=offsetMicros,proto3" json:"offset_micros,omitempty"`
+	Size         uint64 `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+}
+
+func (x *OutputChunk) Reset() {
+	*x = OutputChunk{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_Local_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OutputChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OutputChunk) ProtoMessage() {}
+
+func (x *OutputChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_Local_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OutputChunk.ProtoReflect.Descriptor instead.
+func (*OutputChunk) Descriptor() ([]byte, []int) {
+	return file_Local_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *OutputChunk) GetOffsetMicros() uint64 {
+	if x != nil {
+		return x.OffsetMicros
+	}
+	return 0
+}
+
+func (x *OutputChunk) GetSize() uint64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+type MemorySample struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OffsetMicros uint64 `protobuf:"varint,1,opt,name=offset_micros,json=offsetMicros,proto3" json:"offset_micros,omitempty"`
+	Commit       uint64 `protobuf:"varint,2,opt,name=commit,proto3" json:"commit,omitempty"`
+	WorkingSet   uint64 `protobuf:"varint,3,opt,name=working_set,json=workingSet,proto3" json:"working_set,omitempty"`
+}
+
+func (x *MemorySample) Reset() {
+	*x = MemorySample{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_Local_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MemorySample) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemorySample) ProtoMessage() {}
+
+func (x *MemorySample) ProtoReflect() protoreflect.Message {
+	mi := &file_Local_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemorySample.ProtoReflect.Descriptor instead.
+func (*MemorySample) Descriptor() ([]byte, []int) {
+	return file_Local_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *MemorySample) GetOffsetMicros() uint64 {
+	if x != nil {
+		return x.OffsetMicros
+	}
+	return 0
+}
+
+func (x *MemorySample) GetCommit() uint64 {
+	if x != nil {
+		return x.Commit
+	}
+	return 0
+}
+
+func (x *MemorySample) GetWorkingSet() uint64 {
+	if x != nil {
+		return x.WorkingSet
+	}
+	return 0
 }
 
 type LocalExecuteConnectedResult struct {
@@ -498,27 +2537,1000 @@ type LocalExecuteConnectedResult struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	First  *LocalExecutionResult `protobuf:"bytes,1,opt,name=first,proto3" json:"first,omitempty"`
-	Second *LocalExecutionResult `protobuf:"bytes,2,opt,name=second,proto3" json:"second,omitempty"`
+	First  *LocalExecutionResult `protobuf:"bytes,1,opt,name=first,proto3" json:"first,omitempty"`
+	Second *LocalExecutionResult `protobuf:"bytes,2,opt,name=second,proto3" json:"second,omitempty"`
+}
+
+func (x *LocalExecuteConnectedResult) Reset() {
+	*x = LocalExecuteConnectedResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_Local_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LocalExecuteConnectedResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LocalExecuteConnectedResult) ProtoMessage() {}
+
+func (x *LocalExecuteConnectedResult) ProtoReflect() protoreflect.Message {
+	mi := &file_Local_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LocalExecuteConnectedResult.ProtoReflect.Descriptor instead.
+func (*LocalExecuteConnectedResult) Descriptor() ([]byte, []int) {
+	return file_Local_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *LocalExecuteConnectedResult) GetFirst() *LocalExecutionResult {
+	if x != nil {
+		return x.First
+	}
+	return nil
+}
+
+func (x *LocalExecuteConnectedResult) GetSecond() *LocalExecutionResult {
+	if x != nil {
+		return x.Second
+	}
+	return nil
+}
+
+type LocalExecuteWithChecker struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Solution   *LocalExecutionParameters `protobuf:"bytes,1,opt,name=solution,proto3" json:"solution,omitempty"`
+	Checker    *LocalExecutionParameters `protobuf:"bytes,2,opt,name=checker,proto3" json:"checker,omitempty"`
+	InputFile  string                    `protobuf:"bytes,3,opt,name=input_file,json=inputFile,proto3" json:"input_file,omitempty"`
+	OutputFile string                    `protobuf:"bytes,4,opt,name=output_file,json=outputFile,proto3" json:"output_file,omitempty"`
+	AnswerFile string                    `protobuf:"bytes,5,opt,name=answer_file,json=answerFile,proto3" json:"answer_file,omitempty"`
+	// How checker exit codes map to verdicts: "testlib" (the default), or a [checker "name"] section of server.ini.
+	CheckerConvention string `protobuf:"bytes,6,opt,name=checker_convention,json=checkerConvention,proto3" json:"checker_convention,omitempty"`
+}
+
+func (x *LocalExecuteWithChecker) Reset() {
+	*x = LocalExecuteWithChecker{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_Local_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LocalExecuteWithChecker) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LocalExecuteWithChecker) ProtoMessage() {}
+
+func (x *LocalExecuteWithChecker) ProtoReflect() protoreflect.Message {
+	mi := &file_Local_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LocalExecuteWithChecker.ProtoReflect.Descriptor instead.
+func (*LocalExecuteWithChecker) Descriptor() ([]byte, []int) {
+	return file_Local_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *LocalExecuteWithChecker) GetSolution() *LocalExecutionParameters {
+	if x != nil {
+		return x.Solution
+	}
+	return nil
+}
+
+func (x *LocalExecuteWithChecker) GetChecker() *LocalExecutionParameters {
+	if x != nil {
+		return x.Checker
+	}
+	return nil
+}
+
+func (x *LocalExecuteWithChecker) GetInputFile() string {
+	if x != nil {
+		return x.InputFile
+	}
+	return ""
+}
+
+func (x *LocalExecuteWithChecker) GetOutputFile() string {
+	if x != nil {
+		return x.OutputFile
+	}
+	return ""
+}
+
+func (x *LocalExecuteWithChecker) GetAnswerFile() string {
+	if x != nil {
+		return x.AnswerFile
+	}
+	return ""
+}
+
+func (x *LocalExecuteWithChecker) GetCheckerConvention() string {
+	if x != nil {
+		return x.CheckerConvention
+	}
+	return ""
+}
+
+type LocalExecuteWithCheckerResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Solution       *LocalExecutionResult                 `protobuf:"bytes,1,opt,name=solution,proto3" json:"solution,omitempty"`
+	Checker        *LocalExecutionResult                 `protobuf:"bytes,2,opt,name=checker,proto3" json:"checker,omitempty"`
+	Verdict        LocalExecuteWithCheckerResult_Verdict `protobuf:"varint,3,opt,name=verdict,proto3,enum=contester.proto.LocalExecuteWithCheckerResult_Verdict" json:"verdict,omitempty"`
+	CheckerMessage string                                `protobuf:"bytes,4,opt,name=checker_message,json=checkerMessage,proto3" json:"checker_message,omitempty"`
+	// Partial score reported by the checker, for POINTS.
+	Score float64 `protobuf:"fixed64,5,opt,name=score,proto3" json:"score,omitempty"`
+}
+
+func (x *LocalExecuteWithCheckerResult) Reset() {
+	*x = LocalExecuteWithCheckerResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_Local_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LocalExecuteWithCheckerResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LocalExecuteWithCheckerResult) ProtoMessage() {}
+
+func (x *LocalExecuteWithCheckerResult) ProtoReflect() protoreflect.Message {
+	mi := &file_Local_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LocalExecuteWithCheckerResult.ProtoReflect.Descriptor instead.
+func (*LocalExecuteWithCheckerResult) Descriptor() ([]byte, []int) {
+	return file_Local_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *LocalExecuteWithCheckerResult) GetSolution() *LocalExecutionResult {
+	if x != nil {
+		return x.Solution
+	}
+	return nil
+}
+
+func (x *LocalExecuteWithCheckerResult) GetChecker() *LocalExecutionResult {
+	if x != nil {
+		return x.Checker
+	}
+	return nil
+}
+
+func (x *LocalExecuteWithCheckerResult) GetVerdict() LocalExecuteWithCheckerResult_Verdict {
+	if x != nil {
+		return x.Verdict
+	}
+	return LocalExecuteWithCheckerResult_ACCEPTED
+}
+
+func (x *LocalExecuteWithCheckerResult) GetCheckerMessage() string {
+	if x != nil {
+		return x.CheckerMessage
+	}
+	return ""
+}
+
+func (x *LocalExecuteWithCheckerResult) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+// Tests of one solution, run in order on the host.
+type LocalBatchRun struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tests []*LocalExecutionParameters `protobuf:"bytes,1,rep,name=tests,proto3" json:"tests,omitempty"`
+	// If set, tests not finished by this deadline are skipped, and the one running is stopped.
+	BatchWallTimeLimitMicros uint64 `protobuf:"varint,2,opt,name=batch_wall_time_limit_micros,json=batchWallTimeLimitMicros,proto3" json:"batch_wall_time_limit_micros,omitempty"`
+	// Don't run tests after the first one whose verdict isn't ACCEPTED; results end with that test. Set
+	// std_out.compare_answer in tests to have the output checked.
+	StopOnFailure bool `protobuf:"varint,3,opt,name=stop_on_failure,json=stopOnFailure,proto3" json:"stop_on_failure,omitempty"`
+}
+
+func (x *LocalBatchRun) Reset() {
+	*x = LocalBatchRun{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_Local_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LocalBatchRun) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LocalBatchRun) ProtoMessage() {}
+
+func (x *LocalBatchRun) ProtoReflect() protoreflect.Message {
+	mi := &file_Local_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LocalBatchRun.ProtoReflect.Descriptor instead.
+func (*LocalBatchRun) Descriptor() ([]byte, []int) {
+	return file_Local_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *LocalBatchRun) GetTests() []*LocalExecutionParameters {
+	if x != nil {
+		return x.Tests
+	}
+	return nil
+}
+
+func (x *LocalBatchRun) GetBatchWallTimeLimitMicros() uint64 {
+	if x != nil {
+		return x.BatchWallTimeLimitMicros
+	}
+	return 0
+}
+
+func (x *LocalBatchRun) GetStopOnFailure() bool {
+	if x != nil {
+		return x.StopOnFailure
+	}
+	return false
+}
+
+type LocalBatchRunResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tests []*LocalBatchRunResult_Test `protobuf:"bytes,1,rep,name=tests,proto3" json:"tests,omitempty"`
+}
+
+func (x *LocalBatchRunResult) Reset() {
+	*x = LocalBatchRunResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_Local_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LocalBatchRunResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LocalBatchRunResult) ProtoMessage() {}
+
+func (x *LocalBatchRunResult) ProtoReflect() protoreflect.Message {
+	mi := &file_Local_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LocalBatchRunResult.ProtoReflect.Descriptor instead.
+func (*LocalBatchRunResult) Descriptor() ([]byte, []int) {
+	return file_Local_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *LocalBatchRunResult) GetTests() []*LocalBatchRunResult_Test {
+	if x != nil {
+		return x.Tests
+	}
+	return nil
+}
+
+// StartBatchRun runs a LocalBatchRun in the background; PollBatchRun gets its results as tests complete.
+type StartBatchRunResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BatchId string `protobuf:"bytes,1,opt,name=batch_id,json=batchId,proto3" json:"batch_id,omitempty"`
+}
+
+func (x *StartBatchRunResult) Reset() {
+	*x = StartBatchRunResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_Local_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StartBatchRunResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartBatchRunResult) ProtoMessage() {}
+
+func (x *StartBatchRunResult) ProtoReflect() protoreflect.Message {
+	mi := &file_Local_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartBatchRunResult.ProtoReflect.Descriptor instead.
+func (*StartBatchRunResult) Descriptor() ([]byte, []int) {
+	return file_Local_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *StartBatchRunResult) GetBatchId() string {
+	if x != nil {
+		return x.BatchId
+	}
+	return ""
+}
+
+type PollBatchRun struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BatchId string `protobuf:"bytes,1,opt,name=batch_id,json=batchId,proto3" json:"batch_id,omitempty"`
+	// Number of tests already received, the result has the ones after.
+	From uint32 `protobuf:"varint,2,opt,name=from,proto3" json:"from,omitempty"`
+	// If nothing new is there yet, wait for it up to this long.
+	WaitMicros uint64 `protobuf:"varint,3,opt,name=wait_micros,json=waitMicros,proto3" json:"wait_micros,omitempty"`
+	// Stop the batch: the test running is stopped, it and the rest are skipped.
+	Stop bool `protobuf:"varint,4,opt,name=stop,proto3" json:"stop,omitempty"`
+}
+
+func (x *PollBatchRun) Reset() {
+	*x = PollBatchRun{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_Local_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PollBatchRun) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PollBatchRun) ProtoMessage() {}
+
+func (x *PollBatchRun) ProtoReflect() protoreflect.Message {
+	mi := &file_Local_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PollBatchRun.ProtoReflect.Descriptor instead.
+func (*PollBatchRun) Descriptor() ([]byte, []int) {
+	return file_Local_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *PollBatchRun) GetBatchId() string {
+	if x != nil {
+		return x.BatchId
+	}
+	return ""
+}
+
+func (x *PollBatchRun) GetFrom() uint32 {
+	if x != nil {
+		return x.From
+	}
+	return 0
+}
+
+func (x *PollBatchRun) GetWaitMicros() uint64 {
+	if x != nil {
+		return x.WaitMicros
+	}
+	return 0
+}
+
+func (x *PollBatchRun) GetStop() bool {
+	if x != nil {
+		return x.Stop
+	}
+	return false
+}
+
+type PollBatchRunResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tests []*LocalBatchRunResult_Test `protobuf:"bytes,1,rep,name=tests,proto3" json:"tests,omitempty"`
+	// No more tests will come. The batch is forgotten once a poll has returned all of them.
+	Done bool `protobuf:"varint,2,opt,name=done,proto3" json:"done,omitempty"`
+	// Why the batch failed, for done batches which did.
+	Error string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *PollBatchRunResult) Reset() {
+	*x = PollBatchRunResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_Local_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PollBatchRunResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PollBatchRunResult) ProtoMessage() {}
+
+func (x *PollBatchRunResult) ProtoReflect() protoreflect.Message {
+	mi := &file_Local_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PollBatchRunResult.ProtoReflect.Descriptor instead.
+func (*PollBatchRunResult) Descriptor() ([]byte, []int) {
+	return file_Local_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *PollBatchRunResult) GetTests() []*LocalBatchRunResult_Test {
+	if x != nil {
+		return x.Tests
+	}
+	return nil
+}
+
+func (x *PollBatchRunResult) GetDone() bool {
+	if x != nil {
+		return x.Done
+	}
+	return false
+}
+
+func (x *PollBatchRunResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// Processes exchanging messages through the service, see subprocess.RunCommunication for the protocol.
+type LocalRunCommunication struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Processes      []*LocalExecutionParameters `protobuf:"bytes,1,rep,name=processes,proto3" json:"processes,omitempty"`
+	MaxMessageSize uint32                      `protobuf:"varint,2,opt,name=max_message_size,json=maxMessageSize,proto3" json:"max_message_size,omitempty"`
+	// If set, messages on other routes are dropped.
+	Routes []*LocalRunCommunication_Route `protobuf:"bytes,3,rep,name=routes,proto3" json:"routes,omitempty"`
+}
+
+func (x *LocalRunCommunication) Reset() {
+	*x = LocalRunCommunication{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_Local_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LocalRunCommunication) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LocalRunCommunication) ProtoMessage() {}
+
+func (x *LocalRunCommunication) ProtoReflect() protoreflect.Message {
+	mi := &file_Local_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LocalRunCommunication.ProtoReflect.Descriptor instead.
+func (*LocalRunCommunication) Descriptor() ([]byte, []int) {
+	return file_Local_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *LocalRunCommunication) GetProcesses() []*LocalExecutionParameters {
+	if x != nil {
+		return x.Processes
+	}
+	return nil
+}
+
+func (x *LocalRunCommunication) GetMaxMessageSize() uint32 {
+	if x != nil {
+		return x.MaxMessageSize
+	}
+	return 0
+}
+
+func (x *LocalRunCommunication) GetRoutes() []*LocalRunCommunication_Route {
+	if x != nil {
+		return x.Routes
+	}
+	return nil
+}
+
+type LocalRunCommunicationResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Results []*LocalExecutionResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	// All processes were blocked receiving.
+	Deadlock bool `protobuf:"varint,2,opt,name=deadlock,proto3" json:"deadlock,omitempty"`
+	// Messages routed, rows[from].sent[to].
+	Messages       []*LocalRunCommunicationResult_Row `protobuf:"bytes,3,rep,name=messages,proto3" json:"messages,omitempty"`
+	ProtocolErrors []string                           `protobuf:"bytes,4,rep,name=protocol_errors,json=protocolErrors,proto3" json:"protocol_errors,omitempty"`
+}
+
+func (x *LocalRunCommunicationResult) Reset() {
+	*x = LocalRunCommunicationResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_Local_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LocalRunCommunicationResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LocalRunCommunicationResult) ProtoMessage() {}
+
+func (x *LocalRunCommunicationResult) ProtoReflect() protoreflect.Message {
+	mi := &file_Local_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LocalRunCommunicationResult.ProtoReflect.Descriptor instead.
+func (*LocalRunCommunicationResult) Descriptor() ([]byte, []int) {
+	return file_Local_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *LocalRunCommunicationResult) GetResults() []*LocalExecutionResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *LocalRunCommunicationResult) GetDeadlock() bool {
+	if x != nil {
+		return x.Deadlock
+	}
+	return false
+}
+
+func (x *LocalRunCommunicationResult) GetMessages() []*LocalRunCommunicationResult_Row {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
+}
+
+func (x *LocalRunCommunicationResult) GetProtocolErrors() []string {
+	if x != nil {
+		return x.ProtocolErrors
+	}
+	return nil
+}
+
+type LocalExecution struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Parameters *LocalExecutionParameters `protobuf:"bytes,1,opt,name=parameters,proto3" json:"parameters,omitempty"`
+	Result     *LocalExecutionResult     `protobuf:"bytes,2,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (x *LocalExecution) Reset() {
+	*x = LocalExecution{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_Local_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LocalExecution) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LocalExecution) ProtoMessage() {}
+
+func (x *LocalExecution) ProtoReflect() protoreflect.Message {
+	mi := &file_Local_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LocalExecution.ProtoReflect.Descriptor instead.
+func (*LocalExecution) Descriptor() ([]byte, []int) {
+	return file_Local_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *LocalExecution) GetParameters() *LocalExecutionParameters {
+	if x != nil {
+		return x.Parameters
+	}
+	return nil
+}
+
+func (x *LocalExecution) GetResult() *LocalExecutionResult {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type BinaryTypeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pathname string `protobuf:"bytes,1,opt,name=pathname,proto3" json:"pathname,omitempty"`
 }
 
-func (x *LocalExecuteConnectedResult) Reset() {
-	*x = LocalExecuteConnectedResult{}
+func (x *BinaryTypeRequest) Reset() {
+	*x = BinaryTypeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_Local_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BinaryTypeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BinaryTypeRequest) ProtoMessage() {}
+
+func (x *BinaryTypeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_Local_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BinaryTypeRequest.ProtoReflect.Descriptor instead.
+func (*BinaryTypeRequest) Descriptor() ([]byte, []int) {
+	return file_Local_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *BinaryTypeRequest) GetPathname() string {
+	if x != nil {
+		return x.Pathname
+	}
+	return ""
+}
+
+type BinaryTypeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Failure bool                               `protobuf:"varint,1,opt,name=failure,proto3" json:"failure,omitempty"`
+	Result  BinaryTypeResponse_Win32BinaryType `protobuf:"varint,2,opt,name=result,proto3,enum=contester.proto.BinaryTypeResponse_Win32BinaryType" json:"result,omitempty"`
+}
+
+func (x *BinaryTypeResponse) Reset() {
+	*x = BinaryTypeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_Local_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BinaryTypeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BinaryTypeResponse) ProtoMessage() {}
+
+func (x *BinaryTypeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_Local_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BinaryTypeResponse.ProtoReflect.Descriptor instead.
+func (*BinaryTypeResponse) Descriptor() ([]byte, []int) {
+	return file_Local_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *BinaryTypeResponse) GetFailure() bool {
+	if x != nil {
+		return x.Failure
+	}
+	return false
+}
+
+func (x *BinaryTypeResponse) GetResult() BinaryTypeResponse_Win32BinaryType {
+	if x != nil {
+		return x.Result
+	}
+	return BinaryTypeResponse_SCS_32BIT_BINARY
+}
+
+type ClearSandboxRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sandbox string `protobuf:"bytes,1,opt,name=sandbox,proto3" json:"sandbox,omitempty"`
+}
+
+func (x *ClearSandboxRequest) Reset() {
+	*x = ClearSandboxRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_Local_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClearSandboxRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearSandboxRequest) ProtoMessage() {}
+
+func (x *ClearSandboxRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_Local_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearSandboxRequest.ProtoReflect.Descriptor instead.
+func (*ClearSandboxRequest) Descriptor() ([]byte, []int) {
+	return file_Local_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ClearSandboxRequest) GetSandbox() string {
+	if x != nil {
+		return x.Sandbox
+	}
+	return ""
+}
+
+type IdentifyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ContesterId string `protobuf:"bytes,1,opt,name=contester_id,json=contesterId,proto3" json:"contester_id,omitempty"`
+}
+
+func (x *IdentifyRequest) Reset() {
+	*x = IdentifyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_Local_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IdentifyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IdentifyRequest) ProtoMessage() {}
+
+func (x *IdentifyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_Local_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IdentifyRequest.ProtoReflect.Descriptor instead.
+func (*IdentifyRequest) Descriptor() ([]byte, []int) {
+	return file_Local_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *IdentifyRequest) GetContesterId() string {
+	if x != nil {
+		return x.ContesterId
+	}
+	return ""
+}
+
+type SandboxLocations struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Compile string `protobuf:"bytes,1,opt,name=compile,proto3" json:"compile,omitempty"`
+	Run     string `protobuf:"bytes,2,opt,name=run,proto3" json:"run,omitempty"`
+}
+
+func (x *SandboxLocations) Reset() {
+	*x = SandboxLocations{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_Local_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SandboxLocations) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SandboxLocations) ProtoMessage() {}
+
+func (x *SandboxLocations) ProtoReflect() protoreflect.Message {
+	mi := &file_Local_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SandboxLocations.ProtoReflect.Descriptor instead.
+func (*SandboxLocations) Descriptor() ([]byte, []int) {
+	return file_Local_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *SandboxLocations) GetCompile() string {
+	if x != nil {
+		return x.Compile
+	}
+	return ""
+}
+
+func (x *SandboxLocations) GetRun() string {
+	if x != nil {
+		return x.Run
+	}
+	return ""
+}
+
+type IdentifyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InvokerId     string              `protobuf:"bytes,1,opt,name=invoker_id,json=invokerId,proto3" json:"invoker_id,omitempty"`
+	Sandboxes     []*SandboxLocations `protobuf:"bytes,2,rep,name=sandboxes,proto3" json:"sandboxes,omitempty"`
+	Environment   *LocalEnvironment   `protobuf:"bytes,3,opt,name=environment,proto3" json:"environment,omitempty"`
+	Platform      string              `protobuf:"bytes,4,opt,name=platform,proto3" json:"platform,omitempty"`
+	PathSeparator string              `protobuf:"bytes,5,opt,name=path_separator,json=pathSeparator,proto3" json:"path_separator,omitempty"`
+	Disks         []string            `protobuf:"bytes,6,rep,name=disks,proto3" json:"disks,omitempty"`
+	ProgramFiles  []string            `protobuf:"bytes,7,rep,name=programFiles,proto3" json:"programFiles,omitempty"`
+}
+
+func (x *IdentifyResponse) Reset() {
+	*x = IdentifyResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_Local_proto_msgTypes[4]
+		mi := &file_Local_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *LocalExecuteConnectedResult) String() string {
+func (x *IdentifyResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LocalExecuteConnectedResult) ProtoMessage() {}
+func (*IdentifyResponse) ProtoMessage() {}
 
-func (x *LocalExecuteConnectedResult) ProtoReflect() protoreflect.Message {
-	mi := &file_Local_proto_msgTypes[4]
+func (x *IdentifyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_Local_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -529,105 +3541,86 @@ func (x *LocalExecuteConnectedResult) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LocalExecuteConnectedResult.ProtoReflect.Descriptor instead.
-func (*LocalExecuteConnectedResult) Descriptor() ([]byte, []int) {
-	return file_Local_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use IdentifyResponse.ProtoReflect.Descriptor instead.
+func (*IdentifyResponse) Descriptor() ([]byte, []int) {
+	return file_Local_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *LocalExecuteConnectedResult) GetFirst() *LocalExecutionResult {
+func (x *IdentifyResponse) GetInvokerId() string {
 	if x != nil {
-		return x.First
+		return x.InvokerId
 	}
-	return nil
+	return ""
 }
 
-func (x *LocalExecuteConnectedResult) GetSecond() *LocalExecutionResult {
+func (x *IdentifyResponse) GetSandboxes() []*SandboxLocations {
 	if x != nil {
-		return x.Second
+		return x.Sandboxes
 	}
 	return nil
 }
 
-type LocalExecution struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Parameters *LocalExecutionParameters `protobuf:"bytes,1,opt,name=parameters,proto3" json:"parameters,omitempty"`
-	Result     *LocalExecutionResult     `protobuf:"bytes,2,opt,name=result,proto3" json:"result,omitempty"`
-}
-
-func (x *LocalExecution) Reset() {
-	*x = LocalExecution{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_Local_proto_msgTypes[5]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *IdentifyResponse) GetEnvironment() *LocalEnvironment {
+	if x != nil {
+		return x.Environment
 	}
+	return nil
 }
 
-func (x *LocalExecution) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*LocalExecution) ProtoMessage() {}
-
-func (x *LocalExecution) ProtoReflect() protoreflect.Message {
-	mi := &file_Local_proto_msgTypes[5]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *IdentifyResponse) GetPlatform() string {
+	if x != nil {
+		return x.Platform
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use LocalExecution.ProtoReflect.Descriptor instead.
-func (*LocalExecution) Descriptor() ([]byte, []int) {
-	return file_Local_proto_rawDescGZIP(), []int{5}
+func (x *IdentifyResponse) GetPathSeparator() string {
+	if x != nil {
+		return x.PathSeparator
+	}
+	return ""
 }
 
-func (x *LocalExecution) GetParameters() *LocalExecutionParameters {
+func (x *IdentifyResponse) GetDisks() []string {
 	if x != nil {
-		return x.Parameters
+		return x.Disks
 	}
 	return nil
 }
 
-func (x *LocalExecution) GetResult() *LocalExecutionResult {
+func (x *IdentifyResponse) GetProgramFiles() []string {
 	if x != nil {
-		return x.Result
+		return x.ProgramFiles
 	}
 	return nil
 }
 
-type BinaryTypeRequest struct {
+type CheckLimitsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Pathname string `protobuf:"bytes,1,opt,name=pathname,proto3" json:"pathname,omitempty"`
+	// Only limits and options are looked at, nothing is run.
+	Parameters *LocalExecutionParameters `protobuf:"bytes,1,opt,name=parameters,proto3" json:"parameters,omitempty"`
 }
 
-func (x *BinaryTypeRequest) Reset() {
-	*x = BinaryTypeRequest{}
+func (x *CheckLimitsRequest) Reset() {
+	*x = CheckLimitsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_Local_proto_msgTypes[6]
+		mi := &file_Local_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *BinaryTypeRequest) String() string {
+func (x *CheckLimitsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BinaryTypeRequest) ProtoMessage() {}
+func (*CheckLimitsRequest) ProtoMessage() {}
 
-func (x *BinaryTypeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_Local_proto_msgTypes[6]
+func (x *CheckLimitsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_Local_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -638,44 +3631,46 @@ func (x *BinaryTypeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BinaryTypeRequest.ProtoReflect.Descriptor instead.
-func (*BinaryTypeRequest) Descriptor() ([]byte, []int) {
-	return file_Local_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use CheckLimitsRequest.ProtoReflect.Descriptor instead.
+func (*CheckLimitsRequest) Descriptor() ([]byte, []int) {
+	return file_Local_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *BinaryTypeRequest) GetPathname() string {
+func (x *CheckLimitsRequest) GetParameters() *LocalExecutionParameters {
 	if x != nil {
-		return x.Pathname
+		return x.Parameters
 	}
-	return ""
+	return nil
 }
 
-type BinaryTypeResponse struct {
+type CheckLimitsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Failure bool                               `protobuf:"varint,1,opt,name=failure,proto3" json:"failure,omitempty"`
-	Result  BinaryTypeResponse_Win32BinaryType `protobuf:"varint,2,opt,name=result,proto3,enum=contester.proto.BinaryTypeResponse_Win32BinaryType" json:"result,omitempty"`
+	// Only limits set in the request are reported.
+	Limits         []*CheckLimitsResponse_Limit `protobuf:"bytes,1,rep,name=limits,proto3" json:"limits,omitempty"`
+	PhysicalMemory uint64                       `protobuf:"varint,2,opt,name=physical_memory,json=physicalMemory,proto3" json:"physical_memory,omitempty"`
+	CpuCount       uint32                       `protobuf:"varint,3,opt,name=cpu_count,json=cpuCount,proto3" json:"cpu_count,omitempty"`
 }
 
-func (x *BinaryTypeResponse) Reset() {
-	*x = BinaryTypeResponse{}
+func (x *CheckLimitsResponse) Reset() {
+	*x = CheckLimitsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_Local_proto_msgTypes[7]
+		mi := &file_Local_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *BinaryTypeResponse) String() string {
+func (x *CheckLimitsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BinaryTypeResponse) ProtoMessage() {}
+func (*CheckLimitsResponse) ProtoMessage() {}
 
-func (x *BinaryTypeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_Local_proto_msgTypes[7]
+func (x *CheckLimitsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_Local_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -686,50 +3681,60 @@ func (x *BinaryTypeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BinaryTypeResponse.ProtoReflect.Descriptor instead.
-func (*BinaryTypeResponse) Descriptor() ([]byte, []int) {
-	return file_Local_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use CheckLimitsResponse.ProtoReflect.Descriptor instead.
+func (*CheckLimitsResponse) Descriptor() ([]byte, []int) {
+	return file_Local_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *BinaryTypeResponse) GetFailure() bool {
+func (x *CheckLimitsResponse) GetLimits() []*CheckLimitsResponse_Limit {
 	if x != nil {
-		return x.Failure
+		return x.Limits
 	}
-	return false
+	return nil
 }
 
-func (x *BinaryTypeResponse) GetResult() BinaryTypeResponse_Win32BinaryType {
+func (x *CheckLimitsResponse) GetPhysicalMemory() uint64 {
 	if x != nil {
-		return x.Result
+		return x.PhysicalMemory
 	}
-	return BinaryTypeResponse_SCS_32BIT_BINARY
+	return 0
 }
 
-type ClearSandboxRequest struct {
+func (x *CheckLimitsResponse) GetCpuCount() uint32 {
+	if x != nil {
+		return x.CpuCount
+	}
+	return 0
+}
+
+type FileStat struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Sandbox string `protobuf:"bytes,1,opt,name=sandbox,proto3" json:"sandbox,omitempty"`
+	Name        string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	IsDirectory bool   `protobuf:"varint,2,opt,name=is_directory,json=isDirectory,proto3" json:"is_directory,omitempty"`
+	Size        uint64 `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	Checksum    string `protobuf:"bytes,4,opt,name=checksum,proto3" json:"checksum,omitempty"`
 }
 
-func (x *ClearSandboxRequest) Reset() {
-	*x = ClearSandboxRequest{}
+func (x *FileStat) Reset() {
+	*x = FileStat{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_Local_proto_msgTypes[8]
+		mi := &file_Local_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ClearSandboxRequest) String() string {
+func (x *FileStat) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ClearSandboxRequest) ProtoMessage() {}
+func (*FileStat) ProtoMessage() {}
 
-func (x *ClearSandboxRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_Local_proto_msgTypes[8]
+func (x *FileStat) ProtoReflect() protoreflect.Message {
+	mi := &file_Local_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -740,43 +3745,67 @@ func (x *ClearSandboxRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ClearSandboxRequest.ProtoReflect.Descriptor instead.
-func (*ClearSandboxRequest) Descriptor() ([]byte, []int) {
-	return file_Local_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use FileStat.ProtoReflect.Descriptor instead.
+func (*FileStat) Descriptor() ([]byte, []int) {
+	return file_Local_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *ClearSandboxRequest) GetSandbox() string {
+func (x *FileStat) GetName() string {
 	if x != nil {
-		return x.Sandbox
+		return x.Name
 	}
 	return ""
 }
 
-type IdentifyRequest struct {
+func (x *FileStat) GetIsDirectory() bool {
+	if x != nil {
+		return x.IsDirectory
+	}
+	return false
+}
+
+func (x *FileStat) GetSize() uint64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *FileStat) GetChecksum() string {
+	if x != nil {
+		return x.Checksum
+	}
+	return ""
+}
+
+type StatRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ContesterId string `protobuf:"bytes,1,opt,name=contester_id,json=contesterId,proto3" json:"contester_id,omitempty"`
+	Name              []string `protobuf:"bytes,1,rep,name=name,proto3" json:"name,omitempty"`
+	SandboxId         string   `protobuf:"bytes,2,opt,name=sandbox_id,json=sandboxId,proto3" json:"sandbox_id,omitempty"`
+	Expand            bool     `protobuf:"varint,3,opt,name=expand,proto3" json:"expand,omitempty"`
+	CalculateChecksum bool     `protobuf:"varint,4,opt,name=calculate_checksum,json=calculateChecksum,proto3" json:"calculate_checksum,omitempty"`
 }
 
-func (x *IdentifyRequest) Reset() {
-	*x = IdentifyRequest{}
+func (x *StatRequest) Reset() {
+	*x = StatRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_Local_proto_msgTypes[9]
+		mi := &file_Local_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *IdentifyRequest) String() string {
+func (x *StatRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IdentifyRequest) ProtoMessage() {}
+func (*StatRequest) ProtoMessage() {}
 
-func (x *IdentifyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_Local_proto_msgTypes[9]
+func (x *StatRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_Local_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -787,44 +3816,64 @@ func (x *IdentifyRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use IdentifyRequest.ProtoReflect.Descriptor instead.
-func (*IdentifyRequest) Descriptor() ([]byte, []int) {
-	return file_Local_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use StatRequest.ProtoReflect.Descriptor instead.
+func (*StatRequest) Descriptor() ([]byte, []int) {
+	return file_Local_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *IdentifyRequest) GetContesterId() string {
+func (x *StatRequest) GetName() []string {
 	if x != nil {
-		return x.ContesterId
+		return x.Name
+	}
+	return nil
+}
+
+func (x *StatRequest) GetSandboxId() string {
+	if x != nil {
+		return x.SandboxId
 	}
 	return ""
 }
 
-type SandboxLocations struct {
+func (x *StatRequest) GetExpand() bool {
+	if x != nil {
+		return x.Expand
+	}
+	return false
+}
+
+func (x *StatRequest) GetCalculateChecksum() bool {
+	if x != nil {
+		return x.CalculateChecksum
+	}
+	return false
+}
+
+type FileStats struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Compile string `protobuf:"bytes,1,opt,name=compile,proto3" json:"compile,omitempty"`
-	Run     string `protobuf:"bytes,2,opt,name=run,proto3" json:"run,omitempty"`
+	Entries []*FileStat `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
 }
 
-func (x *SandboxLocations) Reset() {
-	*x = SandboxLocations{}
+func (x *FileStats) Reset() {
+	*x = FileStats{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_Local_proto_msgTypes[10]
+		mi := &file_Local_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SandboxLocations) String() string {
+func (x *FileStats) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SandboxLocations) ProtoMessage() {}
+func (*FileStats) ProtoMessage() {}
 
-func (x *SandboxLocations) ProtoReflect() protoreflect.Message {
-	mi := &file_Local_proto_msgTypes[10]
+func (x *FileStats) ProtoReflect() protoreflect.Message {
+	mi := &file_Local_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -835,56 +3884,43 @@ func (x *SandboxLocations) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SandboxLocations.ProtoReflect.Descriptor instead.
-func (*SandboxLocations) Descriptor() ([]byte, []int) {
-	return file_Local_proto_rawDescGZIP(), []int{10}
-}
-
-func (x *SandboxLocations) GetCompile() string {
-	if x != nil {
-		return x.Compile
-	}
-	return ""
+// Deprecated: Use FileStats.ProtoReflect.Descriptor instead.
+func (*FileStats) Descriptor() ([]byte, []int) {
+	return file_Local_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *SandboxLocations) GetRun() string {
+func (x *FileStats) GetEntries() []*FileStat {
 	if x != nil {
-		return x.Run
+		return x.Entries
 	}
-	return ""
+	return nil
 }
 
-type IdentifyResponse struct {
+type GetRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	InvokerId     string              `protobuf:"bytes,1,opt,name=invoker_id,json=invokerId,proto3" json:"invoker_id,omitempty"`
-	Sandboxes     []*SandboxLocations `protobuf:"bytes,2,rep,name=sandboxes,proto3" json:"sandboxes,omitempty"`
-	Environment   *LocalEnvironment   `protobuf:"bytes,3,opt,name=environment,proto3" json:"environment,omitempty"`
-	Platform      string              `protobuf:"bytes,4,opt,name=platform,proto3" json:"platform,omitempty"`
-	PathSeparator string              `protobuf:"bytes,5,opt,name=path_separator,json=pathSeparator,proto3" json:"path_separator,omitempty"`
-	Disks         []string            `protobuf:"bytes,6,rep,name=disks,proto3" json:"disks,omitempty"`
-	ProgramFiles  []string            `protobuf:"bytes,7,rep,name=programFiles,proto3" json:"programFiles,omitempty"`
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func (x *IdentifyResponse) Reset() {
-	*x = IdentifyResponse{}
+func (x *GetRequest) Reset() {
+	*x = GetRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_Local_proto_msgTypes[11]
+		mi := &file_Local_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *IdentifyResponse) String() string {
+func (x *GetRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IdentifyResponse) ProtoMessage() {}
+func (*GetRequest) ProtoMessage() {}
 
-func (x *IdentifyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_Local_proto_msgTypes[11]
+func (x *GetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_Local_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -895,88 +3931,86 @@ func (x *IdentifyResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use IdentifyResponse.ProtoReflect.Descriptor instead.
-func (*IdentifyResponse) Descriptor() ([]byte, []int) {
-	return file_Local_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use GetRequest.ProtoReflect.Descriptor instead.
+func (*GetRequest) Descriptor() ([]byte, []int) {
+	return file_Local_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *IdentifyResponse) GetInvokerId() string {
+func (x *GetRequest) GetName() string {
 	if x != nil {
-		return x.InvokerId
+		return x.Name
 	}
 	return ""
 }
 
-func (x *IdentifyResponse) GetSandboxes() []*SandboxLocations {
-	if x != nil {
-		return x.Sandboxes
-	}
-	return nil
+type EmptyMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 }
 
-func (x *IdentifyResponse) GetEnvironment() *LocalEnvironment {
-	if x != nil {
-		return x.Environment
+func (x *EmptyMessage) Reset() {
+	*x = EmptyMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_Local_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *IdentifyResponse) GetPlatform() string {
-	if x != nil {
-		return x.Platform
-	}
-	return ""
+func (x *EmptyMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *IdentifyResponse) GetPathSeparator() string {
-	if x != nil {
-		return x.PathSeparator
-	}
-	return ""
-}
+func (*EmptyMessage) ProtoMessage() {}
 
-func (x *IdentifyResponse) GetDisks() []string {
-	if x != nil {
-		return x.Disks
+func (x *EmptyMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_Local_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *IdentifyResponse) GetProgramFiles() []string {
-	if x != nil {
-		return x.ProgramFiles
-	}
-	return nil
+// Deprecated: Use EmptyMessage.ProtoReflect.Descriptor instead.
+func (*EmptyMessage) Descriptor() ([]byte, []int) {
+	return file_Local_proto_rawDescGZIP(), []int{35}
 }
 
-type FileStat struct {
+type CopyOperation struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name        string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	IsDirectory bool   `protobuf:"varint,2,opt,name=is_directory,json=isDirectory,proto3" json:"is_directory,omitempty"`
-	Size        uint64 `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
-	Checksum    string `protobuf:"bytes,4,opt,name=checksum,proto3" json:"checksum,omitempty"`
+	LocalFileName      string `protobuf:"bytes,1,opt,name=local_file_name,json=localFileName,proto3" json:"local_file_name,omitempty"`
+	RemoteLocation     string `protobuf:"bytes,2,opt,name=remote_location,json=remoteLocation,proto3" json:"remote_location,omitempty"`
+	Upload             bool   `protobuf:"varint,3,opt,name=upload,proto3" json:"upload,omitempty"`
+	Checksum           string `protobuf:"bytes,4,opt,name=checksum,proto3" json:"checksum,omitempty"`
+	ModuleType         string `protobuf:"bytes,5,opt,name=module_type,json=moduleType,proto3" json:"module_type,omitempty"`
+	AuthorizationToken string `protobuf:"bytes,6,opt,name=authorization_token,json=authorizationToken,proto3" json:"authorization_token,omitempty"`
 }
 
-func (x *FileStat) Reset() {
-	*x = FileStat{}
+func (x *CopyOperation) Reset() {
+	*x = CopyOperation{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_Local_proto_msgTypes[12]
+		mi := &file_Local_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *FileStat) String() string {
+func (x *CopyOperation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FileStat) ProtoMessage() {}
+func (*CopyOperation) ProtoMessage() {}
 
-func (x *FileStat) ProtoReflect() protoreflect.Message {
-	mi := &file_Local_proto_msgTypes[12]
+func (x *CopyOperation) ProtoReflect() protoreflect.Message {
+	mi := &file_Local_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -987,67 +4021,79 @@ func (x *FileStat) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FileStat.ProtoReflect.Descriptor instead.
-func (*FileStat) Descriptor() ([]byte, []int) {
-	return file_Local_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use CopyOperation.ProtoReflect.Descriptor instead.
+func (*CopyOperation) Descriptor() ([]byte, []int) {
+	return file_Local_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *FileStat) GetName() string {
+func (x *CopyOperation) GetLocalFileName() string {
 	if x != nil {
-		return x.Name
+		return x.LocalFileName
 	}
 	return ""
 }
 
-func (x *FileStat) GetIsDirectory() bool {
+func (x *CopyOperation) GetRemoteLocation() string {
 	if x != nil {
-		return x.IsDirectory
+		return x.RemoteLocation
 	}
-	return false
+	return ""
 }
 
-func (x *FileStat) GetSize() uint64 {
+func (x *CopyOperation) GetUpload() bool {
 	if x != nil {
-		return x.Size
+		return x.Upload
 	}
-	return 0
+	return false
 }
 
-func (x *FileStat) GetChecksum() string {
+func (x *CopyOperation) GetChecksum() string {
 	if x != nil {
 		return x.Checksum
 	}
 	return ""
 }
 
-type StatRequest struct {
+func (x *CopyOperation) GetModuleType() string {
+	if x != nil {
+		return x.ModuleType
+	}
+	return ""
+}
+
+func (x *CopyOperation) GetAuthorizationToken() string {
+	if x != nil {
+		return x.AuthorizationToken
+	}
+	return ""
+}
+
+type CopyOperations struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name              []string `protobuf:"bytes,1,rep,name=name,proto3" json:"name,omitempty"`
-	SandboxId         string   `protobuf:"bytes,2,opt,name=sandbox_id,json=sandboxId,proto3" json:"sandbox_id,omitempty"`
-	Expand            bool     `protobuf:"varint,3,opt,name=expand,proto3" json:"expand,omitempty"`
-	CalculateChecksum bool     `protobuf:"varint,4,opt,name=calculate_checksum,json=calculateChecksum,proto3" json:"calculate_checksum,omitempty"`
+	Entries   []*CopyOperation `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	SandboxId string           `protobuf:"bytes,2,opt,name=sandbox_id,json=sandboxId,proto3" json:"sandbox_id,omitempty"`
 }
 
-func (x *StatRequest) Reset() {
-	*x = StatRequest{}
+func (x *CopyOperations) Reset() {
+	*x = CopyOperations{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_Local_proto_msgTypes[13]
+		mi := &file_Local_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *StatRequest) String() string {
+func (x *CopyOperations) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StatRequest) ProtoMessage() {}
+func (*CopyOperations) ProtoMessage() {}
 
-func (x *StatRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_Local_proto_msgTypes[13]
+func (x *CopyOperations) ProtoReflect() protoreflect.Message {
+	mi := &file_Local_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1058,64 +4104,51 @@ func (x *StatRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StatRequest.ProtoReflect.Descriptor instead.
-func (*StatRequest) Descriptor() ([]byte, []int) {
-	return file_Local_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use CopyOperations.ProtoReflect.Descriptor instead.
+func (*CopyOperations) Descriptor() ([]byte, []int) {
+	return file_Local_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *StatRequest) GetName() []string {
+func (x *CopyOperations) GetEntries() []*CopyOperation {
 	if x != nil {
-		return x.Name
+		return x.Entries
 	}
 	return nil
 }
 
-func (x *StatRequest) GetSandboxId() string {
+func (x *CopyOperations) GetSandboxId() string {
 	if x != nil {
 		return x.SandboxId
 	}
 	return ""
 }
 
-func (x *StatRequest) GetExpand() bool {
-	if x != nil {
-		return x.Expand
-	}
-	return false
-}
-
-func (x *StatRequest) GetCalculateChecksum() bool {
-	if x != nil {
-		return x.CalculateChecksum
-	}
-	return false
-}
-
-type FileStats struct {
+type NamePair struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Entries []*FileStat `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	Source      string `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	Destination string `protobuf:"bytes,2,opt,name=destination,proto3" json:"destination,omitempty"`
 }
 
-func (x *FileStats) Reset() {
-	*x = FileStats{}
+func (x *NamePair) Reset() {
+	*x = NamePair{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_Local_proto_msgTypes[14]
+		mi := &file_Local_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *FileStats) String() string {
+func (x *NamePair) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FileStats) ProtoMessage() {}
+func (*NamePair) ProtoMessage() {}
 
-func (x *FileStats) ProtoReflect() protoreflect.Message {
-	mi := &file_Local_proto_msgTypes[14]
+func (x *NamePair) ProtoReflect() protoreflect.Message {
+	mi := &file_Local_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1126,43 +4159,51 @@ func (x *FileStats) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FileStats.ProtoReflect.Descriptor instead.
-func (*FileStats) Descriptor() ([]byte, []int) {
-	return file_Local_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use NamePair.ProtoReflect.Descriptor instead.
+func (*NamePair) Descriptor() ([]byte, []int) {
+	return file_Local_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *FileStats) GetEntries() []*FileStat {
+func (x *NamePair) GetSource() string {
 	if x != nil {
-		return x.Entries
+		return x.Source
 	}
-	return nil
+	return ""
 }
 
-type GetRequest struct {
+func (x *NamePair) GetDestination() string {
+	if x != nil {
+		return x.Destination
+	}
+	return ""
+}
+
+type RepeatedNamePairEntries struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Entries   []*NamePair `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	SandboxId string      `protobuf:"bytes,2,opt,name=sandbox_id,json=sandboxId,proto3" json:"sandbox_id,omitempty"`
 }
 
-func (x *GetRequest) Reset() {
-	*x = GetRequest{}
+func (x *RepeatedNamePairEntries) Reset() {
+	*x = RepeatedNamePairEntries{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_Local_proto_msgTypes[15]
+		mi := &file_Local_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetRequest) String() string {
+func (x *RepeatedNamePairEntries) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetRequest) ProtoMessage() {}
+func (*RepeatedNamePairEntries) ProtoMessage() {}
 
-func (x *GetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_Local_proto_msgTypes[15]
+func (x *RepeatedNamePairEntries) ProtoReflect() protoreflect.Message {
+	mi := &file_Local_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1173,41 +4214,50 @@ func (x *GetRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetRequest.ProtoReflect.Descriptor instead.
-func (*GetRequest) Descriptor() ([]byte, []int) {
-	return file_Local_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use RepeatedNamePairEntries.ProtoReflect.Descriptor instead.
+func (*RepeatedNamePairEntries) Descriptor() ([]byte, []int) {
+	return file_Local_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *GetRequest) GetName() string {
+func (x *RepeatedNamePairEntries) GetEntries() []*NamePair {
 	if x != nil {
-		return x.Name
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *RepeatedNamePairEntries) GetSandboxId() string {
+	if x != nil {
+		return x.SandboxId
 	}
 	return ""
 }
 
-type EmptyMessage struct {
+type RepeatedStringEntries struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Entries []string `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
 }
 
-func (x *EmptyMessage) Reset() {
-	*x = EmptyMessage{}
+func (x *RepeatedStringEntries) Reset() {
+	*x = RepeatedStringEntries{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_Local_proto_msgTypes[16]
+		mi := &file_Local_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *EmptyMessage) String() string {
+func (x *RepeatedStringEntries) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EmptyMessage) ProtoMessage() {}
+func (*RepeatedStringEntries) ProtoMessage() {}
 
-func (x *EmptyMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_Local_proto_msgTypes[16]
+func (x *RepeatedStringEntries) ProtoReflect() protoreflect.Message {
+	mi := &file_Local_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1218,41 +4268,45 @@ func (x *EmptyMessage) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EmptyMessage.ProtoReflect.Descriptor instead.
-func (*EmptyMessage) Descriptor() ([]byte, []int) {
-	return file_Local_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use RepeatedStringEntries.ProtoReflect.Descriptor instead.
+func (*RepeatedStringEntries) Descriptor() ([]byte, []int) {
+	return file_Local_proto_rawDescGZIP(), []int{40}
 }
 
-type CopyOperation struct {
+func (x *RepeatedStringEntries) GetEntries() []string {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type LocalEnvironment_Variable struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	LocalFileName      string `protobuf:"bytes,1,opt,name=local_file_name,json=localFileName,proto3" json:"local_file_name,omitempty"`
-	RemoteLocation     string `protobuf:"bytes,2,opt,name=remote_location,json=remoteLocation,proto3" json:"remote_location,omitempty"`
-	Upload             bool   `protobuf:"varint,3,opt,name=upload,proto3" json:"upload,omitempty"`
-	Checksum           string `protobuf:"bytes,4,opt,name=checksum,proto3" json:"checksum,omitempty"`
-	ModuleType         string `protobuf:"bytes,5,opt,name=module_type,json=moduleType,proto3" json:"module_type,omitempty"`
-	AuthorizationToken string `protobuf:"bytes,6,opt,name=authorization_token,json=authorizationToken,proto3" json:"authorization_token,omitempty"`
+	Name   string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Value  string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Expand bool   `protobuf:"varint,3,opt,name=expand,proto3" json:"expand,omitempty"`
 }
 
-func (x *CopyOperation) Reset() {
-	*x = CopyOperation{}
+func (x *LocalEnvironment_Variable) Reset() {
+	*x = LocalEnvironment_Variable{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_Local_proto_msgTypes[17]
+		mi := &file_Local_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CopyOperation) String() string {
+func (x *LocalEnvironment_Variable) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CopyOperation) ProtoMessage() {}
+func (*LocalEnvironment_Variable) ProtoMessage() {}
 
-func (x *CopyOperation) ProtoReflect() protoreflect.Message {
-	mi := &file_Local_proto_msgTypes[17]
+func (x *LocalEnvironment_Variable) ProtoReflect() protoreflect.Message {
+	mi := &file_Local_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1263,79 +4317,59 @@ func (x *CopyOperation) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CopyOperation.ProtoReflect.Descriptor instead.
-func (*CopyOperation) Descriptor() ([]byte, []int) {
-	return file_Local_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use LocalEnvironment_Variable.ProtoReflect.Descriptor instead.
+func (*LocalEnvironment_Variable) Descriptor() ([]byte, []int) {
+	return file_Local_proto_rawDescGZIP(), []int{0, 0}
 }
 
-func (x *CopyOperation) GetLocalFileName() string {
+func (x *LocalEnvironment_Variable) GetName() string {
 	if x != nil {
-		return x.LocalFileName
+		return x.Name
 	}
 	return ""
 }
 
-func (x *CopyOperation) GetRemoteLocation() string {
+func (x *LocalEnvironment_Variable) GetValue() string {
 	if x != nil {
-		return x.RemoteLocation
+		return x.Value
 	}
 	return ""
 }
 
-func (x *CopyOperation) GetUpload() bool {
+func (x *LocalEnvironment_Variable) GetExpand() bool {
 	if x != nil {
-		return x.Upload
+		return x.Expand
 	}
 	return false
 }
 
-func (x *CopyOperation) GetChecksum() string {
-	if x != nil {
-		return x.Checksum
-	}
-	return ""
-}
-
-func (x *CopyOperation) GetModuleType() string {
-	if x != nil {
-		return x.ModuleType
-	}
-	return ""
-}
-
-func (x *CopyOperation) GetAuthorizationToken() string {
-	if x != nil {
-		return x.AuthorizationToken
-	}
-	return ""
-}
-
-type CopyOperations struct {
+type KillSnapshot_Thread struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Entries   []*CopyOperation `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
-	SandboxId string           `protobuf:"bytes,2,opt,name=sandbox_id,json=sandboxId,proto3" json:"sandbox_id,omitempty"`
+	Id               uint32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserTimeMicros   uint64 `protobuf:"varint,2,opt,name=user_time_micros,json=userTimeMicros,proto3" json:"user_time_micros,omitempty"`
+	KernelTimeMicros uint64 `protobuf:"varint,3,opt,name=kernel_time_micros,json=kernelTimeMicros,proto3" json:"kernel_time_micros,omitempty"`
 }
 
-func (x *CopyOperations) Reset() {
-	*x = CopyOperations{}
+func (x *KillSnapshot_Thread) Reset() {
+	*x = KillSnapshot_Thread{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_Local_proto_msgTypes[18]
+		mi := &file_Local_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CopyOperations) String() string {
+func (x *KillSnapshot_Thread) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CopyOperations) ProtoMessage() {}
+func (*KillSnapshot_Thread) ProtoMessage() {}
 
-func (x *CopyOperations) ProtoReflect() protoreflect.Message {
-	mi := &file_Local_proto_msgTypes[18]
+func (x *KillSnapshot_Thread) ProtoReflect() protoreflect.Message {
+	mi := &file_Local_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1346,51 +4380,61 @@ func (x *CopyOperations) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CopyOperations.ProtoReflect.Descriptor instead.
-func (*CopyOperations) Descriptor() ([]byte, []int) {
-	return file_Local_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use KillSnapshot_Thread.ProtoReflect.Descriptor instead.
+func (*KillSnapshot_Thread) Descriptor() ([]byte, []int) {
+	return file_Local_proto_rawDescGZIP(), []int{8, 0}
 }
 
-func (x *CopyOperations) GetEntries() []*CopyOperation {
+func (x *KillSnapshot_Thread) GetId() uint32 {
 	if x != nil {
-		return x.Entries
+		return x.Id
 	}
-	return nil
+	return 0
 }
 
-func (x *CopyOperations) GetSandboxId() string {
+func (x *KillSnapshot_Thread) GetUserTimeMicros() uint64 {
 	if x != nil {
-		return x.SandboxId
+		return x.UserTimeMicros
 	}
-	return ""
+	return 0
 }
 
-type NamePair struct {
+func (x *KillSnapshot_Thread) GetKernelTimeMicros() uint64 {
+	if x != nil {
+		return x.KernelTimeMicros
+	}
+	return 0
+}
+
+type LocalBatchRunResult_Test struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Source      string `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
-	Destination string `protobuf:"bytes,2,opt,name=destination,proto3" json:"destination,omitempty"`
+	Status LocalBatchRunResult_Status `protobuf:"varint,1,opt,name=status,proto3,enum=contester.proto.LocalBatchRunResult_Status" json:"status,omitempty"`
+	// Unset if the test didn't start.
+	Result  *LocalExecutionResult       `protobuf:"bytes,2,opt,name=result,proto3" json:"result,omitempty"`
+	Note    string                      `protobuf:"bytes,3,opt,name=note,proto3" json:"note,omitempty"`
+	Verdict LocalBatchRunResult_Verdict `protobuf:"varint,4,opt,name=verdict,proto3,enum=contester.proto.LocalBatchRunResult_Verdict" json:"verdict,omitempty"`
 }
 
-func (x *NamePair) Reset() {
-	*x = NamePair{}
+func (x *LocalBatchRunResult_Test) Reset() {
+	*x = LocalBatchRunResult_Test{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_Local_proto_msgTypes[19]
+		mi := &file_Local_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *NamePair) String() string {
+func (x *LocalBatchRunResult_Test) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NamePair) ProtoMessage() {}
+func (*LocalBatchRunResult_Test) ProtoMessage() {}
 
-func (x *NamePair) ProtoReflect() protoreflect.Message {
-	mi := &file_Local_proto_msgTypes[19]
+func (x *LocalBatchRunResult_Test) ProtoReflect() protoreflect.Message {
+	mi := &file_Local_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1401,51 +4445,65 @@ func (x *NamePair) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NamePair.ProtoReflect.Descriptor instead.
-func (*NamePair) Descriptor() ([]byte, []int) {
-	return file_Local_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use LocalBatchRunResult_Test.ProtoReflect.Descriptor instead.
+func (*LocalBatchRunResult_Test) Descriptor() ([]byte, []int) {
+	return file_Local_proto_rawDescGZIP(), []int{16, 0}
 }
 
-func (x *NamePair) GetSource() string {
+func (x *LocalBatchRunResult_Test) GetStatus() LocalBatchRunResult_Status {
 	if x != nil {
-		return x.Source
+		return x.Status
 	}
-	return ""
+	return LocalBatchRunResult_COMPLETED
 }
 
-func (x *NamePair) GetDestination() string {
+func (x *LocalBatchRunResult_Test) GetResult() *LocalExecutionResult {
 	if x != nil {
-		return x.Destination
+		return x.Result
+	}
+	return nil
+}
+
+func (x *LocalBatchRunResult_Test) GetNote() string {
+	if x != nil {
+		return x.Note
 	}
 	return ""
 }
 
-type RepeatedNamePairEntries struct {
+func (x *LocalBatchRunResult_Test) GetVerdict() LocalBatchRunResult_Verdict {
+	if x != nil {
+		return x.Verdict
+	}
+	return LocalBatchRunResult_ACCEPTED
+}
+
+type LocalRunCommunication_Route struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Entries   []*NamePair `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
-	SandboxId string      `protobuf:"bytes,2,opt,name=sandbox_id,json=sandboxId,proto3" json:"sandbox_id,omitempty"`
+	From uint32 `protobuf:"varint,1,opt,name=from,proto3" json:"from,omitempty"`
+	To   uint32 `protobuf:"varint,2,opt,name=to,proto3" json:"to,omitempty"`
 }
 
-func (x *RepeatedNamePairEntries) Reset() {
-	*x = RepeatedNamePairEntries{}
+func (x *LocalRunCommunication_Route) Reset() {
+	*x = LocalRunCommunication_Route{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_Local_proto_msgTypes[20]
+		mi := &file_Local_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RepeatedNamePairEntries) String() string {
+func (x *LocalRunCommunication_Route) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RepeatedNamePairEntries) ProtoMessage() {}
+func (*LocalRunCommunication_Route) ProtoMessage() {}
 
-func (x *RepeatedNamePairEntries) ProtoReflect() protoreflect.Message {
-	mi := &file_Local_proto_msgTypes[20]
+func (x *LocalRunCommunication_Route) ProtoReflect() protoreflect.Message {
+	mi := &file_Local_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1456,50 +4514,50 @@ func (x *RepeatedNamePairEntries) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RepeatedNamePairEntries.ProtoReflect.Descriptor instead.
-func (*RepeatedNamePairEntries) Descriptor() ([]byte, []int) {
-	return file_Local_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use LocalRunCommunication_Route.ProtoReflect.Descriptor instead.
+func (*LocalRunCommunication_Route) Descriptor() ([]byte, []int) {
+	return file_Local_proto_rawDescGZIP(), []int{20, 0}
 }
 
-func (x *RepeatedNamePairEntries) GetEntries() []*NamePair {
+func (x *LocalRunCommunication_Route) GetFrom() uint32 {
 	if x != nil {
-		return x.Entries
+		return x.From
 	}
-	return nil
+	return 0
 }
 
-func (x *RepeatedNamePairEntries) GetSandboxId() string {
+func (x *LocalRunCommunication_Route) GetTo() uint32 {
 	if x != nil {
-		return x.SandboxId
+		return x.To
 	}
-	return ""
+	return 0
 }
 
-type RepeatedStringEntries struct {
+type LocalRunCommunicationResult_Row struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Entries []string `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	Sent []int64 `protobuf:"varint,1,rep,packed,name=sent,proto3" json:"sent,omitempty"`
 }
 
-func (x *RepeatedStringEntries) Reset() {
-	*x = RepeatedStringEntries{}
+func (x *LocalRunCommunicationResult_Row) Reset() {
+	*x = LocalRunCommunicationResult_Row{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_Local_proto_msgTypes[21]
+		mi := &file_Local_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RepeatedStringEntries) String() string {
+func (x *LocalRunCommunicationResult_Row) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RepeatedStringEntries) ProtoMessage() {}
+func (*LocalRunCommunicationResult_Row) ProtoMessage() {}
 
-func (x *RepeatedStringEntries) ProtoReflect() protoreflect.Message {
-	mi := &file_Local_proto_msgTypes[21]
+func (x *LocalRunCommunicationResult_Row) ProtoReflect() protoreflect.Message {
+	mi := &file_Local_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1510,45 +4568,49 @@ func (x *RepeatedStringEntries) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RepeatedStringEntries.ProtoReflect.Descriptor instead.
-func (*RepeatedStringEntries) Descriptor() ([]byte, []int) {
-	return file_Local_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use LocalRunCommunicationResult_Row.ProtoReflect.Descriptor instead.
+func (*LocalRunCommunicationResult_Row) Descriptor() ([]byte, []int) {
+	return file_Local_proto_rawDescGZIP(), []int{21, 0}
 }
 
-func (x *RepeatedStringEntries) GetEntries() []string {
+func (x *LocalRunCommunicationResult_Row) GetSent() []int64 {
 	if x != nil {
-		return x.Entries
+		return x.Sent
 	}
 	return nil
 }
 
-type LocalEnvironment_Variable struct {
+type CheckLimitsResponse_Limit struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name   string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Value  string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
-	Expand bool   `protobuf:"varint,3,opt,name=expand,proto3" json:"expand,omitempty"`
+	// Field name in LocalExecutionParameters.
+	Name      string                     `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Status    CheckLimitsResponse_Status `protobuf:"varint,2,opt,name=status,proto3,enum=contester.proto.CheckLimitsResponse_Status" json:"status,omitempty"`
+	Requested uint64                     `protobuf:"varint,3,opt,name=requested,proto3" json:"requested,omitempty"`
+	// What the host can actually provide, for CLAMPED.
+	Effective uint64 `protobuf:"varint,4,opt,name=effective,proto3" json:"effective,omitempty"`
+	Note      string `protobuf:"bytes,5,opt,name=note,proto3" json:"note,omitempty"`
 }
 
-func (x *LocalEnvironment_Variable) Reset() {
-	*x = LocalEnvironment_Variable{}
+func (x *CheckLimitsResponse_Limit) Reset() {
+	*x = CheckLimitsResponse_Limit{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_Local_proto_msgTypes[22]
+		mi := &file_Local_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *LocalEnvironment_Variable) String() string {
+func (x *CheckLimitsResponse_Limit) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LocalEnvironment_Variable) ProtoMessage() {}
+func (*CheckLimitsResponse_Limit) ProtoMessage() {}
 
-func (x *LocalEnvironment_Variable) ProtoReflect() protoreflect.Message {
-	mi := &file_Local_proto_msgTypes[22]
+func (x *CheckLimitsResponse_Limit) ProtoReflect() protoreflect.Message {
+	mi := &file_Local_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1559,30 +4621,44 @@ func (x *LocalEnvironment_Variable) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LocalEnvironment_Variable.ProtoReflect.Descriptor instead.
-func (*LocalEnvironment_Variable) Descriptor() ([]byte, []int) {
-	return file_Local_proto_rawDescGZIP(), []int{0, 0}
+// Deprecated: Use CheckLimitsResponse_Limit.ProtoReflect.Descriptor instead.
+func (*CheckLimitsResponse_Limit) Descriptor() ([]byte, []int) {
+	return file_Local_proto_rawDescGZIP(), []int{30, 0}
 }
 
-func (x *LocalEnvironment_Variable) GetName() string {
+func (x *CheckLimitsResponse_Limit) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *LocalEnvironment_Variable) GetValue() string {
+func (x *CheckLimitsResponse_Limit) GetStatus() CheckLimitsResponse_Status {
 	if x != nil {
-		return x.Value
+		return x.Status
 	}
-	return ""
+	return CheckLimitsResponse_HONORED
 }
 
-func (x *LocalEnvironment_Variable) GetExpand() bool {
+func (x *CheckLimitsResponse_Limit) GetRequested() uint64 {
 	if x != nil {
-		return x.Expand
+		return x.Requested
 	}
-	return false
+	return 0
+}
+
+func (x *CheckLimitsResponse_Limit) GetEffective() uint64 {
+	if x != nil {
+		return x.Effective
+	}
+	return 0
+}
+
+func (x *CheckLimitsResponse_Limit) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
 }
 
 var File_Local_proto protoreflect.FileDescriptor
@@ -1603,7 +4679,7 @@ var file_Local_proto_rawDesc = []byte{
 	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
 	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
 	0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x78, 0x70, 0x61, 0x6e, 0x64, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x65, 0x78, 0x70, 0x61, 0x6e, 0x64, 0x22, 0xd8, 0x06,
+	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x65, 0x78, 0x70, 0x61, 0x6e, 0x64, 0x22, 0xfc, 0x1e,
 	0x0a, 0x18, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e,
 	0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x61, 0x70,
 	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
@@ -1630,7 +4706,10 @@ var file_Local_proto_rawDesc = []byte{
 	0x69, 0x12, 0x15, 0x0a, 0x06, 0x6e, 0x6f, 0x5f, 0x6a, 0x6f, 0x62, 0x18, 0x09, 0x20, 0x01, 0x28,
 	0x08, 0x52, 0x05, 0x6e, 0x6f, 0x4a, 0x6f, 0x62, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x72, 0x6f, 0x63,
 	0x65, 0x73, 0x73, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x0c, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x37, 0x0a,
+	0x0c, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x2e, 0x0a,
+	0x13, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x18, 0x3f, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x37, 0x0a,
 	0x18, 0x6b, 0x65, 0x72, 0x6e, 0x65, 0x6c, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6c, 0x69, 0x6d,
 	0x69, 0x74, 0x5f, 0x6d, 0x69, 0x63, 0x72, 0x6f, 0x73, 0x18, 0x13, 0x20, 0x01, 0x28, 0x04, 0x52,
 	0x15, 0x6b, 0x65, 0x72, 0x6e, 0x65, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74,
@@ -1657,178 +4736,820 @@ var file_Local_proto_rawDesc = []byte{
 	0x09, 0x73, 0x61, 0x6e, 0x64, 0x62, 0x6f, 0x78, 0x49, 0x64, 0x12, 0x2c, 0x0a, 0x12, 0x6a, 0x6f,
 	0x69, 0x6e, 0x5f, 0x73, 0x74, 0x64, 0x6f, 0x75, 0x74, 0x5f, 0x73, 0x74, 0x64, 0x65, 0x72, 0x72,
 	0x18, 0x12, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x6a, 0x6f, 0x69, 0x6e, 0x53, 0x74, 0x64, 0x6f,
-	0x75, 0x74, 0x53, 0x74, 0x64, 0x65, 0x72, 0x72, 0x22, 0x9b, 0x01, 0x0a, 0x15, 0x4c, 0x6f, 0x63,
-	0x61, 0x6c, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
-	0x65, 0x64, 0x12, 0x3f, 0x0a, 0x05, 0x66, 0x69, 0x72, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x29, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69,
-	0x6f, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x52, 0x05, 0x66, 0x69,
-	0x72, 0x73, 0x74, 0x12, 0x41, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x45, 0x78, 0x65, 0x63, 0x75,
-	0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x52, 0x06,
-	0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x22, 0xa7, 0x03, 0x0a, 0x14, 0x4c, 0x6f, 0x63, 0x61, 0x6c,
-	0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12,
-	0x3b, 0x0a, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25,
+	0x75, 0x74, 0x53, 0x74, 0x64, 0x65, 0x72, 0x72, 0x12, 0x41, 0x0a, 0x1d, 0x6d, 0x65, 0x6d, 0x6f,
+	0x72, 0x79, 0x5f, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76,
+	0x61, 0x6c, 0x5f, 0x6d, 0x69, 0x63, 0x72, 0x6f, 0x73, 0x18, 0x15, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x1a, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x49, 0x6e, 0x74,
+	0x65, 0x72, 0x76, 0x61, 0x6c, 0x4d, 0x69, 0x63, 0x72, 0x6f, 0x73, 0x12, 0x30, 0x0a, 0x14, 0x76,
+	0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x5f, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x5f, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x18, 0x16, 0x20, 0x01, 0x28, 0x04, 0x52, 0x12, 0x76, 0x69, 0x72, 0x74, 0x75,
+	0x61, 0x6c, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x27, 0x0a,
+	0x0f, 0x64, 0x6c, 0x6c, 0x5f, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73,
+	0x18, 0x17, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x64, 0x6c, 0x6c, 0x44, 0x69, 0x72, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x12, 0x36, 0x0a, 0x17, 0x75, 0x6e, 0x72, 0x65, 0x73, 0x74,
+	0x72, 0x69, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x64, 0x6c, 0x6c, 0x5f, 0x73, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x18, 0x18, 0x20, 0x01, 0x28, 0x08, 0x52, 0x15, 0x75, 0x6e, 0x72, 0x65, 0x73, 0x74, 0x72,
+	0x69, 0x63, 0x74, 0x65, 0x64, 0x44, 0x6c, 0x6c, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x1a,
+	0x0a, 0x08, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x18, 0x51, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x07, 0x66, 0x69,
+	0x6c, 0x65, 0x5f, 0x69, 0x6f, 0x18, 0x19, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x6f,
+	0x6e, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x46, 0x69,
+	0x6c, 0x65, 0x49, 0x4f, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x65, 0x49, 0x6f, 0x12, 0x25, 0x0a, 0x0e,
+	0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x5f, 0x63, 0x79, 0x63, 0x6c, 0x65, 0x73, 0x18, 0x1a,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x43, 0x79, 0x63,
+	0x6c, 0x65, 0x73, 0x12, 0x37, 0x0a, 0x18, 0x6d, 0x69, 0x6e, 0x5f, 0x65, 0x78, 0x70, 0x65, 0x63,
+	0x74, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6d, 0x69, 0x63, 0x72, 0x6f, 0x73, 0x18,
+	0x1b, 0x20, 0x01, 0x28, 0x04, 0x52, 0x15, 0x6d, 0x69, 0x6e, 0x45, 0x78, 0x70, 0x65, 0x63, 0x74,
+	0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x4d, 0x69, 0x63, 0x72, 0x6f, 0x73, 0x12, 0x30, 0x0a, 0x15,
+	0x6d, 0x61, 0x78, 0x5f, 0x77, 0x61, 0x6c, 0x6c, 0x5f, 0x74, 0x6f, 0x5f, 0x63, 0x70, 0x75, 0x5f,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x18, 0x4d, 0x20, 0x01, 0x28, 0x01, 0x52, 0x11, 0x6d, 0x61, 0x78,
+	0x57, 0x61, 0x6c, 0x6c, 0x54, 0x6f, 0x43, 0x70, 0x75, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x12, 0x37,
+	0x0a, 0x19, 0x66, 0x61, 0x69, 0x6c, 0x5f, 0x6f, 0x6e, 0x5f, 0x77, 0x61, 0x6c, 0x6c, 0x5f, 0x74,
+	0x6f, 0x5f, 0x63, 0x70, 0x75, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x18, 0x4e, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x14, 0x66, 0x61, 0x69, 0x6c, 0x4f, 0x6e, 0x57, 0x61, 0x6c, 0x6c, 0x54, 0x6f, 0x43,
+	0x70, 0x75, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x12, 0x67, 0x0a, 0x11, 0x6d, 0x65, 0x6d, 0x6f, 0x72,
+	0x79, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x1c, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x3a, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74,
+	0x69, 0x6f, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x2e, 0x4d, 0x65,
+	0x6d, 0x6f, 0x72, 0x79, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x10,
+	0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67,
+	0x12, 0x25, 0x0a, 0x0e, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x63, 0x6c, 0x61,
+	0x73, 0x73, 0x18, 0x1d, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69,
+	0x74, 0x79, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x30, 0x0a, 0x14, 0x6d, 0x61, 0x69, 0x6e, 0x5f,
+	0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18,
+	0x1e, 0x20, 0x01, 0x28, 0x11, 0x52, 0x12, 0x6d, 0x61, 0x69, 0x6e, 0x54, 0x68, 0x72, 0x65, 0x61,
+	0x64, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x28, 0x0a, 0x10, 0x63, 0x70, 0x75,
+	0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x1f, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0e, 0x63, 0x70, 0x75, 0x52, 0x61, 0x74, 0x65, 0x50, 0x65, 0x72, 0x63,
+	0x65, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6f, 0x5f, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69,
+	0x74, 0x79, 0x18, 0x50, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x69, 0x6f, 0x50, 0x72, 0x69, 0x6f,
+	0x72, 0x69, 0x74, 0x79, 0x12, 0x2a, 0x0a, 0x11, 0x6b, 0x69, 0x6c, 0x6c, 0x5f, 0x73, 0x6e, 0x61,
+	0x70, 0x73, 0x68, 0x6f, 0x74, 0x5f, 0x64, 0x69, 0x72, 0x18, 0x20, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0f, 0x6b, 0x69, 0x6c, 0x6c, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x44, 0x69, 0x72,
+	0x12, 0x25, 0x0a, 0x0e, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x5f, 0x70, 0x61, 0x67, 0x69,
+	0x6e, 0x67, 0x18, 0x25, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72,
+	0x65, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x12, 0x24, 0x0a, 0x0d, 0x64, 0x65, 0x74, 0x65, 0x72,
+	0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x69, 0x63, 0x18, 0x26, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d,
+	0x64, 0x65, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x69, 0x63, 0x12, 0x2a, 0x0a,
+	0x11, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x5f, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x6c, 0x6f,
+	0x61, 0x64, 0x18, 0x27, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72,
+	0x65, 0x48, 0x6f, 0x73, 0x74, 0x4c, 0x6f, 0x61, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x73, 0x65,
+	0x75, 0x64, 0x6f, 0x5f, 0x63, 0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x65, 0x18, 0x28, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0d, 0x70, 0x73, 0x65, 0x75, 0x64, 0x6f, 0x43, 0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x65,
+	0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65,
+	0x73, 0x18, 0x29, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x6f,
+	0x64, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x5f,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x2a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x6d, 0x6f, 0x64,
+	0x75, 0x6c, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x70, 0x70, 0x5f,
+	0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x18, 0x2b, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x61, 0x70, 0x70, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x12, 0x3c, 0x0a,
+	0x1a, 0x61, 0x70, 0x70, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x63,
+	0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x2c, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x18, 0x61, 0x70, 0x70, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x43,
+	0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6d,
+	0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x5f, 0x69, 0x6f, 0x5f, 0x77, 0x61, 0x69, 0x74, 0x18, 0x2d,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x49, 0x6f, 0x57,
+	0x61, 0x69, 0x74, 0x12, 0x36, 0x0a, 0x17, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x5f, 0x73,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x5f, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x18, 0x4f,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x15, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x53, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x12, 0x2b, 0x0a, 0x11, 0x72,
+	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x5f, 0x70, 0x72, 0x69, 0x76, 0x69, 0x6c, 0x65, 0x67, 0x65, 0x73,
+	0x18, 0x2e, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x50, 0x72,
+	0x69, 0x76, 0x69, 0x6c, 0x65, 0x67, 0x65, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x6b, 0x65, 0x65, 0x70,
+	0x5f, 0x70, 0x72, 0x69, 0x76, 0x69, 0x6c, 0x65, 0x67, 0x65, 0x73, 0x18, 0x2f, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0e, 0x6b, 0x65, 0x65, 0x70, 0x50, 0x72, 0x69, 0x76, 0x69, 0x6c, 0x65, 0x67, 0x65,
+	0x73, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x61, 0x69, 0x6c, 0x5f, 0x6f, 0x70, 0x65, 0x6e, 0x18, 0x56,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x66, 0x61, 0x69, 0x6c, 0x4f, 0x70, 0x65, 0x6e, 0x12, 0x1f,
+	0x0a, 0x0b, 0x66, 0x61, 0x69, 0x6c, 0x5f, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x18, 0x57, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0a, 0x66, 0x61, 0x69, 0x6c, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x12,
+	0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x30, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x5f, 0x65,
+	0x6e, 0x76, 0x18, 0x31, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x45,
+	0x6e, 0x76, 0x12, 0x28, 0x0a, 0x10, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x5f, 0x66, 0x69, 0x72, 0x73,
+	0x74, 0x5f, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x32, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x6e, 0x6f,
+	0x6e, 0x63, 0x65, 0x46, 0x69, 0x72, 0x73, 0x74, 0x4c, 0x69, 0x6e, 0x65, 0x12, 0x21, 0x0a, 0x0c,
+	0x76, 0x65, 0x72, 0x69, 0x66, 0x79, 0x5f, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x33, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0b, 0x76, 0x65, 0x72, 0x69, 0x66, 0x79, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x18, 0x4a, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x72, 0x75, 0x6e, 0x5f, 0x69,
+	0x64, 0x18, 0x34, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x72, 0x75, 0x6e, 0x49, 0x64, 0x12, 0x53,
+	0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x44, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x37, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f,
+	0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x2e, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x12, 0x2a, 0x0a, 0x11, 0x6d, 0x69, 0x6e, 0x5f, 0x6d, 0x65, 0x6d, 0x6f, 0x72,
+	0x79, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x18, 0x35, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f,
+	0x6d, 0x69, 0x6e, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x12,
+	0x23, 0x0a, 0x0d, 0x64, 0x65, 0x6e, 0x79, 0x5f, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79,
+	0x18, 0x36, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x64, 0x65, 0x6e, 0x79, 0x52, 0x65, 0x67, 0x69,
+	0x73, 0x74, 0x72, 0x79, 0x12, 0x2d, 0x0a, 0x12, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79,
+	0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x37, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x11, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x6c,
+	0x69, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x65, 0x61, 0x6e, 0x5f, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x18, 0x38, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x6c, 0x65, 0x61, 0x6e, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x6c, 0x61,
+	0x72, 0x67, 0x65, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x73, 0x18, 0x39, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x4c, 0x61, 0x72, 0x67, 0x65, 0x50, 0x61, 0x67, 0x65, 0x73,
+	0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x3a, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x73, 0x18, 0x3b, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0c, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x73, 0x12,
+	0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x73, 0x18, 0x45,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x73,
+	0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65,
+	0x73, 0x18, 0x40, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x48, 0x61,
+	0x6e, 0x64, 0x6c, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x5f,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x41, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x68, 0x61, 0x6e,
+	0x64, 0x6c, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x2e, 0x0a, 0x13, 0x61, 0x75, 0x64, 0x69,
+	0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x64, 0x5f, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x18,
+	0x42, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x61, 0x75, 0x64, 0x69, 0x74, 0x4e, 0x61, 0x6d, 0x65,
+	0x64, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x6e, 0x61, 0x6d, 0x65,
+	0x64, 0x5f, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x43,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x6e, 0x61, 0x6d, 0x65, 0x64, 0x4f, 0x62, 0x6a, 0x65, 0x63,
+	0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f,
+	0x73, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x47, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x53, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x73,
+	0x6f, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x48, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0b, 0x73, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x30,
+	0x0a, 0x14, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x64, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x63, 0x74,
+	0x5f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x54, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x44, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x63, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x73,
+	0x12, 0x2e, 0x0a, 0x13, 0x64, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x63, 0x74, 0x5f, 0x66, 0x69, 0x6c,
+	0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x55, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x64,
+	0x69, 0x73, 0x74, 0x69, 0x6e, 0x63, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74,
+	0x12, 0x2b, 0x0a, 0x11, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x73,
+	0x68, 0x61, 0x32, 0x35, 0x36, 0x18, 0x3c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x65, 0x78, 0x65,
+	0x63, 0x75, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x68, 0x61, 0x32, 0x35, 0x36, 0x12, 0x32, 0x0a,
+	0x15, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x5f, 0x66, 0x72,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x3d, 0x20, 0x01, 0x28, 0x01, 0x52, 0x13, 0x6d, 0x65,
+	0x6d, 0x6f, 0x72, 0x79, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x46, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x37, 0x0a, 0x18, 0x6b, 0x69, 0x6c, 0x6c, 0x5f, 0x67, 0x72, 0x61, 0x63, 0x65, 0x5f,
+	0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x5f, 0x6d, 0x69, 0x63, 0x72, 0x6f, 0x73, 0x18, 0x3e, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x15, 0x6b, 0x69, 0x6c, 0x6c, 0x47, 0x72, 0x61, 0x63, 0x65, 0x50, 0x65,
+	0x72, 0x69, 0x6f, 0x64, 0x4d, 0x69, 0x63, 0x72, 0x6f, 0x73, 0x12, 0x3d, 0x0a, 0x1b, 0x63, 0x6f,
+	0x6e, 0x74, 0x69, 0x6e, 0x75, 0x65, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x5f, 0x73, 0x74, 0x64,
+	0x6f, 0x75, 0x74, 0x5f, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x18, 0x49, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x18, 0x63, 0x6f, 0x6e, 0x74, 0x69, 0x6e, 0x75, 0x65, 0x41, 0x66, 0x74, 0x65, 0x72, 0x53, 0x74,
+	0x64, 0x6f, 0x75, 0x74, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x1a, 0x69, 0x6e, 0x70,
+	0x75, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74,
+	0x5f, 0x6d, 0x69, 0x63, 0x72, 0x6f, 0x73, 0x18, 0x4b, 0x20, 0x01, 0x28, 0x04, 0x52, 0x17, 0x69,
+	0x6e, 0x70, 0x75, 0x74, 0x53, 0x74, 0x61, 0x6c, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74,
+	0x4d, 0x69, 0x63, 0x72, 0x6f, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64,
+	0x65, 0x5f, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x5f, 0x77, 0x61, 0x69, 0x74, 0x18, 0x52, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x10, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74,
+	0x57, 0x61, 0x69, 0x74, 0x12, 0x3b, 0x0a, 0x1a, 0x72, 0x65, 0x74, 0x72, 0x79, 0x5f, 0x6f, 0x6e,
+	0x5f, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x18, 0x53, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x17, 0x72, 0x65, 0x74, 0x72, 0x79, 0x4f,
+	0x6e, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x72, 0x61, 0x73, 0x68, 0x5f, 0x64, 0x75, 0x6d, 0x70, 0x73,
+	0x18, 0x4c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x63, 0x72, 0x61, 0x73, 0x68, 0x44, 0x75, 0x6d,
+	0x70, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x62,
+	0x65, 0x5f, 0x6d, 0x69, 0x63, 0x72, 0x6f, 0x73, 0x18, 0x46, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x50, 0x72, 0x6f, 0x62, 0x65, 0x4d, 0x69, 0x63, 0x72, 0x6f, 0x73,
+	0x12, 0x2d, 0x0a, 0x12, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x5f, 0x70,
+	0x61, 0x72, 0x73, 0x65, 0x72, 0x73, 0x18, 0x21, 0x20, 0x03, 0x28, 0x09, 0x52, 0x11, 0x64, 0x69,
+	0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x50, 0x61, 0x72, 0x73, 0x65, 0x72, 0x73, 0x12,
+	0x2e, 0x0a, 0x13, 0x61, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x5f, 0x73, 0x69, 0x7a, 0x65,
+	0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x22, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x61, 0x72,
+	0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12,
+	0x3c, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x67, 0x65, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x23,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x74, 0x61, 0x67, 0x65, 0x64, 0x46, 0x69, 0x6c,
+	0x65, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x67, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x2e, 0x0a,
+	0x13, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x67, 0x65, 0x64, 0x5f, 0x66,
+	0x69, 0x6c, 0x65, 0x73, 0x18, 0x24, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x72, 0x65, 0x6d, 0x6f,
+	0x76, 0x65, 0x53, 0x74, 0x61, 0x67, 0x65, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x1a, 0x3b, 0x0a,
+	0x0d, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x30, 0x0a, 0x10, 0x4d, 0x65,
+	0x6d, 0x6f, 0x72, 0x79, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x0b,
+	0x0a, 0x07, 0x50, 0x52, 0x49, 0x56, 0x41, 0x54, 0x45, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x57,
+	0x4f, 0x52, 0x4b, 0x49, 0x4e, 0x47, 0x5f, 0x53, 0x45, 0x54, 0x10, 0x01, 0x22, 0x4c, 0x0a, 0x0a,
+	0x53, 0x74, 0x61, 0x67, 0x65, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x8f, 0x01, 0x0a, 0x06, 0x46,
+	0x69, 0x6c, 0x65, 0x49, 0x4f, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6e, 0x70, 0x75, 0x74,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6f, 0x75, 0x74, 0x70, 0x75,
+	0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x5f, 0x66,
+	0x69, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6e, 0x70, 0x75, 0x74,
+	0x46, 0x69, 0x6c, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x5f, 0x6d,
+	0x61, 0x78, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x6f,
+	0x75, 0x74, 0x70, 0x75, 0x74, 0x4d, 0x61, 0x78, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x9b, 0x01, 0x0a,
+	0x15, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x43, 0x6f, 0x6e,
+	0x6e, 0x65, 0x63, 0x74, 0x65, 0x64, 0x12, 0x3f, 0x0a, 0x05, 0x66, 0x69, 0x72, 0x73, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x73, 0x74, 0x65,
+	0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x45, 0x78, 0x65,
+	0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73,
+	0x52, 0x05, 0x66, 0x69, 0x72, 0x73, 0x74, 0x12, 0x41, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x6f, 0x6e,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x73,
+	0x74, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x45,
+	0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65,
+	0x72, 0x73, 0x52, 0x06, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x22, 0xb4, 0x1a, 0x0a, 0x14, 0x4c,
+	0x6f, 0x63, 0x61, 0x6c, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x12, 0x3b, 0x0a, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x25, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x52, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73,
+	0x12, 0x38, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24,
 	0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
-	0x46, 0x6c, 0x61, 0x67, 0x73, 0x52, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x38, 0x0a, 0x04,
-	0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x63, 0x6f, 0x6e,
-	0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x78, 0x65,
-	0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x54, 0x69, 0x6d, 0x65,
-	0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x12, 0x1f,
-	0x0a, 0x0b, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x0d, 0x52, 0x0a, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x12,
-	0x2e, 0x0a, 0x07, 0x73, 0x74, 0x64, 0x5f, 0x6f, 0x75, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x15, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x2e, 0x42, 0x6c, 0x6f, 0x62, 0x52, 0x06, 0x73, 0x74, 0x64, 0x4f, 0x75, 0x74, 0x12,
-	0x2e, 0x0a, 0x07, 0x73, 0x74, 0x64, 0x5f, 0x65, 0x72, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x15, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x2e, 0x42, 0x6c, 0x6f, 0x62, 0x52, 0x06, 0x73, 0x74, 0x64, 0x45, 0x72, 0x72, 0x12,
-	0x27, 0x0a, 0x0f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73,
-	0x65, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x50,
-	0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6b, 0x69, 0x6c, 0x6c,
-	0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6b,
-	0x69, 0x6c, 0x6c, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x6f,
-	0x70, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a,
-	0x73, 0x74, 0x6f, 0x70, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72,
-	0x72, 0x6f, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
-	0x22, 0x99, 0x01, 0x0a, 0x1b, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74,
-	0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x65, 0x64, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
-	0x12, 0x3b, 0x0a, 0x05, 0x66, 0x69, 0x72, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x25, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x05, 0x66, 0x69, 0x72, 0x73, 0x74, 0x12, 0x3d, 0x0a,
-	0x06, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65,
+	0x6d, 0x6f, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x6d, 0x65, 0x6d, 0x6f,
+	0x72, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x5f, 0x63, 0x6f, 0x64,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x43,
+	0x6f, 0x64, 0x65, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x5f, 0x72, 0x65,
+	0x74, 0x75, 0x72, 0x6e, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x46, 0x20, 0x01, 0x28, 0x11, 0x52,
+	0x10, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x43, 0x6f, 0x64,
+	0x65, 0x12, 0x2e, 0x0a, 0x07, 0x73, 0x74, 0x64, 0x5f, 0x6f, 0x75, 0x74, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x6c, 0x6f, 0x62, 0x52, 0x06, 0x73, 0x74, 0x64, 0x4f, 0x75,
+	0x74, 0x12, 0x2e, 0x0a, 0x07, 0x73, 0x74, 0x64, 0x5f, 0x65, 0x72, 0x72, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x6c, 0x6f, 0x62, 0x52, 0x06, 0x73, 0x74, 0x64, 0x45, 0x72,
+	0x72, 0x12, 0x27, 0x0a, 0x0f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x70, 0x72, 0x6f, 0x63, 0x65,
+	0x73, 0x73, 0x65, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6b, 0x69,
+	0x6c, 0x6c, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0a, 0x6b, 0x69, 0x6c, 0x6c, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x12, 0x1f, 0x0a, 0x0b, 0x73,
+	0x74, 0x6f, 0x70, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0a, 0x73, 0x74, 0x6f, 0x70, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x12, 0x14, 0x0a, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x12, 0x44, 0x0a, 0x0e, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x5f, 0x73, 0x61, 0x6d,
+	0x70, 0x6c, 0x65, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x63, 0x6f, 0x6e,
+	0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4d, 0x65, 0x6d,
+	0x6f, 0x72, 0x79, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x52, 0x0d, 0x6d, 0x65, 0x6d, 0x6f, 0x72,
+	0x79, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x70, 0x65, 0x61, 0x6b,
+	0x5f, 0x76, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x5f, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x70, 0x65, 0x61, 0x6b, 0x56, 0x69, 0x72, 0x74, 0x75,
+	0x61, 0x6c, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x12, 0x31, 0x0a, 0x14, 0x69, 0x73, 0x6f, 0x6c,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x6f, 0x77, 0x6e, 0x67, 0x72, 0x61, 0x64, 0x65, 0x64,
+	0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x69, 0x73, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x44, 0x6f, 0x77, 0x6e, 0x67, 0x72, 0x61, 0x64, 0x65, 0x64, 0x12, 0x2f, 0x0a, 0x13, 0x64,
+	0x6f, 0x77, 0x6e, 0x67, 0x72, 0x61, 0x64, 0x65, 0x64, 0x5f, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x73, 0x18, 0x49, 0x20, 0x03, 0x28, 0x09, 0x52, 0x12, 0x64, 0x6f, 0x77, 0x6e, 0x67, 0x72,
+	0x61, 0x64, 0x65, 0x64, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x12, 0x2c, 0x0a, 0x06,
+	0x73, 0x74, 0x64, 0x5f, 0x69, 0x6e, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63,
+	0x6f, 0x6e, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42,
+	0x6c, 0x6f, 0x62, 0x52, 0x05, 0x73, 0x74, 0x64, 0x49, 0x6e, 0x12, 0x28, 0x0a, 0x10, 0x6b, 0x69,
+	0x6c, 0x6c, 0x5f, 0x77, 0x61, 0x69, 0x74, 0x5f, 0x6d, 0x69, 0x63, 0x72, 0x6f, 0x73, 0x18, 0x0f,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x6b, 0x69, 0x6c, 0x6c, 0x57, 0x61, 0x69, 0x74, 0x4d, 0x69,
+	0x63, 0x72, 0x6f, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x5f, 0x6d, 0x69,
+	0x63, 0x72, 0x6f, 0x73, 0x18, 0x10, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x63, 0x6c, 0x6f, 0x73,
+	0x65, 0x4d, 0x69, 0x63, 0x72, 0x6f, 0x73, 0x12, 0x36, 0x0a, 0x0b, 0x66, 0x69, 0x6c, 0x65, 0x5f,
+	0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63,
+	0x6f, 0x6e, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42,
+	0x6c, 0x6f, 0x62, 0x52, 0x0a, 0x66, 0x69, 0x6c, 0x65, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12,
+	0x1d, 0x0a, 0x0a, 0x63, 0x70, 0x75, 0x5f, 0x63, 0x79, 0x63, 0x6c, 0x65, 0x73, 0x18, 0x12, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x09, 0x63, 0x70, 0x75, 0x43, 0x79, 0x63, 0x6c, 0x65, 0x73, 0x12, 0x2d,
+	0x0a, 0x12, 0x65, 0x6e, 0x65, 0x72, 0x67, 0x79, 0x5f, 0x6d, 0x69, 0x63, 0x72, 0x6f, 0x6a, 0x6f,
+	0x75, 0x6c, 0x65, 0x73, 0x18, 0x13, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x65, 0x6e, 0x65, 0x72,
+	0x67, 0x79, 0x4d, 0x69, 0x63, 0x72, 0x6f, 0x6a, 0x6f, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x32, 0x0a,
+	0x15, 0x73, 0x74, 0x64, 0x6f, 0x75, 0x74, 0x5f, 0x63, 0x6c, 0x65, 0x61, 0x6e, 0x6c, 0x79, 0x5f,
+	0x63, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x18, 0x14, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x73, 0x74,
+	0x64, 0x6f, 0x75, 0x74, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x6c, 0x79, 0x43, 0x6c, 0x6f, 0x73, 0x65,
+	0x64, 0x12, 0x30, 0x0a, 0x14, 0x66, 0x61, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x74, 0x68, 0x61, 0x6e,
+	0x5f, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x18, 0x15, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x12, 0x66, 0x61, 0x73, 0x74, 0x65, 0x72, 0x54, 0x68, 0x61, 0x6e, 0x45, 0x78, 0x70, 0x65, 0x63,
+	0x74, 0x65, 0x64, 0x12, 0x3a, 0x0a, 0x1a, 0x77, 0x61, 0x6c, 0x6c, 0x5f, 0x74, 0x6f, 0x5f, 0x63,
+	0x70, 0x75, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x5f, 0x65, 0x78, 0x63, 0x65, 0x65, 0x64, 0x65,
+	0x64, 0x18, 0x40, 0x20, 0x01, 0x28, 0x08, 0x52, 0x16, 0x77, 0x61, 0x6c, 0x6c, 0x54, 0x6f, 0x43,
+	0x70, 0x75, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x45, 0x78, 0x63, 0x65, 0x65, 0x64, 0x65, 0x64, 0x12,
+	0x42, 0x0a, 0x0e, 0x73, 0x74, 0x64, 0x5f, 0x6f, 0x75, 0x74, 0x5f, 0x63, 0x68, 0x75, 0x6e, 0x6b,
+	0x73, 0x18, 0x16, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x73,
+	0x74, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74,
+	0x43, 0x68, 0x75, 0x6e, 0x6b, 0x52, 0x0c, 0x73, 0x74, 0x64, 0x4f, 0x75, 0x74, 0x43, 0x68, 0x75,
+	0x6e, 0x6b, 0x73, 0x12, 0x42, 0x0a, 0x0e, 0x73, 0x74, 0x64, 0x5f, 0x65, 0x72, 0x72, 0x5f, 0x63,
+	0x68, 0x75, 0x6e, 0x6b, 0x73, 0x18, 0x17, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x6f,
+	0x6e, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4f, 0x75,
+	0x74, 0x70, 0x75, 0x74, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x52, 0x0c, 0x73, 0x74, 0x64, 0x45, 0x72,
+	0x72, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x73, 0x12, 0x3b, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x61,
+	0x72, 0x69, 0x73, 0x6f, 0x6e, 0x18, 0x18, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x6f,
+	0x6e, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f,
+	0x6d, 0x70, 0x61, 0x72, 0x69, 0x73, 0x6f, 0x6e, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x72,
+	0x69, 0x73, 0x6f, 0x6e, 0x12, 0x28, 0x0a, 0x10, 0x63, 0x70, 0x75, 0x5f, 0x72, 0x61, 0x74, 0x65,
+	0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x19, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e,
+	0x63, 0x70, 0x75, 0x52, 0x61, 0x74, 0x65, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x1f,
+	0x0a, 0x0b, 0x69, 0x6f, 0x5f, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x42, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0a, 0x69, 0x6f, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12,
+	0x42, 0x0a, 0x0d, 0x6b, 0x69, 0x6c, 0x6c, 0x5f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
+	0x18, 0x1a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x73, 0x74,
+	0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4b, 0x69, 0x6c, 0x6c, 0x53, 0x6e, 0x61,
+	0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x0c, 0x6b, 0x69, 0x6c, 0x6c, 0x53, 0x6e, 0x61, 0x70, 0x73,
+	0x68, 0x6f, 0x74, 0x12, 0x3d, 0x0a, 0x0b, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69,
+	0x63, 0x73, 0x18, 0x1b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x65,
+	0x73, 0x74, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x69, 0x61, 0x67, 0x6e,
+	0x6f, 0x73, 0x74, 0x69, 0x63, 0x52, 0x0b, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69,
+	0x63, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x5f, 0x73,
+	0x69, 0x7a, 0x65, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x61, 0x72, 0x74, 0x69, 0x66,
+	0x61, 0x63, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x2e, 0x0a, 0x13, 0x70, 0x65, 0x61, 0x6b, 0x5f,
+	0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x18, 0x1d,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x70, 0x65, 0x61, 0x6b, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73,
+	0x73, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x12, 0x26, 0x0a, 0x0f, 0x70, 0x65, 0x61, 0x6b, 0x5f,
+	0x6a, 0x6f, 0x62, 0x5f, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0d, 0x70, 0x65, 0x61, 0x6b, 0x4a, 0x6f, 0x62, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x12,
+	0x1f, 0x0a, 0x0b, 0x68, 0x61, 0x72, 0x64, 0x5f, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x1f,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x68, 0x61, 0x72, 0x64, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x73,
+	0x12, 0x2c, 0x0a, 0x12, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f,
+	0x6d, 0x69, 0x63, 0x72, 0x6f, 0x73, 0x18, 0x20, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x70, 0x61,
+	0x67, 0x69, 0x6e, 0x67, 0x54, 0x69, 0x6d, 0x65, 0x4d, 0x69, 0x63, 0x72, 0x6f, 0x73, 0x12, 0x2a,
+	0x0a, 0x11, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x65, 0x74, 0x5f, 0x74, 0x72,
+	0x69, 0x6d, 0x73, 0x18, 0x21, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x77, 0x6f, 0x72, 0x6b, 0x69,
+	0x6e, 0x67, 0x53, 0x65, 0x74, 0x54, 0x72, 0x69, 0x6d, 0x73, 0x12, 0x36, 0x0a, 0x09, 0x68, 0x6f,
+	0x73, 0x74, 0x5f, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x22, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
 	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
-	0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x22, 0x9a, 0x01, 0x0a,
-	0x0e, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x49, 0x0a, 0x0a, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x45, 0x78, 0x65, 0x63, 0x75,
-	0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x52, 0x0a,
-	0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x12, 0x3d, 0x0a, 0x06, 0x72, 0x65,
-	0x73, 0x75, 0x6c, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x63, 0x6f, 0x6e,
+	0x48, 0x6f, 0x73, 0x74, 0x4c, 0x6f, 0x61, 0x64, 0x52, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x4c, 0x6f,
+	0x61, 0x64, 0x12, 0x30, 0x0a, 0x14, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x5f, 0x6c, 0x6f,
+	0x61, 0x64, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x23, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x12, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x4c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x32, 0x0a, 0x15, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x5f, 0x65, 0x78, 0x63, 0x65, 0x65, 0x64, 0x65, 0x64, 0x18, 0x24, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x13, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74,
+	0x45, 0x78, 0x63, 0x65, 0x65, 0x64, 0x65, 0x64, 0x12, 0x35, 0x0a, 0x17, 0x69, 0x6f, 0x5f, 0x77,
+	0x61, 0x69, 0x74, 0x5f, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x69, 0x63,
+	0x72, 0x6f, 0x73, 0x18, 0x25, 0x20, 0x01, 0x28, 0x04, 0x52, 0x14, 0x69, 0x6f, 0x57, 0x61, 0x69,
+	0x74, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x4d, 0x69, 0x63, 0x72, 0x6f, 0x73, 0x12,
+	0x34, 0x0a, 0x16, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x5f, 0x64, 0x65, 0x6c,
+	0x61, 0x79, 0x5f, 0x6d, 0x69, 0x63, 0x72, 0x6f, 0x73, 0x18, 0x41, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x14, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x4d,
+	0x69, 0x63, 0x72, 0x6f, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x73, 0x74, 0x64, 0x69, 0x6e, 0x5f, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x5f, 0x68, 0x69, 0x74, 0x18, 0x26, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d,
+	0x73, 0x74, 0x64, 0x69, 0x6e, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x48, 0x69, 0x74, 0x12, 0x1e, 0x0a,
+	0x0a, 0x70, 0x72, 0x69, 0x76, 0x69, 0x6c, 0x65, 0x67, 0x65, 0x73, 0x18, 0x27, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x0a, 0x70, 0x72, 0x69, 0x76, 0x69, 0x6c, 0x65, 0x67, 0x65, 0x73, 0x12, 0x21, 0x0a,
+	0x0c, 0x6c, 0x61, 0x75, 0x6e, 0x63, 0x68, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x28, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x6c, 0x61, 0x75, 0x6e, 0x63, 0x68, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x29, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x5f,
+	0x66, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x2a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x6e, 0x6f, 0x6e,
+	0x63, 0x65, 0x46, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x61, 0x66, 0x66, 0x69, 0x6e,
+	0x69, 0x74, 0x79, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x73, 0x18, 0x2b, 0x20, 0x03, 0x28, 0x04, 0x52,
+	0x0d, 0x61, 0x66, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x79, 0x4d, 0x61, 0x73, 0x6b, 0x73, 0x12, 0x16,
+	0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x2c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x2a, 0x0a, 0x11, 0x70, 0x65, 0x61, 0x6b, 0x5f, 0x74,
+	0x68, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x2d, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0f, 0x70, 0x65, 0x61, 0x6b, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x5f, 0x63, 0x6c, 0x61,
+	0x6d, 0x70, 0x65, 0x64, 0x18, 0x2e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x73, 0x43, 0x6c, 0x61, 0x6d, 0x70, 0x65, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x69, 0x67,
+	0x68, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x2f, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x74, 0x69, 0x67, 0x68, 0x74, 0x65, 0x73, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74,
+	0x12, 0x30, 0x0a, 0x14, 0x74, 0x69, 0x67, 0x68, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x5f, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x30, 0x20, 0x01, 0x28, 0x01, 0x52, 0x12,
+	0x74, 0x69, 0x67, 0x68, 0x74, 0x65, 0x73, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x55, 0x73, 0x61,
+	0x67, 0x65, 0x12, 0x30, 0x0a, 0x14, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x5f, 0x6d, 0x65,
+	0x6d, 0x6f, 0x72, 0x79, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x31, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x12, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4c,
+	0x69, 0x6d, 0x69, 0x74, 0x12, 0x26, 0x0a, 0x0f, 0x65, 0x78, 0x69, 0x74, 0x65, 0x64, 0x5f, 0x69,
+	0x6e, 0x5f, 0x67, 0x72, 0x61, 0x63, 0x65, 0x18, 0x32, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x65,
+	0x78, 0x69, 0x74, 0x65, 0x64, 0x49, 0x6e, 0x47, 0x72, 0x61, 0x63, 0x65, 0x12, 0x2e, 0x0a, 0x13,
+	0x73, 0x74, 0x64, 0x6f, 0x75, 0x74, 0x5f, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x5f, 0x65, 0x61,
+	0x72, 0x6c, 0x79, 0x18, 0x3e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x73, 0x74, 0x64, 0x6f, 0x75,
+	0x74, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x45, 0x61, 0x72, 0x6c, 0x79, 0x12, 0x32, 0x0a, 0x15,
+	0x70, 0x65, 0x61, 0x6b, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x70, 0x72, 0x6f, 0x63,
+	0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x33, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x13, 0x70, 0x65, 0x61,
+	0x6b, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x73,
+	0x12, 0x2a, 0x0a, 0x11, 0x70, 0x65, 0x61, 0x6b, 0x5f, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x5f,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x34, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x70, 0x65, 0x61,
+	0x6b, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2c, 0x0a, 0x12,
+	0x6e, 0x61, 0x6d, 0x65, 0x64, 0x5f, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x35, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x6e, 0x61, 0x6d, 0x65, 0x64, 0x4f,
+	0x62, 0x6a, 0x65, 0x63, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x3d, 0x0a, 0x1b, 0x6e, 0x61,
+	0x6d, 0x65, 0x64, 0x5f, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x5f, 0x65, 0x78, 0x63, 0x65, 0x65, 0x64, 0x65, 0x64, 0x18, 0x36, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x18, 0x6e, 0x61, 0x6d, 0x65, 0x64, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x4c, 0x69, 0x6d, 0x69,
+	0x74, 0x45, 0x78, 0x63, 0x65, 0x65, 0x64, 0x65, 0x64, 0x12, 0x2e, 0x0a, 0x13, 0x64, 0x69, 0x73,
+	0x74, 0x69, 0x6e, 0x63, 0x74, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x47, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x64, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x63, 0x74,
+	0x46, 0x69, 0x6c, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x4f, 0x0a, 0x08, 0x6d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x37, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x63, 0x6f,
+	0x6e, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x6f,
+	0x63, 0x61, 0x6c, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e,
+	0x70, 0x75, 0x74, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x38, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x75,
+	0x6e, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x18, 0x3f, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0b, 0x75, 0x6e, 0x72, 0x65, 0x61, 0x64, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x2a,
+	0x0a, 0x11, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x5f, 0x77, 0x61, 0x69, 0x74, 0x5f, 0x6d, 0x69, 0x63,
+	0x72, 0x6f, 0x73, 0x18, 0x43, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x69, 0x6e, 0x70, 0x75, 0x74,
+	0x57, 0x61, 0x69, 0x74, 0x4d, 0x69, 0x63, 0x72, 0x6f, 0x73, 0x12, 0x20, 0x0a, 0x0c, 0x73, 0x74,
+	0x64, 0x5f, 0x6f, 0x75, 0x74, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x39, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0a, 0x73, 0x74, 0x64, 0x4f, 0x75, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x20, 0x0a, 0x0c,
+	0x73, 0x74, 0x64, 0x5f, 0x65, 0x72, 0x72, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x3a, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0a, 0x73, 0x74, 0x64, 0x45, 0x72, 0x72, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x2b,
+	0x0a, 0x11, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x18, 0x3b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x65, 0x6e, 0x76, 0x69, 0x72,
+	0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x2f, 0x0a, 0x13, 0x65,
+	0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x74, 0x72, 0x69,
+	0x65, 0x73, 0x18, 0x44, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x12, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f,
+	0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x36, 0x0a, 0x17,
+	0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e,
+	0x74, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x45, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x66,
+	0x69, 0x72, 0x73, 0x74, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x12, 0x2a, 0x0a, 0x11, 0x70, 0x65, 0x61, 0x6b, 0x5f, 0x73, 0x6f, 0x63,
+	0x6b, 0x65, 0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x3c, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0f, 0x70, 0x65, 0x61, 0x6b, 0x53, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x32, 0x0a, 0x15, 0x73, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x5f, 0x65, 0x78, 0x63, 0x65, 0x65, 0x64, 0x65, 0x64, 0x18, 0x3d, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x13, 0x73, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x45, 0x78, 0x63, 0x65,
+	0x65, 0x64, 0x65, 0x64, 0x1a, 0x3b, 0x0a, 0x0d, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
+	0x01, 0x22, 0x82, 0x01, 0x0a, 0x0a, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63,
+	0x12, 0x12, 0x0a, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x66, 0x69, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x04, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6c, 0x75,
+	0x6d, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e,
+	0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x12, 0x18, 0x0a, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xbe, 0x01, 0x0a, 0x08, 0x48, 0x6f, 0x73, 0x74, 0x4c,
+	0x6f, 0x61, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x70, 0x75, 0x5f, 0x75, 0x74, 0x69, 0x6c, 0x69,
+	0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x63, 0x70,
+	0x75, 0x55, 0x74, 0x69, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x27, 0x0a, 0x0f,
+	0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x75, 0x6e, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x74, 0x52, 0x75, 0x6e, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x66, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6d, 0x68, 0x7a, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x10, 0x62, 0x61, 0x73, 0x65, 0x46, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79,
+	0x4d, 0x68, 0x7a, 0x12, 0x32, 0x0a, 0x15, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x66,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6d, 0x68, 0x7a, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x13, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x46, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x6e, 0x63, 0x79, 0x4d, 0x68, 0x7a, 0x22, 0xdd, 0x01, 0x0a, 0x0c, 0x4b, 0x69, 0x6c, 0x6c,
+	0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x75, 0x6d, 0x70,
+	0x5f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x75, 0x6d,
+	0x70, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x3e, 0x0a, 0x07, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x73, 0x74,
+	0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4b, 0x69, 0x6c, 0x6c, 0x53, 0x6e, 0x61,
+	0x70, 0x73, 0x68, 0x6f, 0x74, 0x2e, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x52, 0x07, 0x74, 0x68,
+	0x72, 0x65, 0x61, 0x64, 0x73, 0x1a, 0x70, 0x0a, 0x06, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x28, 0x0a, 0x10, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6d, 0x69, 0x63,
+	0x72, 0x6f, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x75, 0x73, 0x65, 0x72, 0x54,
+	0x69, 0x6d, 0x65, 0x4d, 0x69, 0x63, 0x72, 0x6f, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x6b, 0x65, 0x72,
+	0x6e, 0x65, 0x6c, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6d, 0x69, 0x63, 0x72, 0x6f, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x6b, 0x65, 0x72, 0x6e, 0x65, 0x6c, 0x54, 0x69, 0x6d,
+	0x65, 0x4d, 0x69, 0x63, 0x72, 0x6f, 0x73, 0x22, 0x9f, 0x03, 0x0a, 0x0a, 0x43, 0x6f, 0x6d, 0x70,
+	0x61, 0x72, 0x69, 0x73, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x71, 0x75, 0x61, 0x6c, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x65, 0x71, 0x75, 0x61, 0x6c, 0x12, 0x14, 0x0a, 0x05,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x12, 0x16,
+	0x0a, 0x06, 0x61, 0x63, 0x74, 0x75, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x61, 0x63, 0x74, 0x75, 0x61, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x12, 0x23, 0x0a, 0x0d, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x6c, 0x69, 0x6e,
+	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65,
+	0x64, 0x4c, 0x69, 0x6e, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x63, 0x74, 0x75, 0x61, 0x6c, 0x5f,
+	0x6c, 0x69, 0x6e, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x61, 0x63, 0x74, 0x75,
+	0x61, 0x6c, 0x4c, 0x69, 0x6e, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74,
+	0x65, 0x64, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x0e, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12,
+	0x23, 0x0a, 0x0d, 0x61, 0x63, 0x74, 0x75, 0x61, 0x6c, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65,
+	0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x63, 0x74, 0x75, 0x61, 0x6c, 0x42, 0x65,
+	0x66, 0x6f, 0x72, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x65, 0x78,
+	0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x41, 0x66, 0x74, 0x65, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x61,
+	0x63, 0x74, 0x75, 0x61, 0x6c, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x0b, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x0b, 0x61, 0x63, 0x74, 0x75, 0x61, 0x6c, 0x41, 0x66, 0x74, 0x65, 0x72, 0x12, 0x21,
+	0x0a, 0x0c, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x5f, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x0c,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x4f, 0x75, 0x74, 0x70, 0x75,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x18, 0x0d, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x06, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x22, 0x46, 0x0a, 0x0b, 0x4f, 0x75, 0x74,
+	0x70, 0x75, 0x74, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x12, 0x23, 0x0a, 0x0d, 0x6f, 0x66, 0x66, 0x73,
+	0x65, 0x74, 0x5f, 0x6d, 0x69, 0x63, 0x72, 0x6f, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0c, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x4d, 0x69, 0x63, 0x72, 0x6f, 0x73, 0x12, 0x12, 0x0a,
+	0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x73, 0x69, 0x7a,
+	0x65, 0x22, 0x6c, 0x0a, 0x0c, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x53, 0x61, 0x6d, 0x70, 0x6c,
+	0x65, 0x12, 0x23, 0x0a, 0x0d, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x5f, 0x6d, 0x69, 0x63, 0x72,
+	0x6f, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74,
+	0x4d, 0x69, 0x63, 0x72, 0x6f, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x1f,
+	0x0a, 0x0b, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x65, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0a, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x74, 0x22,
+	0x99, 0x01, 0x0a, 0x1b, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65,
+	0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x65, 0x64, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12,
+	0x3b, 0x0a, 0x05, 0x66, 0x69, 0x72, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25,
+	0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x05, 0x66, 0x69, 0x72, 0x73, 0x74, 0x12, 0x3d, 0x0a, 0x06,
+	0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x63,
+	0x6f, 0x6e, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c,
+	0x6f, 0x63, 0x61, 0x6c, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x52, 0x06, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x22, 0xb5, 0x02, 0x0a, 0x17,
+	0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x57, 0x69, 0x74, 0x68,
+	0x43, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x72, 0x12, 0x45, 0x0a, 0x08, 0x73, 0x6f, 0x6c, 0x75, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x63, 0x6f, 0x6e, 0x74,
+	0x65, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x6f, 0x63, 0x61,
+	0x6c, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65,
+	0x74, 0x65, 0x72, 0x73, 0x52, 0x08, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x43,
+	0x0a, 0x07, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x29, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e,
+	0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x52, 0x07, 0x63, 0x68, 0x65, 0x63,
+	0x6b, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x5f, 0x66, 0x69, 0x6c,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x46, 0x69,
+	0x6c, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x5f, 0x66, 0x69, 0x6c,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x46,
+	0x69, 0x6c, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x5f, 0x66, 0x69,
+	0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72,
+	0x46, 0x69, 0x6c, 0x65, 0x12, 0x2d, 0x0a, 0x12, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x72, 0x5f,
+	0x63, 0x6f, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x11, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x6e, 0x74,
+	0x69, 0x6f, 0x6e, 0x22, 0x9e, 0x03, 0x0a, 0x1d, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x45, 0x78, 0x65,
+	0x63, 0x75, 0x74, 0x65, 0x57, 0x69, 0x74, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x72, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x41, 0x0a, 0x08, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x73,
+	0x74, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x45,
+	0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x08,
+	0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3f, 0x0a, 0x07, 0x63, 0x68, 0x65, 0x63,
+	0x6b, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x63, 0x6f, 0x6e, 0x74,
+	0x65, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x6f, 0x63, 0x61,
+	0x6c, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x52, 0x07, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x72, 0x12, 0x50, 0x0a, 0x07, 0x76, 0x65, 0x72,
+	0x64, 0x69, 0x63, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x36, 0x2e, 0x63, 0x6f, 0x6e,
 	0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x6f, 0x63,
-	0x61, 0x6c, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c,
-	0x74, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x2f, 0x0a, 0x11, 0x42, 0x69, 0x6e,
-	0x61, 0x72, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a,
-	0x0a, 0x08, 0x70, 0x61, 0x74, 0x68, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x70, 0x61, 0x74, 0x68, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0xa3, 0x02, 0x0a, 0x12, 0x42,
-	0x69, 0x6e, 0x61, 0x72, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x07, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x12, 0x4b, 0x0a, 0x06, 0x72,
-	0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x33, 0x2e, 0x63, 0x6f,
-	0x6e, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x69,
-	0x6e, 0x61, 0x72, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x2e, 0x57, 0x69, 0x6e, 0x33, 0x32, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x54, 0x79, 0x70, 0x65,
-	0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0xa5, 0x01, 0x0a, 0x0f, 0x57, 0x69, 0x6e,
-	0x33, 0x32, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x10,
-	0x53, 0x43, 0x53, 0x5f, 0x33, 0x32, 0x42, 0x49, 0x54, 0x5f, 0x42, 0x49, 0x4e, 0x41, 0x52, 0x59,
-	0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x53, 0x43, 0x53, 0x5f, 0x44, 0x4f, 0x53, 0x5f, 0x42, 0x49,
-	0x4e, 0x41, 0x52, 0x59, 0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e, 0x53, 0x43, 0x53, 0x5f, 0x57, 0x4f,
-	0x57, 0x5f, 0x42, 0x49, 0x4e, 0x41, 0x52, 0x59, 0x10, 0x02, 0x12, 0x12, 0x0a, 0x0e, 0x53, 0x43,
-	0x53, 0x5f, 0x50, 0x49, 0x46, 0x5f, 0x42, 0x49, 0x4e, 0x41, 0x52, 0x59, 0x10, 0x03, 0x12, 0x14,
-	0x0a, 0x10, 0x53, 0x43, 0x53, 0x5f, 0x50, 0x4f, 0x53, 0x49, 0x58, 0x5f, 0x42, 0x49, 0x4e, 0x41,
-	0x52, 0x59, 0x10, 0x04, 0x12, 0x14, 0x0a, 0x10, 0x53, 0x43, 0x53, 0x5f, 0x4f, 0x53, 0x32, 0x31,
-	0x36, 0x5f, 0x42, 0x49, 0x4e, 0x41, 0x52, 0x59, 0x10, 0x05, 0x12, 0x14, 0x0a, 0x10, 0x53, 0x43,
-	0x53, 0x5f, 0x36, 0x34, 0x42, 0x49, 0x54, 0x5f, 0x42, 0x49, 0x4e, 0x41, 0x52, 0x59, 0x10, 0x06,
-	0x22, 0x2f, 0x0a, 0x13, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x53, 0x61, 0x6e, 0x64, 0x62, 0x6f, 0x78,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x61, 0x6e, 0x64, 0x62,
-	0x6f, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x61, 0x6e, 0x64, 0x62, 0x6f,
-	0x78, 0x22, 0x34, 0x0a, 0x0f, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x79, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x73, 0x74, 0x65,
-	0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74,
-	0x65, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x22, 0x3e, 0x0a, 0x10, 0x53, 0x61, 0x6e, 0x64, 0x62,
-	0x6f, 0x78, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x63,
-	0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f,
-	0x6d, 0x70, 0x69, 0x6c, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x75, 0x6e, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x03, 0x72, 0x75, 0x6e, 0x22, 0xb4, 0x02, 0x0a, 0x10, 0x49, 0x64, 0x65, 0x6e,
-	0x74, 0x69, 0x66, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a,
-	0x69, 0x6e, 0x76, 0x6f, 0x6b, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x69, 0x6e, 0x76, 0x6f, 0x6b, 0x65, 0x72, 0x49, 0x64, 0x12, 0x3f, 0x0a, 0x09, 0x73,
-	0x61, 0x6e, 0x64, 0x62, 0x6f, 0x78, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21,
+	0x61, 0x6c, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x57, 0x69, 0x74, 0x68, 0x43, 0x68, 0x65,
+	0x63, 0x6b, 0x65, 0x72, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x2e, 0x56, 0x65, 0x72, 0x64, 0x69,
+	0x63, 0x74, 0x52, 0x07, 0x76, 0x65, 0x72, 0x64, 0x69, 0x63, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x63,
+	0x68, 0x65, 0x63, 0x6b, 0x65, 0x72, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x72, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x22, 0x68, 0x0a, 0x07, 0x56, 0x65,
+	0x72, 0x64, 0x69, 0x63, 0x74, 0x12, 0x0c, 0x0a, 0x08, 0x41, 0x43, 0x43, 0x45, 0x50, 0x54, 0x45,
+	0x44, 0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x57, 0x52, 0x4f, 0x4e, 0x47, 0x5f, 0x41, 0x4e, 0x53,
+	0x57, 0x45, 0x52, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x50, 0x52, 0x45, 0x53, 0x45, 0x4e, 0x54,
+	0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x02, 0x12, 0x08, 0x0a,
+	0x04, 0x46, 0x41, 0x49, 0x4c, 0x10, 0x03, 0x12, 0x0f, 0x0a, 0x0b, 0x4e, 0x4f, 0x54, 0x5f, 0x43,
+	0x48, 0x45, 0x43, 0x4b, 0x45, 0x44, 0x10, 0x04, 0x12, 0x0a, 0x0a, 0x06, 0x50, 0x4f, 0x49, 0x4e,
+	0x54, 0x53, 0x10, 0x05, 0x22, 0xb8, 0x01, 0x0a, 0x0d, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x42, 0x61,
+	0x74, 0x63, 0x68, 0x52, 0x75, 0x6e, 0x12, 0x3f, 0x0a, 0x05, 0x74, 0x65, 0x73, 0x74, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x73, 0x74, 0x65,
+	0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x45, 0x78, 0x65,
+	0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73,
+	0x52, 0x05, 0x74, 0x65, 0x73, 0x74, 0x73, 0x12, 0x3e, 0x0a, 0x1c, 0x62, 0x61, 0x74, 0x63, 0x68,
+	0x5f, 0x77, 0x61, 0x6c, 0x6c, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x5f, 0x6d, 0x69, 0x63, 0x72, 0x6f, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x18, 0x62,
+	0x61, 0x74, 0x63, 0x68, 0x57, 0x61, 0x6c, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x4c, 0x69, 0x6d, 0x69,
+	0x74, 0x4d, 0x69, 0x63, 0x72, 0x6f, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x73, 0x74, 0x6f, 0x70, 0x5f,
+	0x6f, 0x6e, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0d, 0x73, 0x74, 0x6f, 0x70, 0x4f, 0x6e, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x22,
+	0x81, 0x05, 0x0a, 0x13, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x75,
+	0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x3f, 0x0a, 0x05, 0x74, 0x65, 0x73, 0x74, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x73, 0x74,
+	0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x42, 0x61,
+	0x74, 0x63, 0x68, 0x52, 0x75, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x2e, 0x54, 0x65, 0x73,
+	0x74, 0x52, 0x05, 0x74, 0x65, 0x73, 0x74, 0x73, 0x1a, 0xe6, 0x01, 0x0a, 0x04, 0x54, 0x65, 0x73,
+	0x74, 0x12, 0x43, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x2b, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x75,
+	0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x3d, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x73, 0x74,
+	0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x45, 0x78,
+	0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x12, 0x46, 0x0a, 0x07, 0x76, 0x65, 0x72,
+	0x64, 0x69, 0x63, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2c, 0x2e, 0x63, 0x6f, 0x6e,
+	0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x6f, 0x63,
+	0x61, 0x6c, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x75, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x2e, 0x56, 0x65, 0x72, 0x64, 0x69, 0x63, 0x74, 0x52, 0x07, 0x76, 0x65, 0x72, 0x64, 0x69, 0x63,
+	0x74, 0x22, 0x24, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0d, 0x0a, 0x09, 0x43,
+	0x4f, 0x4d, 0x50, 0x4c, 0x45, 0x54, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x4b,
+	0x49, 0x50, 0x50, 0x45, 0x44, 0x10, 0x01, 0x22, 0x99, 0x02, 0x0a, 0x07, 0x56, 0x65, 0x72, 0x64,
+	0x69, 0x63, 0x74, 0x12, 0x0c, 0x0a, 0x08, 0x41, 0x43, 0x43, 0x45, 0x50, 0x54, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x10, 0x0a, 0x0c, 0x57, 0x52, 0x4f, 0x4e, 0x47, 0x5f, 0x41, 0x4e, 0x53, 0x57, 0x45,
+	0x52, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x54, 0x49, 0x4d, 0x45, 0x5f, 0x4c, 0x49, 0x4d, 0x49,
+	0x54, 0x5f, 0x45, 0x58, 0x43, 0x45, 0x45, 0x44, 0x45, 0x44, 0x10, 0x02, 0x12, 0x19, 0x0a, 0x15,
+	0x4d, 0x45, 0x4d, 0x4f, 0x52, 0x59, 0x5f, 0x4c, 0x49, 0x4d, 0x49, 0x54, 0x5f, 0x45, 0x58, 0x43,
+	0x45, 0x45, 0x44, 0x45, 0x44, 0x10, 0x03, 0x12, 0x1b, 0x0a, 0x17, 0x49, 0x44, 0x4c, 0x45, 0x4e,
+	0x45, 0x53, 0x53, 0x5f, 0x4c, 0x49, 0x4d, 0x49, 0x54, 0x5f, 0x45, 0x58, 0x43, 0x45, 0x45, 0x44,
+	0x45, 0x44, 0x10, 0x04, 0x12, 0x19, 0x0a, 0x15, 0x4f, 0x55, 0x54, 0x50, 0x55, 0x54, 0x5f, 0x4c,
+	0x49, 0x4d, 0x49, 0x54, 0x5f, 0x45, 0x58, 0x43, 0x45, 0x45, 0x44, 0x45, 0x44, 0x10, 0x05, 0x12,
+	0x16, 0x0a, 0x12, 0x53, 0x45, 0x43, 0x55, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x56, 0x49, 0x4f, 0x4c,
+	0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x06, 0x12, 0x11, 0x0a, 0x0d, 0x52, 0x55, 0x4e, 0x54, 0x49,
+	0x4d, 0x45, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x07, 0x12, 0x08, 0x0a, 0x04, 0x46, 0x41,
+	0x49, 0x4c, 0x10, 0x08, 0x12, 0x11, 0x0a, 0x0d, 0x4d, 0x55, 0x4c, 0x54, 0x49, 0x54, 0x48, 0x52,
+	0x45, 0x41, 0x44, 0x45, 0x44, 0x10, 0x09, 0x12, 0x10, 0x0a, 0x0c, 0x43, 0x41, 0x4e, 0x4e, 0x4f,
+	0x54, 0x5f, 0x53, 0x54, 0x41, 0x52, 0x54, 0x10, 0x0a, 0x12, 0x15, 0x0a, 0x11, 0x45, 0x4e, 0x56,
+	0x49, 0x52, 0x4f, 0x4e, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x0b,
+	0x12, 0x11, 0x0a, 0x0d, 0x49, 0x4e, 0x50, 0x55, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x4c, 0x4c, 0x45,
+	0x44, 0x10, 0x0c, 0x22, 0x30, 0x0a, 0x13, 0x53, 0x74, 0x61, 0x72, 0x74, 0x42, 0x61, 0x74, 0x63,
+	0x68, 0x52, 0x75, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x61,
+	0x74, 0x63, 0x68, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62, 0x61,
+	0x74, 0x63, 0x68, 0x49, 0x64, 0x22, 0x72, 0x0a, 0x0c, 0x50, 0x6f, 0x6c, 0x6c, 0x42, 0x61, 0x74,
+	0x63, 0x68, 0x52, 0x75, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62, 0x61, 0x74, 0x63, 0x68, 0x49, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04,
+	0x66, 0x72, 0x6f, 0x6d, 0x12, 0x1f, 0x0a, 0x0b, 0x77, 0x61, 0x69, 0x74, 0x5f, 0x6d, 0x69, 0x63,
+	0x72, 0x6f, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x77, 0x61, 0x69, 0x74, 0x4d,
+	0x69, 0x63, 0x72, 0x6f, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x74, 0x6f, 0x70, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x04, 0x73, 0x74, 0x6f, 0x70, 0x22, 0x7f, 0x0a, 0x12, 0x50, 0x6f, 0x6c,
+	0x6c, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x75, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12,
+	0x3f, 0x0a, 0x05, 0x74, 0x65, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29,
 	0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2e, 0x53, 0x61, 0x6e, 0x64, 0x62, 0x6f, 0x78, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x52, 0x09, 0x73, 0x61, 0x6e, 0x64, 0x62, 0x6f, 0x78, 0x65, 0x73, 0x12, 0x43, 0x0a, 0x0b,
-	0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x21, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e,
-	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e,
-	0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x12, 0x25, 0x0a,
-	0x0e, 0x70, 0x61, 0x74, 0x68, 0x5f, 0x73, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x61, 0x74, 0x68, 0x53, 0x65, 0x70, 0x61, 0x72,
-	0x61, 0x74, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x69, 0x73, 0x6b, 0x73, 0x18, 0x06, 0x20,
-	0x03, 0x28, 0x09, 0x52, 0x05, 0x64, 0x69, 0x73, 0x6b, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x70, 0x72,
-	0x6f, 0x67, 0x72, 0x61, 0x6d, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09,
-	0x52, 0x0c, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x22, 0x71,
-	0x0a, 0x08, 0x46, 0x69, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x21,
-	0x0a, 0x0c, 0x69, 0x73, 0x5f, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x73, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72,
-	0x79, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75,
-	0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75,
-	0x6d, 0x22, 0x87, 0x01, 0x0a, 0x0b, 0x53, 0x74, 0x61, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x61, 0x6e, 0x64, 0x62, 0x6f, 0x78,
-	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x61, 0x6e, 0x64, 0x62,
-	0x6f, 0x78, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x78, 0x70, 0x61, 0x6e, 0x64, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x65, 0x78, 0x70, 0x61, 0x6e, 0x64, 0x12, 0x2d, 0x0a, 0x12,
-	0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73,
-	0x75, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c,
-	0x61, 0x74, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x22, 0x40, 0x0a, 0x09, 0x46,
-	0x69, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x33, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72,
-	0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x6e, 0x74,
-	0x65, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x46, 0x69, 0x6c, 0x65,
-	0x53, 0x74, 0x61, 0x74, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x22, 0x20, 0x0a,
-	0x0a, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22,
-	0x0e, 0x0a, 0x0c, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22,
-	0xe6, 0x01, 0x0a, 0x0d, 0x43, 0x6f, 0x70, 0x79, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x26, 0x0a, 0x0f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x5f,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6c, 0x6f, 0x63, 0x61,
-	0x6c, 0x46, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x65, 0x6d,
-	0x6f, 0x74, 0x65, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0e, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x06, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x68,
-	0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x68,
-	0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65,
-	0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x6f, 0x64,
-	0x75, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2f, 0x0a, 0x13, 0x61, 0x75, 0x74, 0x68, 0x6f,
-	0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x69, 0x0a, 0x0e, 0x43, 0x6f, 0x70, 0x79,
-	0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x38, 0x0a, 0x07, 0x65, 0x6e,
-	0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x63, 0x6f,
-	0x6e, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f,
-	0x70, 0x79, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x65, 0x6e, 0x74,
-	0x72, 0x69, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x61, 0x6e, 0x64, 0x62, 0x6f, 0x78, 0x5f,
-	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x61, 0x6e, 0x64, 0x62, 0x6f,
-	0x78, 0x49, 0x64, 0x22, 0x44, 0x0a, 0x08, 0x4e, 0x61, 0x6d, 0x65, 0x50, 0x61, 0x69, 0x72, 0x12,
-	0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69,
-	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65,
-	0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x6d, 0x0a, 0x17, 0x52, 0x65, 0x70,
-	0x65, 0x61, 0x74, 0x65, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x50, 0x61, 0x69, 0x72, 0x45, 0x6e, 0x74,
-	0x72, 0x69, 0x65, 0x73, 0x12, 0x33, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x73, 0x74, 0x65,
-	0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x50, 0x61, 0x69, 0x72,
-	0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x61, 0x6e,
-	0x64, 0x62, 0x6f, 0x78, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73,
-	0x61, 0x6e, 0x64, 0x62, 0x6f, 0x78, 0x49, 0x64, 0x22, 0x31, 0x0a, 0x15, 0x52, 0x65, 0x70, 0x65,
-	0x61, 0x74, 0x65, 0x64, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65,
-	0x73, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x09, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x42, 0x4b, 0x0a, 0x1c, 0x6f,
-	0x72, 0x67, 0x2e, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x72, 0x61, 0x79, 0x2e, 0x63, 0x6f, 0x6e, 0x74,
-	0x65, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x5a, 0x2b, 0x67, 0x69, 0x74,
-	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x73, 0x74, 0x65,
-	0x72, 0x2f, 0x72, 0x75, 0x6e, 0x6c, 0x69, 0x62, 0x2f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x73, 0x74,
-	0x65, 0x72, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x75, 0x6e, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x52, 0x05, 0x74, 0x65, 0x73, 0x74, 0x73,
+	0x12, 0x12, 0x0a, 0x04, 0x64, 0x6f, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04,
+	0x64, 0x6f, 0x6e, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0xfd, 0x01, 0x0a, 0x15, 0x4c,
+	0x6f, 0x63, 0x61, 0x6c, 0x52, 0x75, 0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x47, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x73,
+	0x74, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x45,
+	0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65,
+	0x72, 0x73, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x28, 0x0a,
+	0x10, 0x6d, 0x61, 0x78, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x6d, 0x61, 0x78, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x44, 0x0a, 0x06, 0x72, 0x6f, 0x75, 0x74, 0x65,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x73,
+	0x74, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x52,
+	0x75, 0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
+	0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x06, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x1a, 0x2b, 0x0a,
+	0x05, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x74, 0x6f, 0x22, 0x8c, 0x02, 0x0a, 0x1b, 0x4c,
+	0x6f, 0x63, 0x61, 0x6c, 0x52, 0x75, 0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x3f, 0x0a, 0x07, 0x72, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x63, 0x6f,
+	0x6e, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x6f,
+	0x63, 0x61, 0x6c, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x64,
+	0x65, 0x61, 0x64, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x64,
+	0x65, 0x61, 0x64, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x4c, 0x0a, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x63, 0x6f, 0x6e, 0x74,
+	0x65, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x6f, 0x63, 0x61,
+	0x6c, 0x52, 0x75, 0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x2e, 0x52, 0x6f, 0x77, 0x52, 0x08, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
+	0x6c, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x1a, 0x19,
+	0x0a, 0x03, 0x52, 0x6f, 0x77, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x03, 0x52, 0x04, 0x73, 0x65, 0x6e, 0x74, 0x22, 0x9a, 0x01, 0x0a, 0x0e, 0x4c, 0x6f,
+	0x63, 0x61, 0x6c, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x49, 0x0a, 0x0a,
+	0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x29, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f,
+	0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x52, 0x0a, 0x70, 0x61, 0x72,
+	0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x12, 0x3d, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x73,
+	0x74, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x45,
+	0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06,
+	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x2f, 0x0a, 0x11, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79,
+	0x54, 0x79, 0x70, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70,
+	0x61, 0x74, 0x68, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70,
+	0x61, 0x74, 0x68, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0xa3, 0x02, 0x0a, 0x12, 0x42, 0x69, 0x6e, 0x61,
+	0x72, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x12, 0x4b, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x33, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x65,
+	0x73, 0x74, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x69, 0x6e, 0x61, 0x72,
+	0x79, 0x54, 0x79, 0x70, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x57, 0x69,
+	0x6e, 0x33, 0x32, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52, 0x06, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0xa5, 0x01, 0x0a, 0x0f, 0x57, 0x69, 0x6e, 0x33, 0x32, 0x42,
+	0x69, 0x6e, 0x61, 0x72, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x10, 0x53, 0x43, 0x53,
+	0x5f, 0x33, 0x32, 0x42, 0x49, 0x54, 0x5f, 0x42, 0x49, 0x4e, 0x41, 0x52, 0x59, 0x10, 0x00, 0x12,
+	0x12, 0x0a, 0x0e, 0x53, 0x43, 0x53, 0x5f, 0x44, 0x4f, 0x53, 0x5f, 0x42, 0x49, 0x4e, 0x41, 0x52,
+	0x59, 0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e, 0x53, 0x43, 0x53, 0x5f, 0x57, 0x4f, 0x57, 0x5f, 0x42,
+	0x49, 0x4e, 0x41, 0x52, 0x59, 0x10, 0x02, 0x12, 0x12, 0x0a, 0x0e, 0x53, 0x43, 0x53, 0x5f, 0x50,
+	0x49, 0x46, 0x5f, 0x42, 0x49, 0x4e, 0x41, 0x52, 0x59, 0x10, 0x03, 0x12, 0x14, 0x0a, 0x10, 0x53,
+	0x43, 0x53, 0x5f, 0x50, 0x4f, 0x53, 0x49, 0x58, 0x5f, 0x42, 0x49, 0x4e, 0x41, 0x52, 0x59, 0x10,
+	0x04, 0x12, 0x14, 0x0a, 0x10, 0x53, 0x43, 0x53, 0x5f, 0x4f, 0x53, 0x32, 0x31, 0x36, 0x5f, 0x42,
+	0x49, 0x4e, 0x41, 0x52, 0x59, 0x10, 0x05, 0x12, 0x14, 0x0a, 0x10, 0x53, 0x43, 0x53, 0x5f, 0x36,
+	0x34, 0x42, 0x49, 0x54, 0x5f, 0x42, 0x49, 0x4e, 0x41, 0x52, 0x59, 0x10, 0x06, 0x22, 0x2f, 0x0a,
+	0x13, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x53, 0x61, 0x6e, 0x64, 0x62, 0x6f, 0x78, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x61, 0x6e, 0x64, 0x62, 0x6f, 0x78, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x61, 0x6e, 0x64, 0x62, 0x6f, 0x78, 0x22, 0x34,
+	0x0a, 0x0f, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x73, 0x74,
+	0x65, 0x72, 0x49, 0x64, 0x22, 0x3e, 0x0a, 0x10, 0x53, 0x61, 0x6e, 0x64, 0x62, 0x6f, 0x78, 0x4c,
+	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x70,
+	0x69, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x70, 0x69,
+	0x6c, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x75, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x72, 0x75, 0x6e, 0x22, 0xb4, 0x02, 0x0a, 0x10, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66,
+	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x6e, 0x76,
+	0x6f, 0x6b, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69,
+	0x6e, 0x76, 0x6f, 0x6b, 0x65, 0x72, 0x49, 0x64, 0x12, 0x3f, 0x0a, 0x09, 0x73, 0x61, 0x6e, 0x64,
+	0x62, 0x6f, 0x78, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x63, 0x6f,
+	0x6e, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x61,
+	0x6e, 0x64, 0x62, 0x6f, 0x78, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x09,
+	0x73, 0x61, 0x6e, 0x64, 0x62, 0x6f, 0x78, 0x65, 0x73, 0x12, 0x43, 0x0a, 0x0b, 0x65, 0x6e, 0x76,
+	0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21,
+	0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x0b, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1a,
+	0x0a, 0x08, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x61,
+	0x74, 0x68, 0x5f, 0x73, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x70, 0x61, 0x74, 0x68, 0x53, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x6f,
+	0x72, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x69, 0x73, 0x6b, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x05, 0x64, 0x69, 0x73, 0x6b, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x67, 0x72,
+	0x61, 0x6d, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x70,
+	0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x22, 0x5f, 0x0a, 0x12, 0x43,
+	0x68, 0x65, 0x63, 0x6b, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x49, 0x0a, 0x0a, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x73, 0x74, 0x65,
+	0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x45, 0x78, 0x65,
+	0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73,
+	0x52, 0x0a, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x22, 0x86, 0x03, 0x0a,
+	0x13, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x06, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x4c, 0x69, 0x6d, 0x69,
+	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4c, 0x69, 0x6d, 0x69, 0x74,
+	0x52, 0x06, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x68, 0x79, 0x73,
+	0x69, 0x63, 0x61, 0x6c, 0x5f, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0e, 0x70, 0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x4d, 0x65, 0x6d, 0x6f, 0x72,
+	0x79, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x70, 0x75, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x63, 0x70, 0x75, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0xb0,
+	0x01, 0x0a, 0x05, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x43, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2b, 0x2e, 0x63,
+	0x6f, 0x6e, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43,
+	0x68, 0x65, 0x63, 0x6b, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x12,
+	0x1c, 0x0a, 0x09, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x09, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x6f, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x74,
+	0x65, 0x22, 0x32, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0b, 0x0a, 0x07, 0x48,
+	0x4f, 0x4e, 0x4f, 0x52, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x43, 0x4c, 0x41, 0x4d,
+	0x50, 0x45, 0x44, 0x10, 0x01, 0x12, 0x0e, 0x0a, 0x0a, 0x49, 0x4d, 0x50, 0x4f, 0x53, 0x53, 0x49,
+	0x42, 0x4c, 0x45, 0x10, 0x02, 0x22, 0x71, 0x0a, 0x08, 0x46, 0x69, 0x6c, 0x65, 0x53, 0x74, 0x61,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x73, 0x5f, 0x64, 0x69, 0x72, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x73, 0x44,
+	0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x1a, 0x0a, 0x08,
+	0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x22, 0x87, 0x01, 0x0a, 0x0b, 0x53, 0x74, 0x61,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a,
+	0x73, 0x61, 0x6e, 0x64, 0x62, 0x6f, 0x78, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x73, 0x61, 0x6e, 0x64, 0x62, 0x6f, 0x78, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x65,
+	0x78, 0x70, 0x61, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x65, 0x78, 0x70,
+	0x61, 0x6e, 0x64, 0x12, 0x2d, 0x0a, 0x12, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x65,
+	0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x11, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x73,
+	0x75, 0x6d, 0x22, 0x40, 0x0a, 0x09, 0x46, 0x69, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12,
+	0x33, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x52, 0x07, 0x65, 0x6e, 0x74,
+	0x72, 0x69, 0x65, 0x73, 0x22, 0x20, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x0e, 0x0a, 0x0c, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xe6, 0x01, 0x0a, 0x0d, 0x43, 0x6f, 0x70, 0x79, 0x4f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x26, 0x0a, 0x0f, 0x6c, 0x6f, 0x63, 0x61,
+	0x6c, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x46, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x27, 0x0a, 0x0f, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x65, 0x6d, 0x6f, 0x74,
+	0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x70, 0x6c,
+	0x6f, 0x61, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x75, 0x70, 0x6c, 0x6f, 0x61,
+	0x64, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x12, 0x1f, 0x0a,
+	0x0b, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2f,
+	0x0a, 0x13, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x61, 0x75, 0x74,
+	0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22,
+	0x69, 0x0a, 0x0e, 0x43, 0x6f, 0x70, 0x79, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x38, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x70, 0x79, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x73,
+	0x61, 0x6e, 0x64, 0x62, 0x6f, 0x78, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x73, 0x61, 0x6e, 0x64, 0x62, 0x6f, 0x78, 0x49, 0x64, 0x22, 0x44, 0x0a, 0x08, 0x4e, 0x61,
+	0x6d, 0x65, 0x50, 0x61, 0x69, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x20,
+	0x0a, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x22, 0x6d, 0x0a, 0x17, 0x52, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x4e, 0x61, 0x6d, 0x65,
+	0x50, 0x61, 0x69, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x33, 0x0a, 0x07, 0x65,
+	0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63,
+	0x6f, 0x6e, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4e,
+	0x61, 0x6d, 0x65, 0x50, 0x61, 0x69, 0x72, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73,
+	0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x61, 0x6e, 0x64, 0x62, 0x6f, 0x78, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x61, 0x6e, 0x64, 0x62, 0x6f, 0x78, 0x49, 0x64, 0x22,
+	0x31, 0x0a, 0x15, 0x52, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x53, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72,
+	0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69,
+	0x65, 0x73, 0x42, 0x4b, 0x0a, 0x1c, 0x6f, 0x72, 0x67, 0x2e, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x72,
+	0x61, 0x79, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x5a, 0x2b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63,
+	0x6f, 0x6e, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x2f, 0x72, 0x75, 0x6e, 0x6c, 0x69, 0x62, 0x2f,
+	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1843,65 +5564,129 @@ func file_Local_proto_rawDescGZIP() []byte {
 	return file_Local_proto_rawDescData
 }
 
-var file_Local_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_Local_proto_msgTypes = make([]protoimpl.MessageInfo, 23)
+var file_Local_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
+var file_Local_proto_msgTypes = make([]protoimpl.MessageInfo, 49)
 var file_Local_proto_goTypes = []interface{}{
-	(BinaryTypeResponse_Win32BinaryType)(0), // 0: contester.proto.BinaryTypeResponse.Win32BinaryType
-	(*LocalEnvironment)(nil),                // 1: contester.proto.LocalEnvironment
-	(*LocalExecutionParameters)(nil),        // 2: contester.proto.LocalExecutionParameters
-	(*LocalExecuteConnected)(nil),           // 3: contester.proto.LocalExecuteConnected
-	(*LocalExecutionResult)(nil),            // 4: contester.proto.LocalExecutionResult
-	(*LocalExecuteConnectedResult)(nil),     // 5: contester.proto.LocalExecuteConnectedResult
-	(*LocalExecution)(nil),                  // 6: contester.proto.LocalExecution
-	(*BinaryTypeRequest)(nil),               // 7: contester.proto.BinaryTypeRequest
-	(*BinaryTypeResponse)(nil),              // 8: contester.proto.BinaryTypeResponse
-	(*ClearSandboxRequest)(nil),             // 9: contester.proto.ClearSandboxRequest
-	(*IdentifyRequest)(nil),                 // 10: contester.proto.IdentifyRequest
-	(*SandboxLocations)(nil),                // 11: contester.proto.SandboxLocations
-	(*IdentifyResponse)(nil),                // 12: contester.proto.IdentifyResponse
-	(*FileStat)(nil),                        // 13: contester.proto.FileStat
-	(*StatRequest)(nil),                     // 14: contester.proto.StatRequest
-	(*FileStats)(nil),                       // 15: contester.proto.FileStats
-	(*GetRequest)(nil),                      // 16: contester.proto.GetRequest
-	(*EmptyMessage)(nil),                    // 17: contester.proto.EmptyMessage
-	(*CopyOperation)(nil),                   // 18: contester.proto.CopyOperation
-	(*CopyOperations)(nil),                  // 19: contester.proto.CopyOperations
-	(*NamePair)(nil),                        // 20: contester.proto.NamePair
-	(*RepeatedNamePairEntries)(nil),         // 21: contester.proto.RepeatedNamePairEntries
-	(*RepeatedStringEntries)(nil),           // 22: contester.proto.RepeatedStringEntries
-	(*LocalEnvironment_Variable)(nil),       // 23: contester.proto.LocalEnvironment.Variable
-	(*RedirectParameters)(nil),              // 24: contester.proto.RedirectParameters
-	(*ExecutionResultFlags)(nil),            // 25: contester.proto.ExecutionResultFlags
-	(*ExecutionResultTime)(nil),             // 26: contester.proto.ExecutionResultTime
-	(*Blob)(nil),                            // 27: contester.proto.Blob
+	(LocalExecutionParameters_MemoryAccounting)(0), // 0: contester.proto.LocalExecutionParameters.MemoryAccounting
+	(LocalExecuteWithCheckerResult_Verdict)(0),     // 1: contester.proto.LocalExecuteWithCheckerResult.Verdict
+	(LocalBatchRunResult_Status)(0),                // 2: contester.proto.LocalBatchRunResult.Status
+	(LocalBatchRunResult_Verdict)(0),               // 3: contester.proto.LocalBatchRunResult.Verdict
+	(BinaryTypeResponse_Win32BinaryType)(0),        // 4: contester.proto.BinaryTypeResponse.Win32BinaryType
+	(CheckLimitsResponse_Status)(0),                // 5: contester.proto.CheckLimitsResponse.Status
+	(*LocalEnvironment)(nil),                       // 6: contester.proto.LocalEnvironment
+	(*LocalExecutionParameters)(nil),               // 7: contester.proto.LocalExecutionParameters
+	(*StagedFile)(nil),                             // 8: contester.proto.StagedFile
+	(*FileIO)(nil),                                 // 9: contester.proto.FileIO
+	(*LocalExecuteConnected)(nil),                  // 10: contester.proto.LocalExecuteConnected
+	(*LocalExecutionResult)(nil),                   // 11: contester.proto.LocalExecutionResult
+	(*Diagnostic)(nil),                             // 12: contester.proto.Diagnostic
+	(*HostLoad)(nil),                               // 13: contester.proto.HostLoad
+	(*KillSnapshot)(nil),                           // 14: contester.proto.KillSnapshot
+	(*Comparison)(nil),                             // 15: contester.proto.Comparison
+	(*OutputChunk)(nil),                            // 16: contester.proto.OutputChunk
+	(*MemorySample)(nil),                           // 17: contester.proto.MemorySample
+	(*LocalExecuteConnectedResult)(nil),            // 18: contester.proto.LocalExecuteConnectedResult
+	(*LocalExecuteWithChecker)(nil),                // 19: contester.proto.LocalExecuteWithChecker
+	(*LocalExecuteWithCheckerResult)(nil),          // 20: contester.proto.LocalExecuteWithCheckerResult
+	(*LocalBatchRun)(nil),                          // 21: contester.proto.LocalBatchRun
+	(*LocalBatchRunResult)(nil),                    // 22: contester.proto.LocalBatchRunResult
+	(*StartBatchRunResult)(nil),                    // 23: contester.proto.StartBatchRunResult
+	(*PollBatchRun)(nil),                           // 24: contester.proto.PollBatchRun
+	(*PollBatchRunResult)(nil),                     // 25: contester.proto.PollBatchRunResult
+	(*LocalRunCommunication)(nil),                  // 26: contester.proto.LocalRunCommunication
+	(*LocalRunCommunicationResult)(nil),            // 27: contester.proto.LocalRunCommunicationResult
+	(*LocalExecution)(nil),                         // 28: contester.proto.LocalExecution
+	(*BinaryTypeRequest)(nil),                      // 29: contester.proto.BinaryTypeRequest
+	(*BinaryTypeResponse)(nil),                     // 30: contester.proto.BinaryTypeResponse
+	(*ClearSandboxRequest)(nil),                    // 31: contester.proto.ClearSandboxRequest
+	(*IdentifyRequest)(nil),                        // 32: contester.proto.IdentifyRequest
+	(*SandboxLocations)(nil),                       // 33: contester.proto.SandboxLocations
+	(*IdentifyResponse)(nil),                       // 34: contester.proto.IdentifyResponse
+	(*CheckLimitsRequest)(nil),                     // 35: contester.proto.CheckLimitsRequest
+	(*CheckLimitsResponse)(nil),                    // 36: contester.proto.CheckLimitsResponse
+	(*FileStat)(nil),                               // 37: contester.proto.FileStat
+	(*StatRequest)(nil),                            // 38: contester.proto.StatRequest
+	(*FileStats)(nil),                              // 39: contester.proto.FileStats
+	(*GetRequest)(nil),                             // 40: contester.proto.GetRequest
+	(*EmptyMessage)(nil),                           // 41: contester.proto.EmptyMessage
+	(*CopyOperation)(nil),                          // 42: contester.proto.CopyOperation
+	(*CopyOperations)(nil),                         // 43: contester.proto.CopyOperations
+	(*NamePair)(nil),                               // 44: contester.proto.NamePair
+	(*RepeatedNamePairEntries)(nil),                // 45: contester.proto.RepeatedNamePairEntries
+	(*RepeatedStringEntries)(nil),                  // 46: contester.proto.RepeatedStringEntries
+	(*LocalEnvironment_Variable)(nil),              // 47: contester.proto.LocalEnvironment.Variable
+	nil,                                            // 48: contester.proto.LocalExecutionParameters.MetadataEntry
+	nil,                                            // 49: contester.proto.LocalExecutionResult.MetadataEntry
+	(*KillSnapshot_Thread)(nil),                    // 50: contester.proto.KillSnapshot.Thread
+	(*LocalBatchRunResult_Test)(nil),               // 51: contester.proto.LocalBatchRunResult.Test
+	(*LocalRunCommunication_Route)(nil),            // 52: contester.proto.LocalRunCommunication.Route
+	(*LocalRunCommunicationResult_Row)(nil),        // 53: contester.proto.LocalRunCommunicationResult.Row
+	(*CheckLimitsResponse_Limit)(nil),              // 54: contester.proto.CheckLimitsResponse.Limit
+	(*RedirectParameters)(nil),                     // 55: contester.proto.RedirectParameters
+	(*ExecutionResultFlags)(nil),                   // 56: contester.proto.ExecutionResultFlags
+	(*ExecutionResultTime)(nil),                    // 57: contester.proto.ExecutionResultTime
+	(*Blob)(nil),                                   // 58: contester.proto.Blob
 }
 var file_Local_proto_depIdxs = []int32{
-	23, // 0: contester.proto.LocalEnvironment.variable:type_name -> contester.proto.LocalEnvironment.Variable
-	1,  // 1: contester.proto.LocalExecutionParameters.environment:type_name -> contester.proto.LocalEnvironment
-	24, // 2: contester.proto.LocalExecutionParameters.std_in:type_name -> contester.proto.RedirectParameters
-	24, // 3: contester.proto.LocalExecutionParameters.std_out:type_name -> contester.proto.RedirectParameters
-	24, // 4: contester.proto.LocalExecutionParameters.std_err:type_name -> contester.proto.RedirectParameters
-	2,  // 5: contester.proto.LocalExecuteConnected.first:type_name -> contester.proto.LocalExecutionParameters
-	2,  // 6: contester.proto.LocalExecuteConnected.second:type_name -> contester.proto.LocalExecutionParameters
-	25, // 7: contester.proto.LocalExecutionResult.flags:type_name -> contester.proto.ExecutionResultFlags
-	26, // 8: contester.proto.LocalExecutionResult.time:type_name -> contester.proto.ExecutionResultTime
-	27, // 9: contester.proto.LocalExecutionResult.std_out:type_name -> contester.proto.Blob
-	27, // 10: contester.proto.LocalExecutionResult.std_err:type_name -> contester.proto.Blob
-	4,  // 11: contester.proto.LocalExecuteConnectedResult.first:type_name -> contester.proto.LocalExecutionResult
-	4,  // 12: contester.proto.LocalExecuteConnectedResult.second:type_name -> contester.proto.LocalExecutionResult
-	2,  // 13: contester.proto.LocalExecution.parameters:type_name -> contester.proto.LocalExecutionParameters
-	4,  // 14: contester.proto.LocalExecution.result:type_name -> contester.proto.LocalExecutionResult
-	0,  // 15: contester.proto.BinaryTypeResponse.result:type_name -> contester.proto.BinaryTypeResponse.Win32BinaryType
-	11, // 16: contester.proto.IdentifyResponse.sandboxes:type_name -> contester.proto.SandboxLocations
-	1,  // 17: contester.proto.IdentifyResponse.environment:type_name -> contester.proto.LocalEnvironment
-	13, // 18: contester.proto.FileStats.entries:type_name -> contester.proto.FileStat
-	18, // 19: contester.proto.CopyOperations.entries:type_name -> contester.proto.CopyOperation
-	20, // 20: contester.proto.RepeatedNamePairEntries.entries:type_name -> contester.proto.NamePair
-	21, // [21:21] is the sub-list for method output_type
-	21, // [21:21] is the sub-list for method input_type
-	21, // [21:21] is the sub-list for extension type_name
-	21, // [21:21] is the sub-list for extension extendee
-	0,  // [0:21] is the sub-list for field type_name
+	47, // 0: contester.proto.LocalEnvironment.variable:type_name -> contester.proto.LocalEnvironment.Variable
+	6,  // 1: contester.proto.LocalExecutionParameters.environment:type_name -> contester.proto.LocalEnvironment
+	55, // 2: contester.proto.LocalExecutionParameters.std_in:type_name -> contester.proto.RedirectParameters
+	55, // 3: contester.proto.LocalExecutionParameters.std_out:type_name -> contester.proto.RedirectParameters
+	55, // 4: contester.proto.LocalExecutionParameters.std_err:type_name -> contester.proto.RedirectParameters
+	9,  // 5: contester.proto.LocalExecutionParameters.file_io:type_name -> contester.proto.FileIO
+	0,  // 6: contester.proto.LocalExecutionParameters.memory_accounting:type_name -> contester.proto.LocalExecutionParameters.MemoryAccounting
+	48, // 7: contester.proto.LocalExecutionParameters.metadata:type_name -> contester.proto.LocalExecutionParameters.MetadataEntry
+	8,  // 8: contester.proto.LocalExecutionParameters.stage_files:type_name -> contester.proto.StagedFile
+	7,  // 9: contester.proto.LocalExecuteConnected.first:type_name -> contester.proto.LocalExecutionParameters
+	7,  // 10: contester.proto.LocalExecuteConnected.second:type_name -> contester.proto.LocalExecutionParameters
+	56, // 11: contester.proto.LocalExecutionResult.flags:type_name -> contester.proto.ExecutionResultFlags
+	57, // 12: contester.proto.LocalExecutionResult.time:type_name -> contester.proto.ExecutionResultTime
+	58, // 13: contester.proto.LocalExecutionResult.std_out:type_name -> contester.proto.Blob
+	58, // 14: contester.proto.LocalExecutionResult.std_err:type_name -> contester.proto.Blob
+	17, // 15: contester.proto.LocalExecutionResult.memory_samples:type_name -> contester.proto.MemorySample
+	58, // 16: contester.proto.LocalExecutionResult.std_in:type_name -> contester.proto.Blob
+	58, // 17: contester.proto.LocalExecutionResult.file_output:type_name -> contester.proto.Blob
+	16, // 18: contester.proto.LocalExecutionResult.std_out_chunks:type_name -> contester.proto.OutputChunk
+	16, // 19: contester.proto.LocalExecutionResult.std_err_chunks:type_name -> contester.proto.OutputChunk
+	15, // 20: contester.proto.LocalExecutionResult.comparison:type_name -> contester.proto.Comparison
+	14, // 21: contester.proto.LocalExecutionResult.kill_snapshot:type_name -> contester.proto.KillSnapshot
+	12, // 22: contester.proto.LocalExecutionResult.diagnostics:type_name -> contester.proto.Diagnostic
+	13, // 23: contester.proto.LocalExecutionResult.host_load:type_name -> contester.proto.HostLoad
+	49, // 24: contester.proto.LocalExecutionResult.metadata:type_name -> contester.proto.LocalExecutionResult.MetadataEntry
+	50, // 25: contester.proto.KillSnapshot.threads:type_name -> contester.proto.KillSnapshot.Thread
+	11, // 26: contester.proto.LocalExecuteConnectedResult.first:type_name -> contester.proto.LocalExecutionResult
+	11, // 27: contester.proto.LocalExecuteConnectedResult.second:type_name -> contester.proto.LocalExecutionResult
+	7,  // 28: contester.proto.LocalExecuteWithChecker.solution:type_name -> contester.proto.LocalExecutionParameters
+	7,  // 29: contester.proto.LocalExecuteWithChecker.checker:type_name -> contester.proto.LocalExecutionParameters
+	11, // 30: contester.proto.LocalExecuteWithCheckerResult.solution:type_name -> contester.proto.LocalExecutionResult
+	11, // 31: contester.proto.LocalExecuteWithCheckerResult.checker:type_name -> contester.proto.LocalExecutionResult
+	1,  // 32: contester.proto.LocalExecuteWithCheckerResult.verdict:type_name -> contester.proto.LocalExecuteWithCheckerResult.Verdict
+	7,  // 33: contester.proto.LocalBatchRun.tests:type_name -> contester.proto.LocalExecutionParameters
+	51, // 34: contester.proto.LocalBatchRunResult.tests:type_name -> contester.proto.LocalBatchRunResult.Test
+	51, // 35: contester.proto.PollBatchRunResult.tests:type_name -> contester.proto.LocalBatchRunResult.Test
+	7,  // 36: contester.proto.LocalRunCommunication.processes:type_name -> contester.proto.LocalExecutionParameters
+	52, // 37: contester.proto.LocalRunCommunication.routes:type_name -> contester.proto.LocalRunCommunication.Route
+	11, // 38: contester.proto.LocalRunCommunicationResult.results:type_name -> contester.proto.LocalExecutionResult
+	53, // 39: contester.proto.LocalRunCommunicationResult.messages:type_name -> contester.proto.LocalRunCommunicationResult.Row
+	7,  // 40: contester.proto.LocalExecution.parameters:type_name -> contester.proto.LocalExecutionParameters
+	11, // 41: contester.proto.LocalExecution.result:type_name -> contester.proto.LocalExecutionResult
+	4,  // 42: contester.proto.BinaryTypeResponse.result:type_name -> contester.proto.BinaryTypeResponse.Win32BinaryType
+	33, // 43: contester.proto.IdentifyResponse.sandboxes:type_name -> contester.proto.SandboxLocations
+	6,  // 44: contester.proto.IdentifyResponse.environment:type_name -> contester.proto.LocalEnvironment
+	7,  // 45: contester.proto.CheckLimitsRequest.parameters:type_name -> contester.proto.LocalExecutionParameters
+	54, // 46: contester.proto.CheckLimitsResponse.limits:type_name -> contester.proto.CheckLimitsResponse.Limit
+	37, // 47: contester.proto.FileStats.entries:type_name -> contester.proto.FileStat
+	42, // 48: contester.proto.CopyOperations.entries:type_name -> contester.proto.CopyOperation
+	44, // 49: contester.proto.RepeatedNamePairEntries.entries:type_name -> contester.proto.NamePair
+	2,  // 50: contester.proto.LocalBatchRunResult.Test.status:type_name -> contester.proto.LocalBatchRunResult.Status
+	11, // 51: contester.proto.LocalBatchRunResult.Test.result:type_name -> contester.proto.LocalExecutionResult
+	3,  // 52: contester.proto.LocalBatchRunResult.Test.verdict:type_name -> contester.proto.LocalBatchRunResult.Verdict
+	5,  // 53: contester.proto.CheckLimitsResponse.Limit.status:type_name -> contester.proto.CheckLimitsResponse.Status
+	54, // [54:54] is the sub-list for method output_type
+	54, // [54:54] is the sub-list for method input_type
+	54, // [54:54] is the sub-list for extension type_name
+	54, // [54:54] is the sub-list for extension extendee
+	0,  // [0:54] is the sub-list for field type_name
 }
 
 func init() { file_Local_proto_init() }
@@ -1924,8 +5709,44 @@ func file_Local_proto_init() {
 				return nil
 			}
 		}
-		file_Local_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LocalExecutionParameters); i {
+		file_Local_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LocalExecutionParameters); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_Local_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StagedFile); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_Local_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FileIO); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_Local_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LocalExecuteConnected); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1936,8 +5757,8 @@ func file_Local_proto_init() {
 				return nil
 			}
 		}
-		file_Local_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LocalExecuteConnected); i {
+		file_Local_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LocalExecutionResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1948,8 +5769,8 @@ func file_Local_proto_init() {
 				return nil
 			}
 		}
-		file_Local_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LocalExecutionResult); i {
+		file_Local_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Diagnostic); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1960,7 +5781,67 @@ func file_Local_proto_init() {
 				return nil
 			}
 		}
-		file_Local_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+		file_Local_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HostLoad); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_Local_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KillSnapshot); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_Local_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Comparison); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_Local_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OutputChunk); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_Local_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MemorySample); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_Local_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*LocalExecuteConnectedResult); i {
 			case 0:
 				return &v.state
@@ -1972,7 +5853,115 @@ func file_Local_proto_init() {
 				return nil
 			}
 		}
-		file_Local_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+		file_Local_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LocalExecuteWithChecker); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_Local_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LocalExecuteWithCheckerResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_Local_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LocalBatchRun); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_Local_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LocalBatchRunResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_Local_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StartBatchRunResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_Local_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PollBatchRun); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_Local_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PollBatchRunResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_Local_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LocalRunCommunication); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_Local_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LocalRunCommunicationResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_Local_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*LocalExecution); i {
 			case 0:
 				return &v.state
@@ -1984,7 +5973,7 @@ func file_Local_proto_init() {
 				return nil
 			}
 		}
-		file_Local_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+		file_Local_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*BinaryTypeRequest); i {
 			case 0:
 				return &v.state
@@ -1996,7 +5985,7 @@ func file_Local_proto_init() {
 				return nil
 			}
 		}
-		file_Local_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+		file_Local_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*BinaryTypeResponse); i {
 			case 0:
 				return &v.state
@@ -2008,7 +5997,7 @@ func file_Local_proto_init() {
 				return nil
 			}
 		}
-		file_Local_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+		file_Local_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ClearSandboxRequest); i {
 			case 0:
 				return &v.state
@@ -2020,7 +6009,7 @@ func file_Local_proto_init() {
 				return nil
 			}
 		}
-		file_Local_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+		file_Local_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*IdentifyRequest); i {
 			case 0:
 				return &v.state
@@ -2032,7 +6021,7 @@ func file_Local_proto_init() {
 				return nil
 			}
 		}
-		file_Local_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+		file_Local_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SandboxLocations); i {
 			case 0:
 				return &v.state
@@ -2044,7 +6033,7 @@ func file_Local_proto_init() {
 				return nil
 			}
 		}
-		file_Local_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+		file_Local_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*IdentifyResponse); i {
 			case 0:
 				return &v.state
@@ -2056,7 +6045,31 @@ func file_Local_proto_init() {
 				return nil
 			}
 		}
-		file_Local_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+		file_Local_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckLimitsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_Local_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckLimitsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_Local_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FileStat); i {
 			case 0:
 				return &v.state
@@ -2068,7 +6081,7 @@ func file_Local_proto_init() {
 				return nil
 			}
 		}
-		file_Local_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+		file_Local_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*StatRequest); i {
 			case 0:
 				return &v.state
@@ -2080,7 +6093,7 @@ func file_Local_proto_init() {
 				return nil
 			}
 		}
-		file_Local_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+		file_Local_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FileStats); i {
 			case 0:
 				return &v.state
@@ -2092,7 +6105,7 @@ func file_Local_proto_init() {
 				return nil
 			}
 		}
-		file_Local_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+		file_Local_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetRequest); i {
 			case 0:
 				return &v.state
@@ -2104,7 +6117,7 @@ func file_Local_proto_init() {
 				return nil
 			}
 		}
-		file_Local_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+		file_Local_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*EmptyMessage); i {
 			case 0:
 				return &v.state
@@ -2116,7 +6129,7 @@ func file_Local_proto_init() {
 				return nil
 			}
 		}
-		file_Local_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+		file_Local_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CopyOperation); i {
 			case 0:
 				return &v.state
@@ -2128,7 +6141,7 @@ func file_Local_proto_init() {
 				return nil
 			}
 		}
-		file_Local_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+		file_Local_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CopyOperations); i {
 			case 0:
 				return &v.state
@@ -2140,7 +6153,7 @@ func file_Local_proto_init() {
 				return nil
 			}
 		}
-		file_Local_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+		file_Local_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*NamePair); i {
 			case 0:
 				return &v.state
@@ -2152,7 +6165,7 @@ func file_Local_proto_init() {
 				return nil
 			}
 		}
-		file_Local_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+		file_Local_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RepeatedNamePairEntries); i {
 			case 0:
 				return &v.state
@@ -2164,7 +6177,7 @@ func file_Local_proto_init() {
 				return nil
 			}
 		}
-		file_Local_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+		file_Local_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RepeatedStringEntries); i {
 			case 0:
 				return &v.state
@@ -2176,7 +6189,7 @@ func file_Local_proto_init() {
 				return nil
 			}
 		}
-		file_Local_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+		file_Local_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*LocalEnvironment_Variable); i {
 			case 0:
 				return &v.state
@@ -2188,14 +6201,74 @@ func file_Local_proto_init() {
 				return nil
 			}
 		}
+		file_Local_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KillSnapshot_Thread); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_Local_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LocalBatchRunResult_Test); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_Local_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LocalRunCommunication_Route); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_Local_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LocalRunCommunicationResult_Row); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_Local_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckLimitsResponse_Limit); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_Local_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   23,
+			NumEnums:      6,
+			NumMessages:   49,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
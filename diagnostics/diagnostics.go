@@ -0,0 +1,111 @@
+package diagnostics
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Parsing stops after this many diagnostics, raw output is still there for the rest.
+const MAX_DIAGNOSTICS = 1000
+
+type Diagnostic struct {
+	File     string
+	Line     int
+	Column   int    // 0 if not reported
+	Severity string // lowercase, "fatal error" is reported as "error"
+	Message  string
+}
+
+// Parser matches one diagnostic per line with a regexp. It must have "file", "line" and "message" named groups,
+// and may have "column" and "severity".
+type Parser struct {
+	Name string
+	re   *regexp.Regexp
+}
+
+func NewParser(name, pattern string) (*Parser, error) {
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, fmt.Errorf("diagnostics parser %q: %w", name, err)
+	}
+	for _, group := range []string{"file", "line", "message"} {
+		if re.SubexpIndex(group) < 0 {
+			return nil, fmt.Errorf("diagnostics parser %q: no %q group in pattern", name, group)
+		}
+	}
+	return &Parser{Name: name, re: re}, nil
+}
+
+func mustParser(name, pattern string) *Parser {
+	p, err := NewParser(name, pattern)
+	if err != nil {
+		panic(err)
+	}
+	return p
+}
+
+// GCC, Clang and compilers mimicking them (fpc, rustc with short messages): "a.cpp:10:5: error: message".
+var GCC = mustParser("gcc",
+	`^(?P<file>(?:[A-Za-z]:)?[^:]+):(?P<line>\d+):(?:(?P<column>\d+):)? *(?P<severity>fatal error|error|warning|note):\s*(?P<message>.*)$`)
+
+// MSVC: "a.cpp(10,5): error C2065: message" or "a.cpp(10): warning C4996: message".
+var MSVC = mustParser("msvc",
+	`^\s*(?P<file>[^(]+)\((?P<line>\d+)(?:,(?P<column>\d+))?\)\s*:\s*(?P<severity>fatal error|error|warning|note)\s*(?P<message>(?:[A-Z]+\d+)?\s*:.*)$`)
+
+// Builtin returns the parsers available out of the box, by name.
+func Builtin() map[string]*Parser {
+	return map[string]*Parser{
+		GCC.Name:  GCC,
+		MSVC.Name: MSVC,
+	}
+}
+
+func (p *Parser) parseLine(line string) (Diagnostic, bool) {
+	m := p.re.FindStringSubmatch(line)
+	if m == nil {
+		return Diagnostic{}, false
+	}
+	group := func(name string) string {
+		if i := p.re.SubexpIndex(name); i >= 0 {
+			return m[i]
+		}
+		return ""
+	}
+	var d Diagnostic
+	var err error
+	if d.Line, err = strconv.Atoi(group("line")); err != nil {
+		return Diagnostic{}, false
+	}
+	if c := group("column"); c != "" {
+		d.Column, _ = strconv.Atoi(c)
+	}
+	d.File = strings.TrimSpace(group("file"))
+	d.Severity = strings.ToLower(group("severity"))
+	if d.Severity == "fatal error" {
+		d.Severity = "error"
+	}
+	d.Message = strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(group("message")), ":"))
+	return d, true
+}
+
+// Parse returns diagnostics found in the compiler output. Each line is given to parsers in order, first match wins;
+// lines no parser recognizes (source excerpts, notes without location) are skipped.
+func Parse(text []byte, parsers []*Parser) []Diagnostic {
+	var result []Diagnostic
+	s := bufio.NewScanner(bytes.NewReader(text))
+	s.Buffer(make([]byte, 0, 4096), 1024*1024)
+	for s.Scan() && len(result) < MAX_DIAGNOSTICS {
+		line := strings.TrimRight(s.Text(), "\r")
+		for _, p := range parsers {
+			if d, ok := p.parseLine(line); ok {
+				result = append(result, d)
+				break
+			}
+		}
+	}
+	return result
+}
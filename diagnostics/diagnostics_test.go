@@ -0,0 +1,46 @@
+package diagnostics
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	cases := []struct {
+		text string
+		want []Diagnostic
+	}{
+		{"a.cpp: In function 'int main()':\na.cpp:3:5: error: 'x' was not declared in this scope\n    3 |     x = 1;\n      |     ^\n",
+			[]Diagnostic{{"a.cpp", 3, 5, "error", "'x' was not declared in this scope"}}},
+		{"C:\\src\\a.c:7: warning: unused variable\r\n",
+			[]Diagnostic{{"C:\\src\\a.c", 7, 0, "warning", "unused variable"}}},
+		{"a.cpp\na.cpp(10,5): error C2065: 'x': undeclared identifier\r\nC:\\src\\b.h(2): warning C4996: 'scanf': deprecated\r\n",
+			[]Diagnostic{
+				{"a.cpp", 10, 5, "error", "C2065: 'x': undeclared identifier"},
+				{"C:\\src\\b.h", 2, 0, "warning", "C4996: 'scanf': deprecated"},
+			}},
+		{"a.cpp:1:10: fatal error: foo.h: No such file or directory\n",
+			[]Diagnostic{{"a.cpp", 1, 10, "error", "foo.h: No such file or directory"}}},
+		{"compilation terminated.\n", nil},
+	}
+	parsers := []*Parser{GCC, MSVC}
+	for _, c := range cases {
+		if got := Parse([]byte(c.text), parsers); !reflect.DeepEqual(got, c.want) {
+			t.Errorf("%q: got %+v, want %+v", c.text, got, c.want)
+		}
+	}
+}
+
+func TestNewParser(t *testing.T) {
+	if _, err := NewParser("bad", `^(?P<file>.*):(?P<line>\d+)$`); err == nil {
+		t.Error("parser without message group accepted")
+	}
+	p, err := NewParser("fpc", `^(?P<file>[^(]+)\((?P<line>\d+),(?P<column>\d+)\) (?P<severity>Error|Warning): (?P<message>.*)$`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []Diagnostic{{"a.pas", 4, 3, "error", "Identifier not found \"x\""}}
+	if got := Parse([]byte("a.pas(4,3) Error: Identifier not found \"x\"\n"), []*Parser{p}); !reflect.DeepEqual(got, want) {
+		t.Errorf("got %+v, want %+v", got, want)
+	}
+}
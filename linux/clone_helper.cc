@@ -64,6 +64,13 @@ int Exec(const struct CloneParams& params) {
     return -1;
   }
 
+  // Own session without a controlling terminal: Ctrl+C and hangups of the
+  // terminal the judge runs in don't reach the child.
+  if (syscalls.setsid() < 0) {
+    Status(params.commfd, 5, syscalls.errno_);
+    return -1;
+  }
+
   if (params.suid) {
     if (syscalls.setuid(params.suid) < 0) {
       Status(params.commfd, 2, syscalls.errno_);
@@ -14,6 +14,7 @@ var childStages = map[int]string{
 	2: "setuid",
 	3: "ptrace",
 	4: "exec",
+	5: "setsid",
 }
 
 type StdHandles struct {
@@ -4,6 +4,7 @@ import (
 	"os"
 	"runtime"
 	"strconv"
+	"strings"
 	"sync"
 	"syscall"
 
@@ -20,7 +21,8 @@ type ContesterDesktop struct {
 }
 
 type GlobalData struct {
-	mu sync.Mutex
+	mu   sync.Mutex
+	opts GlobalDataOptions
 
 	desktop    *ContesterDesktop
 	desktopErr error
@@ -39,9 +41,15 @@ func (s errNoGlobalDataT) Error() string { return "no global data" }
 var errNoGlobalData = errNoGlobalDataT{}
 
 func (s *GlobalData) onceInitDesktop() {
-	s.desktop, s.desktopErr = createContesterDesktop()
+	s.desktop, s.desktopErr = createContesterDesktop(s.opts.DesktopAccounts, s.opts.SharedWindowStation)
+	if s.desktopErr != nil && s.opts.AllowNoDesktop {
+		log.Warningf("Can't create isolation desktop, running without it: %v", s.desktopErr)
+		s.desktop, s.desktopErr = &ContesterDesktop{}, nil
+	}
 }
 
+// GetDesktopName returns full name of the isolation desktop, or "" if it's unavailable and
+// GlobalDataOptions.AllowNoDesktop is set.
 func (s *GlobalData) GetDesktopName() (string, error) {
 	if s == nil {
 		return "", errNoGlobalData
@@ -84,7 +92,34 @@ func threadIdName(prefix string) string {
 	return prefix + strconv.FormatUint(uint64(windows.GetCurrentThreadId()), 10)
 }
 
-func createContesterDesktop() (result *ContesterDesktop, err error) {
+// desktopSids resolves accounts for the desktop ACEs, falling back to Everyone.
+func desktopSids(accounts []string) ([]*syscall.SID, error) {
+	var result []*syscall.SID
+	for _, account := range accounts {
+		var sid *syscall.SID
+		var err error
+		if strings.HasPrefix(account, "S-") {
+			sid, err = syscall.StringToSid(account)
+		} else {
+			sid, _, _, err = syscall.LookupSID("", account)
+		}
+		if err != nil {
+			log.Errorf("Can't resolve desktop account %q: %v", account, err)
+			continue
+		}
+		result = append(result, sid)
+	}
+	if len(result) != 0 {
+		return result, nil
+	}
+	everyone, err := syscall.StringToSid("S-1-1-0")
+	if err != nil {
+		return nil, os.NewSyscallError("StringToSid", err)
+	}
+	return []*syscall.SID{everyone}, nil
+}
+
+func createContesterDesktop(accounts []string, sharedWinsta bool) (result *ContesterDesktop, err error) {
 	var desk win32.Hdesk
 	var name string
 	origWinsta, err := win32.GetProcessWindowStation()
@@ -100,15 +135,18 @@ func createContesterDesktop() (result *ContesterDesktop, err error) {
 		return nil, err
 	}
 
-	winsta, err := win32.CreateWindowStation(
-		syscall.StringToUTF16Ptr(threadIdName("w")), 0, win32.MAXIMUM_ALLOWED, win32.MakeInheritSa())
-	if err != nil {
-		return nil, err
-	}
+	winsta := origWinsta
+	if !sharedWinsta {
+		winsta, err = win32.CreateWindowStation(
+			syscall.StringToUTF16Ptr(threadIdName("w")), 0, win32.MAXIMUM_ALLOWED, win32.MakeInheritSa())
+		if err != nil {
+			return nil, err
+		}
 
-	if err = win32.SetProcessWindowStation(winsta); err != nil {
-		win32.CloseWindowStation(winsta)
-		return
+		if err = win32.SetProcessWindowStation(winsta); err != nil {
+			win32.CloseWindowStation(winsta)
+			return
+		}
 	}
 
 	winstaName, err := win32.GetUserObjectName(syscall.Handle(winsta))
@@ -123,23 +161,25 @@ func createContesterDesktop() (result *ContesterDesktop, err error) {
 		}
 	}
 
-	win32.SetProcessWindowStation(origWinsta)
+	if !sharedWinsta {
+		win32.SetProcessWindowStation(origWinsta)
+	}
 	win32.SetThreadDesktop(origDesktop)
 	if err != nil {
 		return
 	}
 
-	everyone, err := syscall.StringToSid("S-1-1-0")
-	if err == nil {
-		if err = win32.AddAceToWindowStation(winsta, everyone); err != nil {
+	sids, err := desktopSids(accounts)
+	if err != nil {
+		log.Error(err)
+	}
+	for _, sid := range sids {
+		if err = win32.AddAceToWindowStation(winsta, sid); err != nil {
 			log.Error(err)
 		}
-		if err = win32.AddAceToDesktop(desk, everyone); err != nil {
+		if err = win32.AddAceToDesktop(desk, sid); err != nil {
 			log.Error(err)
 		}
-	} else {
-		err = os.NewSyscallError("StringToSid", err)
-		log.Error(err)
 	}
 
 	return &ContesterDesktop{
@@ -161,9 +201,11 @@ func getLoadLibrary() (uintptr, error) {
 	return addr, nil
 }
 
-func CreateGlobalData(needDesktop bool) (*GlobalData, error) {
-	var result GlobalData
-	if needDesktop {
+func CreateGlobalData(opts GlobalDataOptions) (*GlobalData, error) {
+	result := GlobalData{
+		opts: opts,
+	}
+	if opts.NeedDesktop {
 		result.onceInitDesktop()
 		if result.desktopErr != nil {
 			return nil, result.desktopErr
@@ -1,7 +1,20 @@
 package platform
 
+import (
+	"debug/pe"
+	"fmt"
+)
+
 type archDependentData struct{}
 
 func (s *GlobalData) GetLoadLibraryW32() (uintptr, error) {
 	return 0, nil
 }
+
+// loadLibraryForMachine returns the LoadLibraryW address to inject a DLL built for machine with.
+func (s *GlobalData) loadLibraryForMachine(machine uint16) (uintptr, error) {
+	if machine != pe.IMAGE_FILE_MACHINE_I386 {
+		return 0, fmt.Errorf("can't inject DLLs for machine 0x%04x from a 32-bit process", machine)
+	}
+	return s.GetLoadLibraryW()
+}
@@ -1,13 +1,20 @@
 package platform
 
 import (
+	"context"
+	"debug/pe"
+	"errors"
+	"fmt"
 	"os"
 	"os/exec"
 	"strconv"
+	"time"
 
 	_ "embed"
 )
 
+var errNo32BitInjection = errors.New("injecting DLLs into 32-bit processes is disabled (GlobalDataOptions.No32BitInjection)")
+
 type archDependentData struct {
 	loadLibraryW32    uintptr
 	loadLibraryW32Err error
@@ -16,7 +23,7 @@ type archDependentData struct {
 //go:embed Detect32BitEntryPoint.exe.embed
 var detect32BitEntryPointBinary []byte
 
-func getLoadLibrary32Bit() (uintptr, error) {
+func getLoadLibrary32Bit(timeout time.Duration) (uintptr, error) {
 	tfile, err := os.CreateTemp("", "detect32bit.*.exe")
 	if err != nil {
 		return 0, err
@@ -33,8 +40,13 @@ func getLoadLibrary32Bit() (uintptr, error) {
 		return 0, err
 	}
 
-	cmd := exec.Command(fname)
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	cmd := exec.CommandContext(ctx, fname)
 	txt, err := cmd.CombinedOutput()
+	if ctx.Err() == context.DeadlineExceeded {
+		return 0, fmt.Errorf("32-bit detector didn't finish in %s", timeout)
+	}
 	if err != nil {
 		return 0, err
 	}
@@ -46,13 +58,20 @@ func getLoadLibrary32Bit() (uintptr, error) {
 }
 
 func (s *GlobalData) onceInitLibraryW32() {
-	s.loadLibraryW32, s.loadLibraryW32Err = getLoadLibrary32Bit()
+	timeout := s.opts.Detect32BitTimeout
+	if timeout <= 0 {
+		timeout = DEFAULT_DETECT_32BIT_TIMEOUT
+	}
+	s.loadLibraryW32, s.loadLibraryW32Err = getLoadLibrary32Bit(timeout)
 }
 
 func (s *GlobalData) GetLoadLibraryW32() (uintptr, error) {
 	if s == nil {
 		return 0, errNoGlobalData
 	}
+	if s.opts.No32BitInjection {
+		return 0, errNo32BitInjection
+	}
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
@@ -65,3 +84,14 @@ func (s *GlobalData) GetLoadLibraryW32() (uintptr, error) {
 	}
 	return s.loadLibraryW32, nil
 }
+
+// loadLibraryForMachine returns the LoadLibraryW address to inject a DLL built for machine with.
+func (s *GlobalData) loadLibraryForMachine(machine uint16) (uintptr, error) {
+	switch machine {
+	case pe.IMAGE_FILE_MACHINE_AMD64:
+		return s.GetLoadLibraryW()
+	case pe.IMAGE_FILE_MACHINE_I386:
+		return s.GetLoadLibraryW32()
+	}
+	return 0, fmt.Errorf("can't inject DLLs for machine 0x%04x", machine)
+}
@@ -5,10 +5,14 @@ type GlobalData struct{}
 type ContesterDesktop struct {
 }
 
-func CreateGlobalData(opts bool) (*GlobalData, error) {
+func CreateGlobalData(opts GlobalDataOptions) (*GlobalData, error) {
 	return nil, nil
 }
 
 func (s *GlobalData) GetLoadLibraryW32() (uintptr, error) {
 	return 0, nil
 }
+
+func (s *GlobalData) WarmupInjection(dlls []string) error {
+	return nil
+}
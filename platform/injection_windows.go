@@ -0,0 +1,27 @@
+package platform
+
+import (
+	"debug/pe"
+	"fmt"
+)
+
+// WarmupInjection resolves the LoadLibraryW addresses needed to inject dlls and checks each of them against the
+// bitness it'd be injected with, so that a bad injection setup fails at startup instead of on the first run using
+// it. Addresses are cached, as if GetLoadLibraryW or GetLoadLibraryW32 was called.
+func (s *GlobalData) WarmupInjection(dlls []string) error {
+	if s == nil {
+		return errNoGlobalData
+	}
+	for _, dll := range dlls {
+		f, err := pe.Open(dll)
+		if err != nil {
+			return fmt.Errorf("inject DLL %q: %w", dll, err)
+		}
+		machine := f.FileHeader.Machine
+		f.Close()
+		if _, err = s.loadLibraryForMachine(machine); err != nil {
+			return fmt.Errorf("inject DLL %q: %w", dll, err)
+		}
+	}
+	return nil
+}
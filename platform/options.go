@@ -0,0 +1,27 @@
+package platform
+
+import "time"
+
+// Used when GlobalDataOptions.Detect32BitTimeout isn't set.
+const DEFAULT_DETECT_32BIT_TIMEOUT = 30 * time.Second
+
+type GlobalDataOptions struct {
+	// Create the isolation desktop right away instead of on first use.
+	NeedDesktop bool
+	// If the window station or desktop can't be created (Server Core, restricted hosts), log a warning and run
+	// processes with token and job isolation only. Results of such runs have IsolationDowngraded set; runs which set
+	// subprocess.FEATURE_DESKTOP to fail closed are refused instead.
+	AllowNoDesktop bool
+	// Create only the desktop, inside the window station of this process, instead of a new window station. It's
+	// cheaper, but the sandboxed processes then share the atom table and clipboard with everything else on this
+	// window station, and get access to the window station itself (not just their desktop).
+	SharedWindowStation bool
+	// Accounts (names or SID strings) granted access to the isolation window station and desktop. If none of them
+	// resolve, Everyone is granted access instead.
+	DesktopAccounts []string
+	// How long the helper detecting 32-bit LoadLibraryW address may run before it's killed.
+	Detect32BitTimeout time.Duration
+	// Never write and run that helper, for hosts where running executables from the temp directory is forbidden.
+	// Injecting DLLs into 32-bit processes then fails.
+	No32BitInjection bool
+}
@@ -2,11 +2,15 @@ package main
 
 import (
 	"bufio"
+	"bytes"
 	"errors"
 	"flag"
 	"fmt"
+	"net"
 	"os"
+	"strings"
 	"sync"
+	"time"
 
 	"github.com/contester/runlib/platform"
 	"github.com/contester/runlib/subprocess"
@@ -23,41 +27,127 @@ type processConfig struct {
 	CurrentDirectory string
 	Parameters       []string
 
-	TimeLimit       timeLimitFlag
-	WallTimeLimit   timeLimitFlag
-	KernelTimeLimit timeLimitFlag
-	MemoryLimit     memoryLimitFlag
-	Environment     envFlag
-	EnvironmentFile string
-	ProcessAffinity processAffinityFlag
+	TimeLimit          timeLimitFlag
+	WallTimeLimit      timeLimitFlag
+	KernelTimeLimit    timeLimitFlag
+	MemoryLimit        memoryLimitFlag
+	VirtualMemoryLimit memoryLimitFlag
+	Environment        envFlag
+	EnvironmentFile    string
+	Locale             string
+	ProcessAffinity    processAffinityFlag
+
+	MemorySampleInterval timeLimitFlag
+	WatchdogTimeout      timeLimitFlag
+	KillGracePeriod      timeLimitFlag
+	ContinueAfterClose   bool
+	InputStallTimeout    timeLimitFlag
+	ExcludeInputWait     bool
+	CrashDumps           bool
+	StartProbe           timeLimitFlag
+	ExitCodeClasses      string
+	RetryEnvError        uint
+	MinMemoryChecks      uint
+	MinExpectedTime      timeLimitFlag
+	MaxWallToCpuRatio    float64
+	FailOnWallToCpu      bool
+	WorkingSetMemory     bool
+	MeasureCycles        bool
+	MeasurePaging        bool
+	Deterministic        bool
+	CountModules         bool
+	CountThreads         bool
+	MaxThreads           uint
+	CountHandles         bool
+	HandleLimit          uint
+	CountDistinctFiles   bool
+	DistinctFileLimit    uint
+	CountSockets         bool
+	SocketLimit          uint
+	ModuleLimit          uint
+	MeasureIoWait        bool
+	MeasureSchedDelay    bool
+	KillSnapshotDir      string
+	ExecutableSha256     string
+
+	Nonce          string
+	NonceEnv       string
+	NonceFirstLine bool
+	VerifyNonce    bool
 
 	LoginName string
 	Password  string
 	InjectDLL string
+	InjectEnv envFlag
+
+	ReportLastError bool
+	ReportChildView bool
+
+	DllDirectories        envFlag
+	UnrestrictedDllSearch bool
+	Manifest              string
+
+	PriorityClass  string
+	IoPriority     string
+	ThreadPriority int
+
+	AppContainer string
+	Capabilities envFlag
+	DenyRegistry bool
+	AllowKeys    envFlag
+
+	AuditNamedObjects bool
+	NamedObjectLimit  uint
+
+	RemovePrivileges envFlag
+	KeepPrivileges   bool
+	AllowLargePages  bool
+	FailOpen         envFlag
+	FailClosed       envFlag
 
 	StdIn         string
+	StdInAppend   envFlag
 	StdOut        string
 	StdErr        string
 	JoinStdOutErr bool
+	PseudoConsole bool
 
+	StdInMaxSize  int64
 	StdOutMaxSize int64
 	StdErrMaxSize int64
 
+	FileInput      string
+	FileInputName  string
+	FileOutput     string
+	FileOutputName string
+
+	StageFiles        envFlag
+	RemoveStagedFiles bool
+
 	TrustedMode bool
 	NoIdleCheck bool
 	NoJob       bool
 
-	ProcessLimit int
+	ProcessLimit      int
+	TotalProcessLimit uint
+	CpuRatePercent    uint
 }
 
 type runexeConfig struct {
 	XML                 bool
+	Format              string
 	Interactor          string
 	ShowKernelModeTime  bool
 	ReturnExitCode      bool
 	Logfile             string
 	RecordProgramInput  string
 	RecordProgramOutput string
+	RemoteInteractor    string
+	AllowNoDesktop      bool
+	SharedWinsta        bool
+	TimeLimitMargin     timeLimitFlag
+	Warmup              int
+	CheckDeterminism    bool
 }
 
 type processType int
@@ -85,24 +175,93 @@ func CreateFlagSet() (*flag.FlagSet, *processConfig) {
 
 	fs.Var(&result.TimeLimit, "t", "")
 	fs.Var(&result.MemoryLimit, "m", "")
+	fs.Var(&result.VirtualMemoryLimit, "vm", "")
 	fs.Var(&result.Environment, "D", "")
 	fs.Var(&result.ProcessAffinity, "a", "")
 	fs.Var(&result.WallTimeLimit, "h", "")
+	fs.Var(&result.MemorySampleInterval, "memory-sample-interval", "")
+	fs.Var(&result.WatchdogTimeout, "watchdog", "")
+	fs.Var(&result.KillGracePeriod, "grace", "")
+	fs.BoolVar(&result.ContinueAfterClose, "continue-after-stdout-close", false, "")
+	fs.Var(&result.InputStallTimeout, "input-stall", "")
+	fs.BoolVar(&result.ExcludeInputWait, "exclude-input-wait", false, "")
+	fs.BoolVar(&result.CrashDumps, "crash-dumps", false, "")
+	fs.Var(&result.StartProbe, "start-probe", "")
+	fs.StringVar(&result.ExitCodeClasses, "exit-code-classes", "", "")
+	fs.UintVar(&result.RetryEnvError, "retry-env-error", 0, "")
+	fs.UintVar(&result.MinMemoryChecks, "min-memory-checks", 0, "")
+	fs.Var(&result.MinExpectedTime, "min-time", "")
+	fs.Float64Var(&result.MaxWallToCpuRatio, "max-wall-cpu-ratio", 0, "")
+	fs.BoolVar(&result.FailOnWallToCpu, "fail-wall-cpu-ratio", false, "")
+	fs.BoolVar(&result.WorkingSetMemory, "memory-working-set", false, "")
+	fs.BoolVar(&result.MeasureCycles, "measure-cycles", false, "")
+	fs.BoolVar(&result.MeasurePaging, "measure-paging", false, "")
+	fs.BoolVar(&result.Deterministic, "deterministic", false, "")
+	fs.BoolVar(&result.CountModules, "count-modules", false, "")
+	fs.BoolVar(&result.CountThreads, "count-threads", false, "")
+	fs.UintVar(&result.MaxThreads, "max-threads", 0, "")
+	fs.BoolVar(&result.CountHandles, "count-handles", false, "")
+	fs.UintVar(&result.HandleLimit, "handle-limit", 0, "")
+	fs.BoolVar(&result.CountDistinctFiles, "count-files", false, "")
+	fs.UintVar(&result.DistinctFileLimit, "file-limit", 0, "")
+	fs.BoolVar(&result.CountSockets, "count-sockets", false, "")
+	fs.UintVar(&result.SocketLimit, "socket-limit", 0, "")
+	fs.UintVar(&result.ModuleLimit, "module-limit", 0, "")
+	fs.BoolVar(&result.MeasureIoWait, "measure-io-wait", false, "")
+	fs.BoolVar(&result.MeasureSchedDelay, "measure-sched-delay", false, "")
+	fs.StringVar(&result.KillSnapshotDir, "kill-snapshot", "", "")
+	fs.StringVar(&result.ExecutableSha256, "sha256", "", "")
+	fs.StringVar(&result.Nonce, "nonce", "", "")
+	fs.StringVar(&result.NonceEnv, "nonce-env", "", "")
+	fs.BoolVar(&result.NonceFirstLine, "nonce-stdin", false, "")
+	fs.BoolVar(&result.VerifyNonce, "verify-nonce", false, "")
 	fs.StringVar(&result.CurrentDirectory, "d", "", "")
 	fs.StringVar(&result.LoginName, "l", "", "")
 	fs.StringVar(&result.Password, "p", "", "")
 	fs.StringVar(&result.InjectDLL, "j", "", "")
+	fs.Var(&result.InjectEnv, "inject-env", "")
+	fs.BoolVar(&result.ReportLastError, "report-last-error", false, "")
+	fs.BoolVar(&result.ReportChildView, "report-child-view", false, "")
+	fs.Var(&result.DllDirectories, "dll-dir", "")
+	fs.StringVar(&result.AppContainer, "appcontainer", "", "")
+	fs.Var(&result.Capabilities, "capability", "")
+	fs.BoolVar(&result.DenyRegistry, "deny-registry", false, "")
+	fs.BoolVar(&result.AuditNamedObjects, "audit-named-objects", false, "")
+	fs.UintVar(&result.NamedObjectLimit, "named-object-limit", 0, "")
+	fs.Var(&result.AllowKeys, "registry-allow", "")
+	fs.Var(&result.RemovePrivileges, "remove-privilege", "")
+	fs.BoolVar(&result.KeepPrivileges, "keep-privileges", false, "")
+	fs.BoolVar(&result.AllowLargePages, "allow-large-pages", false, "")
+	fs.Var(&result.FailOpen, "fail-open", "")
+	fs.Var(&result.FailClosed, "fail-closed", "")
+	fs.BoolVar(&result.UnrestrictedDllSearch, "unrestricted-dll-search", false, "")
+	fs.StringVar(&result.Manifest, "manifest", "", "")
+	fs.StringVar(&result.PriorityClass, "priority-class", "", "")
+	fs.IntVar(&result.ThreadPriority, "thread-priority", 0, "")
+	fs.StringVar(&result.IoPriority, "io-priority", "", "")
 	fs.StringVar(&result.StdIn, "i", "", "")
+	fs.Var(&result.StdInAppend, "i-append", "")
 	fs.StringVar(&result.StdOut, "o", "", "")
+	fs.Int64Var(&result.StdInMaxSize, "is", 0, "")
 	fs.Int64Var(&result.StdOutMaxSize, "os", 0, "")
 	fs.Int64Var(&result.StdErrMaxSize, "es", 0, "")
 	fs.StringVar(&result.StdErr, "e", "", "")
 	fs.StringVar(&result.EnvironmentFile, "envfile", "", "")
+	fs.StringVar(&result.Locale, "locale", "", "")
+	fs.StringVar(&result.FileInput, "file-input", "", "")
+	fs.StringVar(&result.FileInputName, "file-input-name", "", "")
+	fs.StringVar(&result.FileOutput, "file-output", "", "")
+	fs.StringVar(&result.FileOutputName, "file-output-name", "", "")
+	fs.Var(&result.StageFiles, "stage", "")
+	fs.BoolVar(&result.RemoveStagedFiles, "remove-staged", false, "")
 	fs.BoolVar(&result.JoinStdOutErr, "u", false, "")
+	fs.BoolVar(&result.PseudoConsole, "pty", false, "")
 	fs.BoolVar(&result.TrustedMode, "z", false, "")
 	fs.BoolVar(&result.NoIdleCheck, "no-idleness-check", false, "")
 	fs.BoolVar(&result.NoJob, "no-job", false, "")
 	fs.IntVar(&result.ProcessLimit, "process-limit", 0, "")
+	fs.UintVar(&result.TotalProcessLimit, "total-process-limit", 0, "")
+	fs.UintVar(&result.CpuRatePercent, "cpu-rate", 0, "")
 
 	return fs, &result
 }
@@ -110,12 +269,19 @@ func CreateFlagSet() (*flag.FlagSet, *processConfig) {
 func AddGlobalFlags(fs *flag.FlagSet) *runexeConfig {
 	var result runexeConfig
 	fs.BoolVar(&result.XML, "xml", false, "")
+	fs.StringVar(&result.Format, "format", "", "")
 	fs.StringVar(&result.Interactor, "interactor", "", "")
 	fs.StringVar(&result.Logfile, "logfile", "", "")
 	fs.StringVar(&result.RecordProgramInput, "ri", "", "")
 	fs.StringVar(&result.RecordProgramOutput, "ro", "", "")
+	fs.StringVar(&result.RemoteInteractor, "remote-interactor", "", "")
 	fs.BoolVar(&result.ShowKernelModeTime, "show-kernel-mode-time", false, "")
 	fs.BoolVar(&result.ReturnExitCode, "x", false, "")
+	fs.BoolVar(&result.AllowNoDesktop, "allow-no-desktop", false, "")
+	fs.BoolVar(&result.SharedWinsta, "shared-winsta", false, "")
+	fs.Var(&result.TimeLimitMargin, "tl-margin", "")
+	fs.IntVar(&result.Warmup, "warmup", 0, "")
+	fs.BoolVar(&result.CheckDeterminism, "check-determinism", false, "")
 	return &result
 }
 
@@ -131,6 +297,17 @@ func ParseFlagSet(fs *flag.FlagSet, pc *processConfig, args []string) error {
 	return nil
 }
 
+// desktopAccounts returns accounts the processes are started under; the current user has access to the desktop anyway.
+func desktopAccounts(program, interactor *processConfig) []string {
+	var result []string
+	for _, pc := range []*processConfig{program, interactor} {
+		if pc != nil && pc.NeedLogin() {
+			result = append(result, pc.LoginName)
+		}
+	}
+	return result
+}
+
 func (pc *processConfig) NeedLogin() bool {
 	return pc.LoginName != "" && pc.Password != ""
 }
@@ -146,6 +323,35 @@ func fillRedirect(x string, maxSize int64) *subprocess.Redirect {
 	}
 }
 
+// fillInputRedirect is fillRedirect for stdin, which can be several files fed one after another.
+func fillInputRedirect(x string, appended []string) *subprocess.Redirect {
+	if len(appended) == 0 {
+		return fillRedirect(x, 0)
+	}
+	var sources []subprocess.InputSource
+	for _, name := range append([]string{x}, appended...) {
+		if name != "" {
+			sources = append(sources, subprocess.InputSource{Filename: name})
+		}
+	}
+	return &subprocess.Redirect{Mode: subprocess.REDIRECT_CONCAT, Sources: sources}
+}
+
+// fillOutputRedirect is fillRedirect for stdout and stderr, which can also go to a named pipe.
+func fillOutputRedirect(x string, maxSize int64) *subprocess.Redirect {
+	if x != "" && subprocess.IsNamedPipe(x) {
+		return &subprocess.Redirect{Filename: x, Mode: subprocess.REDIRECT_NAMED_PIPE}
+	}
+	return fillRedirect(x, maxSize)
+}
+
+// setStdInLimit applies -is once stdin redirect is final, as interactors replace it.
+func setStdInLimit(sub *subprocess.Subprocess, pc *processConfig) {
+	if sub.StdIn != nil {
+		sub.StdIn.MaxInputSize = pc.StdInMaxSize
+	}
+}
+
 func readEnvironmentFile(name string) ([]string, error) {
 	f, err := os.Open(name)
 	if err != nil {
@@ -193,10 +399,60 @@ func SetupSubprocess(s *processConfig, env *platform.GlobalData) (*subprocess.Su
 		sub.WallTimeLimit = subprocess.DuFromMicros(uint64(s.WallTimeLimit))
 	}
 	sub.MemoryLimit = uint64(s.MemoryLimit)
+	if s.WorkingSetMemory {
+		sub.MemoryAccounting = subprocess.MEMORY_ACCOUNTING_WORKING_SET
+	}
+	sub.VirtualMemoryLimit = uint64(s.VirtualMemoryLimit)
+	sub.MemorySampleInterval = subprocess.DuFromMicros(uint64(s.MemorySampleInterval))
+	sub.WatchdogTimeout = subprocess.DuFromMicros(uint64(s.WatchdogTimeout))
+	sub.KillGracePeriod = subprocess.DuFromMicros(uint64(s.KillGracePeriod))
+	sub.InputStallTimeout = subprocess.DuFromMicros(uint64(s.InputStallTimeout))
+	sub.ExcludeInputWait = s.ExcludeInputWait
+	sub.CrashDumps = s.CrashDumps
+	if s.ContinueAfterClose {
+		sub.OnStdOutClose = subprocess.STDOUT_CLOSE_CONTINUE
+	}
+	sub.StartProbe = subprocess.DuFromMicros(uint64(s.StartProbe))
+	classes, err := subprocess.ParseExitCodeClasses(s.ExitCodeClasses)
+	if err != nil {
+		return nil, err
+	}
+	sub.ExitCodeClasses = classes
+	sub.RetryOnEnvironmentError = uint32(s.RetryEnvError)
+	sub.MinMemoryChecks = uint32(s.MinMemoryChecks)
+	sub.MinExpectedTime = subprocess.DuFromMicros(uint64(s.MinExpectedTime))
+	sub.MaxWallToCpuRatio = s.MaxWallToCpuRatio
+	sub.FailOnWallToCpuRatio = s.FailOnWallToCpu
+	sub.MeasureCycles = s.MeasureCycles
+	sub.MeasurePaging = s.MeasurePaging
+	sub.Deterministic = s.Deterministic
+	sub.CountModules = s.CountModules
+	sub.CountThreads = s.CountThreads
+	sub.MaxThreads = uint32(s.MaxThreads)
+	sub.CountHandles = s.CountHandles
+	sub.HandleLimit = uint32(s.HandleLimit)
+	sub.CountDistinctFiles = s.CountDistinctFiles
+	sub.DistinctFileLimit = uint32(s.DistinctFileLimit)
+	sub.CountSockets = s.CountSockets
+	sub.SocketLimit = uint32(s.SocketLimit)
+	sub.ModuleLimit = uint32(s.ModuleLimit)
+	sub.MeasureIoWait = s.MeasureIoWait
+	sub.MeasureSchedulerDelay = s.MeasureSchedDelay
+	sub.KillSnapshotDir = s.KillSnapshotDir
+	sub.ExecutableSha256 = s.ExecutableSha256
+	if s.Nonce != "" || s.NonceEnv != "" || s.NonceFirstLine {
+		sub.Nonce = &subprocess.Nonce{
+			Value:     s.Nonce,
+			EnvName:   s.NonceEnv,
+			FirstLine: s.NonceFirstLine,
+			Verify:    s.VerifyNonce,
+		}
+	}
 	sub.CheckIdleness = !s.NoIdleCheck
 	sub.RestrictUi = !s.TrustedMode
 	sub.ProcessAffinityMask = uint64(s.ProcessAffinity)
 	sub.NoJob = s.NoJob
+	sub.CpuRatePercent = uint32(s.CpuRatePercent)
 	if s.ProcessLimit > 0 {
 		if s.NoJob {
 			return nil, errors.New("can't enforce process limit if not using job object")
@@ -204,6 +460,15 @@ func SetupSubprocess(s *processConfig, env *platform.GlobalData) (*subprocess.Su
 		sub.FailOnJobCreationFailure = true
 		sub.ProcessLimit = uint32(s.ProcessLimit)
 	}
+	if s.TotalProcessLimit > 0 {
+		if s.NoJob {
+			return nil, errors.New("can't enforce total process limit if not using job object")
+		}
+		sub.FailOnJobCreationFailure = true
+		sub.TotalProcessLimit = uint32(s.TotalProcessLimit)
+	}
+
+	sub.Locale = s.Locale
 
 	if s.EnvironmentFile != "" {
 		var err error
@@ -216,18 +481,34 @@ func SetupSubprocess(s *processConfig, env *platform.GlobalData) (*subprocess.Su
 		sub.NoInheritEnvironment = true
 	}
 
-	sub.StdIn = fillRedirect(s.StdIn, 0)
-	sub.StdOut = fillRedirect(s.StdOut, s.StdOutMaxSize)
+	sub.StdIn = fillInputRedirect(s.StdIn, s.StdInAppend)
+	sub.StdOut = fillOutputRedirect(s.StdOut, s.StdOutMaxSize)
 	if s.JoinStdOutErr {
 		sub.JoinStdOutErr = true
 	} else {
-		sub.StdErr = fillRedirect(s.StdErr, s.StdErrMaxSize)
+		sub.StdErr = fillOutputRedirect(s.StdErr, s.StdErrMaxSize)
 	}
 
+	if s.FileInput != "" || s.FileOutput != "" {
+		sub.FileIO = &subprocess.FileIO{
+			InputName:  s.FileInputName,
+			OutputName: s.FileOutputName,
+			InputFile:  s.FileInput,
+		}
+	}
+
+	for _, v := range s.StageFiles {
+		name, source, ok := strings.Cut(v, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid -stage %q, must be name=filename", v)
+		}
+		sub.StageFiles = append(sub.StageFiles, subprocess.StagedFile{Name: name, Source: source})
+	}
+	sub.RemoveStagedFiles = s.RemoveStagedFiles
+
 	sub.Options = newPlatformOptions()
 	sub.Options.Environment = env
 
-	var err error
 	if s.NeedLogin() {
 		sub.Login, err = subprocess.NewLoginInfo(s.LoginName, s.Password)
 		if err != nil {
@@ -235,7 +516,21 @@ func SetupSubprocess(s *processConfig, env *platform.GlobalData) (*subprocess.Su
 		}
 	}
 
-	setInject(sub.Options, s.InjectDLL)
+	if err = setInject(sub.Options, s.InjectDLL, s.InjectEnv, s.ReportLastError, s.ReportChildView); err != nil {
+		return nil, err
+	}
+	setDllSearch(sub.Options, s.DllDirectories, s.UnrestrictedDllSearch, s.Manifest)
+	setPseudoConsole(sub.Options, s.PseudoConsole)
+	setAppContainer(sub.Options, s.AppContainer, s.Capabilities)
+	setRegistryPolicy(sub.Options, s.DenyRegistry, s.AllowKeys)
+	setNamedObjectAudit(sub.Options, s.AuditNamedObjects || s.NamedObjectLimit > 0, uint32(s.NamedObjectLimit))
+	setPrivileges(sub.Options, s.RemovePrivileges, s.KeepPrivileges, s.AllowLargePages)
+	if err = setFailPolicies(sub.Options, s.FailOpen, s.FailClosed); err != nil {
+		return nil, err
+	}
+	if err = setPriority(sub.Options, s.PriorityClass, s.ThreadPriority, s.IoPriority); err != nil {
+		return nil, err
+	}
 	return sub, nil
 }
 
@@ -260,6 +555,58 @@ func ExecAndSend(sub *subprocess.Subprocess, pr **RunResult, ptype processType,
 	*pr = &r
 }
 
+// rerunExtended repeats a run that exceeded its time limit, with the limit extended by margin, to find out how much
+// time the program really needs. This is for problem preparation only: the original verdict is kept as is.
+func rerunExtended(pc *processConfig, env *platform.GlobalData, margin time.Duration) *RunResult {
+	sub, err := SetupSubprocess(pc, env)
+	if err != nil {
+		return &RunResult{T: processProgram, V: verdictFail, E: err}
+	}
+	sub.TimeLimit += margin
+	if sub.WallTimeLimit > 0 {
+		sub.WallTimeLimit += margin
+	}
+	var result *RunResult
+	ExecAndSend(sub, &result, processProgram, nil)
+	return result
+}
+
+// checkDeterminism runs the program once more and compares its output with the one of the first run, to find
+// solutions which may be judged differently on the same test. This is for problem preparation only.
+func checkDeterminism(pc *processConfig, env *platform.GlobalData, first *RunResult) *determinismResult {
+	want, err := os.ReadFile(pc.StdOut)
+	if err != nil {
+		return &determinismResult{Err: err}
+	}
+	sub, err := SetupSubprocess(pc, env)
+	if err != nil {
+		return &determinismResult{Err: err}
+	}
+	setStdInLimit(sub, pc)
+	// One byte more, so longer output doesn't look the same.
+	sub.StdOut = &subprocess.Redirect{Mode: subprocess.REDIRECT_MEMORY, MaxOutputSize: int64(len(want)) + 1}
+	result := &determinismResult{}
+	ExecAndSend(sub, &result.Second, processProgram, nil)
+	if r := result.Second.R; r != nil {
+		result.Identical = bytes.Equal(r.Output, want) && bytes.Equal(r.FileOutput, first.R.FileOutput)
+	}
+	return result
+}
+
+// warmupRuns executes the program n times and discards the results, so the measured run doesn't pay for cold disk
+// cache and loader. This is for benchmarking only.
+func warmupRuns(pc *processConfig, env *platform.GlobalData, n int) {
+	for i := 0; i < n; i++ {
+		sub, err := SetupSubprocess(pc, env)
+		if err != nil {
+			Fail(err, "Setup warmup subprocess")
+		}
+		if _, err = sub.Execute(); err != nil {
+			log.Warningf("warmup run %d: %s", i, err)
+		}
+	}
+}
+
 func ParseFlags(globals bool, args []string) (pc *processConfig, gc *runexeConfig, err error) {
 	var fs *flag.FlagSet
 
@@ -295,11 +642,25 @@ func main() {
 	}
 
 	if globalFlags.XML {
-		fmt.Println(xmlHeaderText)
-		failLog = FailXml
+		globalFlags.Format = "xml"
+	}
+	format, err := getResultFormat(globalFlags.Format)
+	if err != nil {
+		Fail(err, "Parse main flags")
+	}
+	if format != nil {
+		if format.header != "" {
+			fmt.Println(format.header)
+		}
+		failLog = format.fail
 	}
 
-	globalData, err := platform.CreateGlobalData(desktopNeeded(programFlags, interactorFlags))
+	globalData, err := platform.CreateGlobalData(platform.GlobalDataOptions{
+		NeedDesktop:         desktopNeeded(programFlags, interactorFlags),
+		AllowNoDesktop:      globalFlags.AllowNoDesktop,
+		SharedWindowStation: globalFlags.SharedWinsta,
+		DesktopAccounts:     desktopAccounts(programFlags, interactorFlags),
+	})
 
 	if err != nil {
 		Fail(err, "Creating platform data")
@@ -345,6 +706,41 @@ func main() {
 		}
 	}
 
+	if globalFlags.RemoteInteractor != "" {
+		if interactor != nil {
+			Fail(errors.New("-interactor and -remote-interactor are mutually exclusive"), "Parse main flags")
+		}
+		conn, err := net.Dial("tcp", globalFlags.RemoteInteractor)
+		if err != nil {
+			Fail(err, "Connect to remote interactor")
+		}
+		defer conn.Close()
+		program.StdIn = &subprocess.Redirect{
+			Mode: subprocess.REDIRECT_SOCKET,
+			Conn: conn,
+		}
+		program.StdOut = &subprocess.Redirect{
+			Mode: subprocess.REDIRECT_SOCKET,
+			Conn: conn,
+		}
+	}
+
+	setStdInLimit(program, programFlags)
+	if interactor != nil {
+		setStdInLimit(interactor, interactorFlags)
+	}
+
+	if globalFlags.Warmup > 0 {
+		if interactor != nil || globalFlags.RemoteInteractor != "" {
+			Fail(errors.New("-warmup can't be used with interactors"), "Parse main flags")
+		}
+		warmupRuns(programFlags, globalData, globalFlags.Warmup)
+	}
+	if globalFlags.CheckDeterminism &&
+		(interactor != nil || globalFlags.RemoteInteractor != "" || programFlags.StdOut == "") {
+		Fail(errors.New("-check-determinism needs -o and can't be used with interactors"), "Parse main flags")
+	}
+
 	var wg sync.WaitGroup
 	wg.Add(1)
 	var results [2]*RunResult
@@ -355,13 +751,27 @@ func main() {
 	go ExecAndSend(program, &results[0], processProgram, &wg)
 	wg.Wait()
 
+	if globalFlags.TimeLimitMargin > 0 && interactor == nil && results[0] != nil &&
+		results[0].V == verdictTimeLimitExceeded {
+		results[0].Extended = rerunExtended(programFlags, globalData, subprocess.DuFromMicros(uint64(globalFlags.TimeLimitMargin)))
+	}
+
+	if globalFlags.CheckDeterminism && results[0] != nil && results[0].R != nil {
+		results[0].Determinism = checkDeterminism(programFlags, globalData, results[0])
+	}
+
 	var programReturnCode int
 	if results[0] != nil && results[0].R != nil {
 		programReturnCode = int(results[0].R.ExitCode)
+		if programFlags.FileOutput != "" && results[0].R.FileOutput != nil {
+			if err := os.WriteFile(programFlags.FileOutput, results[0].R.FileOutput, 0644); err != nil {
+				log.Error(err)
+			}
+		}
 	}
 
-	if globalFlags.XML {
-		PrintResultsXml(results[:])
+	if format != nil {
+		format.print(results[:])
 	} else {
 		for _, result := range results {
 			if result == nil {
@@ -121,9 +121,31 @@ Command line format:
 Global options:
   -help         - show help
   -xml          - print result in xml format (otherwise, use human-readable)
+  -format <name> - print result for another judge system: xml (same as -xml,
+                  the Polygon invoker format), or cms (an isolate meta file,
+                  program only).
   -show-kernel-mode-time - include kernel-mode time in human-readable format
                   (always included in xml)
   -x            - return process exit code
+  -tl-margin <value> - FOR PROBLEM PREPARATION ONLY, never use it for judging.
+                  If the program exceeds its time limit, run it once more with
+                  the limit extended by <value> (same format as -t) and print
+                  how much time it actually took. The verdict of the first run
+                  is not changed. Ignored in interactor mode.
+  -warmup <n>   - FOR BENCHMARKING ONLY, never use it for judging. Run the
+                  program <n> times with the same options and discard the
+                  results before the measured run, to warm up disk cache.
+                  Can't be used with interactors.
+  -check-determinism - FOR PROBLEM PREPARATION ONLY. Run the program once more
+                  on the same input and tell if its output (-o, and the
+                  -file-output file) was byte-identical, with time and memory
+                  of the second run. Needs -o, can't be used with interactors.
+  -allow-no-desktop - if isolated desktop can't be created (e.g. on Server Core),
+                  run with job and user isolation only, with a warning in the
+                  result.
+  -shared-winsta - create the isolated desktop in the current window station
+                  instead of a new one. Faster, but processes share clipboard
+                  and atoms with the window station.
   -logfile=<f>  - for runexe developers only
   -interactor="<process properties> interactor <parameters>"
                   INTERACTOR MODE
@@ -134,6 +156,12 @@ Global options:
     program and interactor.
   -ri=<f>       - in interactor mode, record program input to file <f>.
   -ro=<f>       - in interactor mode, record program output to file <f>.
+  -remote-interactor=<host:port>
+                  Connect to an interactor running elsewhere over TCP and use
+                  that connection as program stdin&stdout. If the connection
+                  is lost, the program is terminated with the
+                  INTERACTOR_DISCONNECTED verdict. Can't be combined with
+                  -interactor.
 
 Process properties:
   -t <value>    - time limit. Terminate after <value> seconds, you can use
@@ -142,24 +170,224 @@ Process properties:
   -m <value>    - memory limit. Terminate if anonymous virtual memory of the
                   process exceeds <value> bytes. Use suffixes K, M, G to
                   specify kilo, mega, gigabytes.
+  -memory-working-set - measure memory (and apply -m) as peak working set of
+                  the process, including shared pages like system libraries,
+                  instead of private memory. Windows only.
+  -vm <value>   - virtual memory limit. Terminate if reserved address space of
+                  the main process exceeds <value> bytes, even if it's not
+                  committed. Same suffixes as -m. This is reported as
+                  VIRTUAL_MEMORY_LIMIT_EXCEEDED, separately from -m.
+  -memory-sample-interval <value> - record process commit and working set every
+                  <value> seconds (same format as -t) and print the series.
+  -grace <value> - when the program hits -t, -h or idleness limit, ask it to
+                  stop (CTRL_BREAK, SIGTERM on Linux) and wait <value> (same
+                  format as -t) before killing it, keeping what it prints
+                  meanwhile. On Windows, only works if runexe has no console.
+  -continue-after-stdout-close - with an interactor, keep running a program
+                  which closed its stdout, until it exits or hits a limit. By
+                  default it's taken as done communicating and stopped shortly
+                  after, as if it exited.
+  -input-stall <value> - with an interactor, stop the program with verdict
+                  INPUT_STALLED if input it hasn't read sits there for <value>
+                  (same format as -t). Checked every quarter of a second.
+  -exclude-input-wait - with an interactor, don't count time the program
+                  waits for input against -h: the limit is then on wall time
+                  minus input wait. Quarters of a second with no unread input
+                  and no CPU time used count as waiting.
+  -crash-dumps  - let the OS write a core dump, or a Windows Error Reporting
+                  dump, when the program crashes. Off by default, as it makes
+                  crashes slow; for debugging.
+  -watchdog <value> - kill the program if runexe itself stops checking on it
+                  for <value> (same format as -t), and report FAILED.
+  -start-probe <value> - first start the program with empty input for <value>
+                  (same format as -t). If it can't be loaded (a DLL or shared
+                  library is missing), report CANNOT_START without the real
+                  run; otherwise run it again as usual.
+  -exit-code-classes <list> - like "0xC0000135=ENVIRONMENT,3=RUNTIME": exit
+                  codes reported as ENVIRONMENT_ERROR (the host's fault) or as
+                  the program's own. On Windows, loader failures like a
+                  missing DLL are ENVIRONMENT_ERROR by default.
+  -retry-env-error <n> - run the program again, up to <n> times, if it ends
+                  with ENVIRONMENT_ERROR. Not done with an interactor.
+  -min-memory-checks <n> - when running without a job object (-no-job, or
+                  job creation failed), check memory <n> times during the
+                  first quarter second, so a short run doesn't miss its peak.
+                  Windows only.
+  -min-time <value> - print a warning if the program successfully finishes
+                  using less than <value> time (same format as -t). This may
+                  mean it printed a precomputed answer. Doesn't change the
+                  verdict.
+  -max-wall-cpu-ratio <r> - print a warning if a run of a second or more takes
+                  over <r> times more wall time than CPU time, as from
+                  sleeping to stay under the time limit. It's a heuristic:
+                  waiting on I/O or a loaded host looks the same.
+  -fail-wall-cpu-ratio - give IDLENESS_LIMIT_EXCEEDED instead of the warning.
+  -kill-snapshot <dir> - debugging only, slow: if the program is killed for
+                  -h or idleness, first write its minidump (with thread stacks)
+                  into <dir> and print time used by each thread. Windows only.
+  -measure-cycles - report CPU cycles used by the process (Windows), or
+                  package energy consumed during the run (Linux, RAPL).
+  -measure-paging - report hard page faults and a rough estimate of wall time
+                  spent on them, to spot runs slowed down by host memory
+                  pressure, and if the OS trimmed the working set (Windows).
+  -measure-io-wait - report an estimate of wall time spent waiting for I/O
+                  (including pipes): wall time minus CPU time minus time the
+                  program was seen idle, without CPU use or I/O.
+  -measure-sched-delay - report time the program was ready to run, but
+                  waiting for a CPU. A high one means the host is
+                  oversubscribed, and the run may be worth repeating. On
+                  Windows it's an estimate, sampled every time quantum.
+  -sha256 <hex> - refuse to run the program unless its file has this SHA-256.
+                  The file is kept open from the check until the process is
+                  created, so it can't be replaced in between (Windows).
+  -count-modules - report how many distinct DLLs/shared objects the program
+                  loaded. Sampled, so short-lived loads may be missed.
+  -module-limit <n> - implies -count-modules, warn if more than <n> modules
+                  were loaded. The verdict is not changed.
+  -count-threads - report the most threads the program (with its child
+                  processes) had at once. Sampled, so it's a lower bound.
+  -max-threads <n> - implies -count-threads, stop the program with verdict
+                  MULTITHREADED once it's seen with more than <n> threads. On
+                  Windows 10+ count the loader's own workers: up to 4 threads
+                  even in a single-threaded program.
+  -count-handles - report the most handles (file descriptors on Linux) the
+                  program had open at once, sampled like threads.
+  -handle-limit <n> - implies -count-handles, stop the program with
+                  SECURITY_VIOLATION when it has more than <n> handles open.
+  -count-files - report how many distinct files the program opened over the
+                  run. Sampled on Linux; on Windows read from the audit log, so
+                  it needs file system auditing.
+  -file-limit <n> - implies -count-files, stop the program with
+                  SECURITY_VIOLATION once it has opened more than <n> files.
+  -count-sockets - report the most sockets the program had open at once,
+                  sampled like threads.
+  -socket-limit <n> - implies -count-sockets, warn when the program had more
+                  than <n> sockets open. The run isn't stopped.
   -D k=v        - environment. If any is specified, existing environment is
 				  cleared.
   -envfile <filename> - if specified, the file is loaded as new process environment.
+  -locale <name> - locale of the process, like en_US.UTF-8, set in LANG and
+                  LC_ALL, and on Windows passed to the -j DLL as well. C by
+                  default, "host" keeps the environment as is.
   -d <value>    - current directory for the process.
   -l <value>    - login name. Create process under <value> user.
   -p <value>    - password for user specified in -l. On linux, ignored (but
                   must be present).
   -j <filename> - inject <filename> DLL into process.
+  -inject-env k=v - configuration for the injected DLL: set RUNLIB_k=v in the
+                  process environment. Variables starting with RUNLIB_ are
+                  reserved, any others with this prefix are removed from the
+                  environment. Can be repeated.
+  -report-last-error - debugging, with -j: the DLL gets a file path in
+                  RUNLIB_STATUS_FILE, and may write "last_error <n>" there as
+                  the process exits; <n> is printed with the results.
+  -report-child-view - with -j: also set RUNLIB_REPORT_VIEW, asking the DLL to
+                  write the arguments and environment as the process parsed
+                  them, as 'arg "<s>"' and 'env "<s>"' lines; they're printed
+                  with the results, to check quoting and encoding.
+  -dll-dir <dir> - by default the current directory is excluded from the
+                  search order for DLLs loaded at runtime. If any -dll-dir is
+                  given, only the application directory, system directories
+                  and these directories are searched. Can be repeated.
+  -unrestricted-dll-search - use default Windows DLL search order.
+  -manifest <file> - run the program with this application manifest, to pin
+                  side-by-side assemblies, like the MSVC runtime version, it
+                  binds to. Copied next to the program as <program>.manifest.
+                  Programs with an embedded manifest are refused, as it would
+                  take precedence.
+  -appcontainer <name> - Windows 8+: run in AppContainer <name> (created if
+                  missing) instead of -l. The container gets full access to
+                  -d, and can read what ALL APPLICATION PACKAGES can: system
+                  directories, Program Files, most of HKLM. Compilers and
+                  runtimes elsewhere need a read ACE for S-1-15-2-1.
+  -remove-privilege <name> - remove the privilege, like SeShutdownPrivilege,
+                  from the process token. Can be repeated. By default, with
+                  -l all privileges but SeChangeNotifyPrivilege are removed.
+  -keep-privileges - with -l, don't remove any privileges by default but
+                  SeLockMemoryPrivilege.
+  -allow-large-pages - keep SeLockMemoryPrivilege, removed from all processes
+                  otherwise, so the program can allocate large pages. These
+                  are locked in memory, and -memory-working-set doesn't see
+                  them.
+  -fail-open <feature> - if the host can't apply the hardening feature
+                  (desktop, privileges, dll-search), run without it and warn.
+                  Can be repeated.
+  -fail-closed <feature> - refuse the run instead. By default desktop and
+                  privileges fail closed (desktop open with
+                  -allow-no-desktop), dll-search fails open unless
+                  -dll-dir is given.
+  -capability <cap> - grant a capability to the container, as a SID or one
+                  of internetClient, internetClientServer,
+                  privateNetworkClientServer. None by default; stdio-only
+                  solutions in C++, Java, Python or .NET need none. TCP
+                  interactors need internetClientServer, plus
+                  "CheckNetIsolation LoopbackExempt -a -n=<name>" for
+                  localhost. Can be repeated.
+  -deny-registry - with -appcontainer, Windows 10 1703+: close the registry
+                  to the process; it loses access granted to ALL APPLICATION
+                  PACKAGES, but keeps -d and system directories. Reported as
+                  SECURITY_VIOLATION if the host audits failed registry access.
+  -registry-allow <key> - with -deny-registry, let the process read <key>, like
+                  MACHINE\SOFTWARE\Microsoft\.NETFramework. Can be repeated.
+  -audit-named-objects - report how many named kernel objects (events,
+                  mutexes, sections...) the program created or opened, from the
+                  Security log. Needs "Kernel Object" auditing and the "Audit
+                  the access of global system objects" policy on the host.
+  -named-object-limit <n> - implies -audit-named-objects, warn if more than <n>
+                  named objects were used. The verdict is not changed.
+  -priority-class <class> - run the process in idle, below, normal, above, high
+                  or realtime priority class. Realtime needs "Increase
+                  scheduling priority" privilege, otherwise high is used.
+  -thread-priority <n> - priority of the main thread relative to the class,
+                  from -2 to 2, or -15 (idle) and 15 (time critical). Other
+                  threads keep normal priority. If a priority can't be set,
+                  the process runs anyway with a warning in the log.
+  -io-priority <p> - run the process with normal, low or very-low I/O
+                  priority. Lower ones keep it from starving the disk, but
+                  slow its I/O down: mind the wall time limit.
   -i <filename> - redirect standard input to <filename>.
+  -i-append <filename> - feed <filename> after -i (or after the previous
+                  -i-append), as one input. Can be repeated. All files are
+                  opened before the start; if one fails to read midway, the
+                  input is cut there and the verdict is FAIL.
   -o <filename> - redirect standard output to <filename>.
   -e <filename> - redirect standard error to <filename>.
+                  For -o and -e, <filename> can be a named pipe (\\.\pipe\name
+                  on Windows, a FIFO on Linux) served by another program; it
+                  must be reading before the run starts.
+  -is <value>   - feed at most <value> bytes to standard input, then close
+                  it. With an interactor, bounds how much it can send.
   -os <value>   - limit size of standard output file to <value>.
   -es <value>   - limit size of standard error file to <value>.
   -u            - instead of using separate stderr, join error output to standard output.
+  -pty          - run the process in a pseudo console, for programs which
+                  behave differently on a terminal. -i and -o feed and receive
+                  the console, error output goes to -o too; output contains
+                  terminal escape sequences. Needs Windows 10 1809 or later and
+                  doesn't work with -l, falls back to plain redirects otherwise.
+  -file-input <filename> - for problems with file I/O: copy <filename> into the
+                  current directory of the process as input.txt before start.
+  -file-output <filename> - save output.txt written by the process into
+                  <filename>. Output file left from previous runs is removed.
+  -file-input-name <name>, -file-output-name <name> - use these names instead
+                  of input.txt and output.txt.
+  -stage <name>=<filename> - copy <filename> into the current directory of the
+                  process as <name> before start, readable by the -l user.
+                  Can be repeated.
+  -remove-staged - remove files copied by -stage after the run.
+  -nonce <value> - per-run value the program must echo; random if empty and
+                  -nonce-env or -nonce-stdin is given.
+  -nonce-env <name> - pass the nonce in environment variable <name>.
+  -nonce-stdin  - feed the nonce as the first line of standard input.
+  -verify-nonce - warn if standard output doesn't contain the nonce.
   -no-idleness-check - switch off idleness checking.
   -a <value>	- set process affinity to <value>. You can either specify it
                   as plain int, or as a bit mask starting with 0, so 2 and
                   010 are equivalent.
+  -deterministic - best effort at reproducible runs: pin the process to a
+                  single CPU (the last one, unless -a is given) and disable
+                  priority boosts. Reduces, but doesn't remove, run-to-run
+                  variance of multithreaded programs.
 
   Some options require job objects to function. When process is created, runexe attempts
   to create a job object. If it can't, it will continue without it unless internal flag
@@ -176,4 +404,10 @@ Process properties:
   -process-limit <intvalue> - set process limit to <intvalue>. Windows will refuse
                   to create processes above the limit. Setting this sets FailOnJobCreationFailure
 				  and is incompatible with -no-job.
+  -total-process-limit <n> - terminate the job once it has created more than <n>
+                  processes over the run, the program itself included, however
+                  few run at once. Like -process-limit, needs a job.
+  -cpu-rate <percent> - cap CPU usage of the job to <percent> of all CPUs
+                  (Windows 8+). The program runs slower, so time passed
+                  grows: don't use it with -h unless you mean it.
 `
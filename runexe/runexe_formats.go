@@ -0,0 +1,98 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// resultFormat prints results for a downstream judge system, picked with -format.
+type resultFormat struct {
+	// Printed before the run, so that a failure after it still gives a whole document.
+	header string
+	print  func(results []*RunResult)
+	fail   func(err error, state string)
+}
+
+var resultFormats = map[string]*resultFormat{
+	// The invoker format of Polygon and testlib.
+	"xml": {header: xmlHeaderText, print: PrintResultsXml, fail: FailXml},
+	// A meta file like isolate writes, which CMS reads.
+	"cms": {print: printResultsMeta, fail: failMeta},
+}
+
+func formatNames() string {
+	var names []string
+	for k := range resultFormats {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+	return strings.Join(names, ", ")
+}
+
+func getResultFormat(name string) (*resultFormat, error) {
+	if name == "" || name == "text" {
+		return nil, nil
+	}
+	if f := resultFormats[name]; f != nil {
+		return f, nil
+	}
+	return nil, fmt.Errorf("unknown result format %q, known are text, %s", name, formatNames())
+}
+
+// metaStatus is the isolate status for a verdict, and whether the sandbox killed the program.
+func metaStatus(v verdict) (string, bool) {
+	switch v {
+	case verdictSuccess:
+		return "", false
+	case verdictTimeLimitExceeded, verdictIdle:
+		return "TO", true
+	case verdictCrash:
+		return "RE", false
+	case verdictFail, verdictCannotStart, verdictEnvironmentError, verdictRemoteDisconnected:
+		return "XX", false
+	}
+	return "SG", true
+}
+
+// printResultsMeta prints the program result as "key:value" lines. Isolate has no place for an interactor, so its
+// result isn't printed. Like with isolate, time is CPU time in seconds and max-rss is in kilobytes.
+func printResultsMeta(results []*RunResult) {
+	r := results[0]
+	if r == nil {
+		return
+	}
+	if r.R == nil {
+		failMeta(r.E, "run")
+		return
+	}
+	status, killed := metaStatus(r.V)
+	fmt.Printf("time:%.3f\n", (r.R.UserTime + r.R.KernelTime).Seconds())
+	fmt.Printf("time-wall:%.3f\n", r.R.WallTime.Seconds())
+	fmt.Printf("max-rss:%d\n", r.R.PeakMemory/1024)
+	if killed {
+		fmt.Println("killed:1")
+		if r.V == verdictMemoryLimitExceeded {
+			fmt.Println("cg-oom-killed:1")
+		}
+		if status == "SG" {
+			fmt.Println("exitsig:9")
+		}
+	} else {
+		fmt.Printf("exitcode:%d\n", r.R.ExitCode)
+	}
+	if status != "" {
+		fmt.Println("status:" + status)
+		fmt.Println("message:" + metaValue(r.V.String()+": "+r.R.Reason))
+	}
+}
+
+func failMeta(err error, state string) {
+	fmt.Println("status:XX")
+	fmt.Println("message:" + metaValue("("+state+") "+err.Error()))
+}
+
+// metaValue keeps a value on its line.
+func metaValue(s string) string {
+	return strings.NewReplacer("\r", " ", "\n", " ").Replace(strings.TrimSuffix(s, ": "))
+}
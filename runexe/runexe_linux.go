@@ -15,7 +15,34 @@ func loadLibraryNeeded(program, interactor *processConfig) bool {
 	return false
 }
 
-func setInject(p *subprocess.PlatformOptions, injectDll string) {
+func setInject(p *subprocess.PlatformOptions, injectDll string, injectEnv []string, reportLastError, reportChildView bool) error {
+	return nil
+}
+
+func setDllSearch(p *subprocess.PlatformOptions, dirs []string, unrestricted bool, manifest string) {
+}
+
+func setPseudoConsole(p *subprocess.PlatformOptions, enable bool) {
+}
+
+func setAppContainer(p *subprocess.PlatformOptions, name string, capabilities []string) {
+}
+
+func setRegistryPolicy(p *subprocess.PlatformOptions, deny bool, allow []string) {
+}
+
+func setNamedObjectAudit(p *subprocess.PlatformOptions, enable bool, limit uint32) {
+}
+
+func setPrivileges(p *subprocess.PlatformOptions, remove []string, keep, largePages bool) {
+}
+
+func setFailPolicies(p *subprocess.PlatformOptions, open, closed []string) error {
+	return nil
+}
+
+func setPriority(p *subprocess.PlatformOptions, class string, thread int, io string) error {
+	return nil
 }
 
 func newPlatformOptions() *subprocess.PlatformOptions {
@@ -14,14 +14,20 @@ import (
 type verdict int
 
 const (
-	verdictSuccess             = verdict(0)
-	verdictFail                = verdict(1)
-	verdictCrash               = verdict(2)
-	verdictTimeLimitExceeded   = verdict(3)
-	verdictMemoryLimitExceeded = verdict(4)
-	verdictIdle                = verdict(5)
-	verdictSecurityViolation   = verdict(6)
-	verdictOutputLimitExceeded = verdict(7)
+	verdictSuccess                    = verdict(0)
+	verdictFail                       = verdict(1)
+	verdictCrash                      = verdict(2)
+	verdictTimeLimitExceeded          = verdict(3)
+	verdictMemoryLimitExceeded        = verdict(4)
+	verdictIdle                       = verdict(5)
+	verdictSecurityViolation          = verdict(6)
+	verdictOutputLimitExceeded        = verdict(7)
+	verdictRemoteDisconnected         = verdict(8)
+	verdictVirtualMemoryLimitExceeded = verdict(9)
+	verdictMultiThreaded              = verdict(10)
+	verdictCannotStart                = verdict(11)
+	verdictEnvironmentError           = verdict(12)
+	verdictInputStalled               = verdict(13)
 )
 
 func (v verdict) String() string {
@@ -42,6 +48,18 @@ func (v verdict) String() string {
 		return "SECURITY_VIOLATION"
 	case verdictOutputLimitExceeded:
 		return "OUTPUT_LIMIT_EXCEEDED"
+	case verdictRemoteDisconnected:
+		return "INTERACTOR_DISCONNECTED"
+	case verdictVirtualMemoryLimitExceeded:
+		return "VIRTUAL_MEMORY_LIMIT_EXCEEDED"
+	case verdictMultiThreaded:
+		return "MULTITHREADED"
+	case verdictCannotStart:
+		return "CANNOT_START"
+	case verdictEnvironmentError:
+		return "ENVIRONMENT_ERROR"
+	case verdictInputStalled:
+		return "INPUT_STALLED"
 	}
 	return "FAILED"
 }
@@ -50,30 +68,48 @@ func getVerdict(r *subprocess.SubprocessResult) verdict {
 	switch {
 	case r.OutputLimitExceeded || r.ErrorLimitExceeded:
 		return verdictOutputLimitExceeded
+	case r.EnvironmentError:
+		return verdictEnvironmentError
 	case r.SuccessCode == 0:
 		return verdictSuccess
-	case r.SuccessCode&(subprocess.EF_PROCESS_LIMIT_HIT|subprocess.EF_PROCESS_LIMIT_HIT_POST) != 0:
+	case r.SuccessCode&subprocess.EF_CANNOT_START != 0:
+		return verdictCannotStart
+	case r.SuccessCode&(subprocess.EF_JUDGE_STALLED|subprocess.EF_INPUT_FAILED) != 0:
+		return verdictFail
+	case r.SuccessCode&subprocess.EF_REMOTE_DISCONNECTED != 0:
+		return verdictRemoteDisconnected
+	case r.SuccessCode&subprocess.EF_MULTITHREADED != 0:
+		return verdictMultiThreaded
+	case r.SuccessCode&subprocess.EF_INPUT_STALLED != 0:
+		return verdictInputStalled
+	case r.SuccessCode&(subprocess.EF_PROCESS_LIMIT_HIT|subprocess.EF_PROCESS_LIMIT_HIT_POST|subprocess.EF_TOTAL_PROCESS_LIMIT_HIT|subprocess.EF_HANDLE_LIMIT_HIT|subprocess.EF_DISTINCT_FILE_LIMIT_HIT|subprocess.EF_REGISTRY_ACCESS_DENIED) != 0:
 		return verdictSecurityViolation
-	case r.SuccessCode&(subprocess.EF_INACTIVE|subprocess.EF_WALL_TIME_LIMIT_HIT) != 0:
+	case r.SuccessCode&(subprocess.EF_INACTIVE|subprocess.EF_WALL_TIME_LIMIT_HIT|subprocess.EF_WALL_TO_CPU_RATIO_POST) != 0:
 		return verdictIdle
 	case r.SuccessCode&(subprocess.EF_TIME_LIMIT_HIT|subprocess.EF_TIME_LIMIT_HIT_POST|subprocess.EF_KERNEL_TIME_LIMIT_HIT|subprocess.EF_KERNEL_TIME_LIMIT_HIT_POST) != 0:
 		return verdictTimeLimitExceeded
 	case r.SuccessCode&(subprocess.EF_MEMORY_LIMIT_HIT|subprocess.EF_MEMORY_LIMIT_HIT_POST) != 0:
 		return verdictMemoryLimitExceeded
+	case r.SuccessCode&(subprocess.EF_VIRTUAL_MEMORY_LIMIT_HIT|subprocess.EF_VIRTUAL_MEMORY_LIMIT_HIT_POST) != 0:
+		return verdictVirtualMemoryLimitExceeded
 	default:
 		return verdictCrash
 	}
 }
 
 type invocationSuccess struct {
-	XMLName    xml.Name `xml:"invocationResult"`
-	ID         string   `xml:"id,attr"`
-	Verdict    string   `xml:"invocationVerdict"`
-	ExitCode   int      `xml:"exitCode"`
-	UserTime   int      `xml:"processorUserModeTime"`
-	KernelTime int      `xml:"processorKernelModeTime"`
-	WallTime   int      `xml:"passedTime"`
-	Memory     int      `xml:"consumedMemory"`
+	XMLName       xml.Name `xml:"invocationResult"`
+	ID            string   `xml:"id,attr"`
+	Verdict       string   `xml:"invocationVerdict"`
+	ExitCode      int      `xml:"exitCode"`
+	UserTime      int      `xml:"processorUserModeTime"`
+	KernelTime    int      `xml:"processorKernelModeTime"`
+	WallTime      int      `xml:"passedTime"`
+	Memory        int      `xml:"consumedMemory"`
+	VirtualMemory int      `xml:"consumedVirtualMemory,omitempty"`
+	CpuCycles     uint64   `xml:"consumedCpuCycles,omitempty"`
+	Energy        uint64   `xml:"consumedEnergy,omitempty"`
+	Reason        string   `xml:"comment,omitempty"`
 }
 
 type invocationError struct {
@@ -107,13 +143,17 @@ func PrintResultsXml(results []*RunResult) {
 func convertXml(result *RunResult) interface{} {
 	if result.R != nil {
 		return invocationSuccess{
-			ID:         strings.ToLower(result.T.String()),
-			Verdict:    result.V.String(),
-			ExitCode:   int(result.R.ExitCode),
-			UserTime:   int(result.R.UserTime.Nanoseconds() / 1000000),
-			KernelTime: int(result.R.KernelTime.Nanoseconds() / 1000000),
-			WallTime:   int(result.R.WallTime.Nanoseconds() / 1000000),
-			Memory:     int(result.R.PeakMemory),
+			ID:            strings.ToLower(result.T.String()),
+			Verdict:       result.V.String(),
+			ExitCode:      int(result.R.ExitCode),
+			UserTime:      int(result.R.UserTime.Nanoseconds() / 1000000),
+			KernelTime:    int(result.R.KernelTime.Nanoseconds() / 1000000),
+			WallTime:      int(result.R.WallTime.Nanoseconds() / 1000000),
+			Memory:        int(result.R.PeakMemory),
+			VirtualMemory: int(result.R.PeakVirtualMemory),
+			CpuCycles:     result.R.CpuCycles,
+			Energy:        result.R.EnergyMicrojoules,
+			Reason:        result.R.Reason,
 		}
 	}
 
@@ -135,12 +175,18 @@ func strMemory(t uint64) string {
 	return strconv.FormatUint(t, 10)
 }
 
+func printLaunchFailed(err error) {
+	if subprocess.IsLaunchError(err) {
+		fmt.Println("Launch failed, no process ran")
+	}
+}
+
 func PrintResultText(kernelTime bool, result *RunResult, pipeRecords []subprocess.PipeRecordEntry) {
 	usuffix := "sec"
 	switch result.V {
 	case verdictSuccess:
 		fmt.Println(result.T.String(), "successfully terminated")
-		fmt.Println("  exit code:    " + strconv.Itoa(int(result.R.ExitCode)))
+		fmt.Println("  exit code:    " + exitCodeText(result.R))
 	case verdictOutputLimitExceeded:
 		fmt.Println(result.T.String(), "output limit exceeded")
 	case verdictTimeLimitExceeded:
@@ -150,24 +196,56 @@ func PrintResultText(kernelTime bool, result *RunResult, pipeRecords []subproces
 	case verdictMemoryLimitExceeded:
 		fmt.Println("Memory limit exceeded")
 		fmt.Println(result.T.String(), "tried to allocate more than", strMemory(result.S.MemoryLimit), "bytes")
+	case verdictVirtualMemoryLimitExceeded:
+		fmt.Println("Virtual memory limit exceeded")
+		fmt.Println(result.T.String(), "tried to reserve more than", strMemory(result.S.VirtualMemoryLimit), "bytes of address space")
 	case verdictIdle:
 		fmt.Println("Idleness limit exceeded")
 		fmt.Println("Detected", result.T.String(), "idle")
+	case verdictEnvironmentError:
+		fmt.Println("Environment error")
+		fmt.Println(result.T.String(), "exited with", fmt.Sprintf("%#x,", result.R.ExitCode), "a problem of the host, not the program:", result.R.Reason)
+	case verdictCannotStart:
+		fmt.Println("Cannot start")
+		fmt.Println(result.T.String(), "could not be loaded, it wasn't run:", result.R.Reason)
+	case verdictInputStalled:
+		fmt.Println("Input stalled")
+		fmt.Println(result.T.String(), "stopped reading its input with", result.R.UnreadInput, "bytes unread, and was terminated")
+	case verdictMultiThreaded:
+		fmt.Println("Multithreaded")
+		fmt.Println(result.T.String(), "ran", result.R.PeakThreadCount, "threads, only", result.S.MaxThreads, "allowed")
 	case verdictSecurityViolation:
 		fmt.Println("Security violation")
 		fmt.Println(result.T.String(), " tried to do some forbidden action")
+	case verdictRemoteDisconnected:
+		fmt.Println("Remote interactor disconnected")
+		fmt.Println(result.T.String(), "was terminated after losing connection to the interactor")
 	case verdictCrash:
 		fmt.Println("Invocation crashed:", result.T.String())
 		fmt.Println("Comment:", result.E)
+		printLaunchFailed(result.E)
 		fmt.Println()
 		return
 	case verdictFail:
 		fmt.Println("Invocation failed:", result.T.String())
 		fmt.Println("Comment:", result.E)
+		printLaunchFailed(result.E)
 		fmt.Println()
 		return
 	}
 
+	if result.R.Reason != "" {
+		fmt.Println("  reason:       " + result.R.Reason)
+	}
+	if result.R.StdOutClosedEarly {
+		fmt.Println("  stdout:       closed while running, stopped as done communicating")
+	}
+	if result.R.ExitedInGrace {
+		fmt.Println("  grace:        exited by itself after it was asked to stop")
+	}
+	if result.R.TightestLimit != "" {
+		fmt.Printf("  tightest:     %s limit, %.0f%% used\n", result.R.TightestLimit, result.R.TightestLimitUsage*100)
+	}
 	utime := strTime(result.R.UserTime) + " " + usuffix
 	if kernelTime {
 		fmt.Println("  time consumed:")
@@ -177,7 +255,157 @@ func PrintResultText(kernelTime bool, result *RunResult, pipeRecords []subproces
 		fmt.Println("  time consumed: " + utime)
 	}
 	fmt.Println("  time passed:  " + strTime(result.R.WallTime) + " sec")
+	if result.R.EnvironmentRetries > 0 {
+		fmt.Println("  retried:      " + strconv.FormatUint(uint64(result.R.EnvironmentRetries), 10) + " times, first attempt: " + result.R.FirstEnvironmentError)
+	}
+	if result.S.ExcludeInputWait {
+		fmt.Println("  input wait:   " + strTime(result.R.InputWaitTime) + " sec (not counted against the limit)")
+	}
 	fmt.Println("  peak memory:  " + strMemory(result.R.PeakMemory) + " bytes")
+	if result.R.PeakJobMemory > 0 && result.R.PeakJobMemory != result.R.PeakMemory {
+		fmt.Println("  all processes: " + strMemory(result.R.PeakJobMemory) + " bytes")
+	}
+	if result.R.PeakVirtualMemory > 0 {
+		fmt.Println("  peak virtual: " + strMemory(result.R.PeakVirtualMemory) + " bytes")
+	}
+	if result.R.OutputSize > 0 {
+		fmt.Println("  output:       " + strconv.FormatInt(result.R.OutputSize, 10) + " bytes")
+	}
+	if result.R.ErrorSize > 0 {
+		fmt.Println("  error output: " + strconv.FormatInt(result.R.ErrorSize, 10) + " bytes")
+	}
+	if result.R.CpuCycles > 0 {
+		fmt.Println("  cpu cycles:   " + strconv.FormatUint(result.R.CpuCycles, 10))
+	}
+	if result.R.EnergyMicrojoules > 0 {
+		fmt.Println("  energy:       " + strconv.FormatUint(result.R.EnergyMicrojoules, 10) + " uJ (host-wide estimate)")
+	}
+	if result.R.HardFaults > 0 {
+		fmt.Println("  hard faults:  " + strconv.FormatUint(result.R.HardFaults, 10) + " (about " + strTime(result.R.PagingTime) + " sec)")
+	}
+	if result.R.WorkingSetTrims > 0 {
+		fmt.Println("  working set trimmed by OS " + strconv.FormatUint(uint64(result.R.WorkingSetTrims), 10) + " times")
+	}
+	if result.S.MeasureIoWait {
+		fmt.Println("  i/o wait:     " + strTime(result.R.IoWaitEstimate) + " sec (estimate)")
+	}
+	if result.S.MeasureSchedulerDelay {
+		fmt.Println("  sched delay:  " + strTime(result.R.SchedulerDelay) + " sec")
+	}
+	if result.R.ModulesLoadedCount > 0 {
+		fmt.Println("  modules:      " + strconv.FormatUint(uint64(result.R.ModulesLoadedCount), 10))
+	}
+	if result.S.TotalProcessLimit > 0 {
+		fmt.Println("  processes:    " + strconv.FormatUint(result.R.TotalProcesses, 10) + " created, at most " +
+			strconv.FormatUint(uint64(result.R.PeakActiveProcesses), 10) + " at once")
+	}
+	if result.R.PeakThreadCount > 0 {
+		fmt.Println("  threads:      " + strconv.FormatUint(uint64(result.R.PeakThreadCount), 10))
+	}
+	if result.R.PeakHandleCount > 0 {
+		fmt.Println("  handles:      " + strconv.FormatUint(uint64(result.R.PeakHandleCount), 10))
+	}
+	if result.R.DistinctFileCount > 0 {
+		fmt.Println("  files:        " + strconv.FormatUint(uint64(result.R.DistinctFileCount), 10) + " distinct")
+	}
+	if result.R.PeakSocketCount > 0 {
+		fmt.Println("  sockets:      " + strconv.FormatUint(uint64(result.R.PeakSocketCount), 10))
+	}
+	if result.R.SocketLimitExceeded {
+		fmt.Println("  warning:      had more than " + strconv.FormatUint(uint64(result.S.SocketLimit), 10) + " sockets open")
+	}
+	if result.R.NamedObjectCount > 0 {
+		fmt.Println("  named objs:   " + strconv.FormatUint(uint64(result.R.NamedObjectCount), 10) + " (from audit log)")
+	}
+	if result.R.NamedObjectLimitExceeded {
+		fmt.Println("  warning:      used more named kernel objects than -named-object-limit")
+	}
+	if result.R.ModuleLimitExceeded {
+		fmt.Println("  warning:      loaded more than " + strconv.FormatUint(uint64(result.S.ModuleLimit), 10) + " modules")
+	}
+	if result.R.CpuRatePercent > 0 {
+		fmt.Println("  cpu rate cap: " + strconv.FormatUint(uint64(result.R.CpuRatePercent), 10) + "%")
+	}
+	if result.R.IoPriority != subprocess.IO_PRIORITY_DEFAULT {
+		fmt.Println("  i/o priority: " + result.R.IoPriority.String())
+	}
+	if result.R.StdinLimitHit {
+		fmt.Println("  warning:      standard input cut at " + strconv.FormatInt(result.S.StdIn.MaxInputSize, 10) + " bytes")
+	}
+	if result.R.InputError != "" {
+		fmt.Println("  input error:  " + result.R.InputError)
+	}
+	if result.R.WallToCpuRatioExceeded && result.R.SuccessCode&subprocess.EF_WALL_TO_CPU_RATIO_POST == 0 {
+		fmt.Println("  warning:      wall time over " + strconv.FormatFloat(result.S.MaxWallToCpuRatio, 'g', -1, 64) + " times CPU time")
+	}
+	if result.R.FasterThanExpected {
+		fmt.Println("  warning:      finished faster than " + strTime(result.S.MinExpectedTime) + " sec")
+	}
+	if result.R.IsolationDowngraded {
+		fmt.Println("  warning:      ran without " + fmt.Sprint(result.R.DowngradedFeatures) + ", unavailable on this host")
+	}
+	if result.R.ChildLastErrorReported {
+		fmt.Println("  last error:   " + strconv.FormatUint(uint64(result.R.ChildLastError), 10) + " (reported by injected DLL)")
+	}
+	if v := result.R.ChildView; v != nil {
+		for _, arg := range v.Args {
+			fmt.Printf("  child arg:    %q\n", arg)
+		}
+		for _, env := range v.Environment {
+			fmt.Printf("  child env:    %q\n", env)
+		}
+	}
+	if result.R.Privileges != nil {
+		fmt.Println("  privileges:   " + strings.Join(result.R.Privileges, ", "))
+	}
+	if result.R.Nonce != "" {
+		fmt.Println("  nonce:        " + result.R.Nonce)
+		if result.S.Nonce.Verify && !result.R.NonceFound {
+			fmt.Println("  warning:      nonce not found in output")
+		}
+	}
+	if len(result.R.MemorySamples) > 0 {
+		fmt.Println("  memory samples (time, commit, working set):")
+		for _, v := range result.R.MemorySamples {
+			fmt.Println("    " + strTime(v.Offset) + " sec: " + strMemory(v.Commit) + " " + strMemory(v.WorkingSet))
+		}
+	}
+	if s := result.R.KillSnapshot; s != nil {
+		fmt.Println("  threads at kill (id, user time, kernel time):")
+		for _, v := range s.Threads {
+			fmt.Println("    " + strconv.FormatUint(uint64(v.ID), 10) + ": " + strTime(v.UserTime) + " sec, " + strTime(v.KernelTime) + " sec")
+		}
+		if s.DumpFile != "" {
+			fmt.Println("  minidump:     " + s.DumpFile)
+		}
+	}
+	if e := result.Extended; e != nil {
+		if e.R != nil {
+			fmt.Println("  with time limit extended to " + strTime(e.S.TimeLimit) + " sec: " + e.V.String())
+			fmt.Println("    time consumed: " + strTime(e.R.UserTime) + " sec")
+			fmt.Println("    time passed:   " + strTime(e.R.WallTime) + " sec")
+		} else {
+			fmt.Println("  re-run with extended time limit failed:", e.E)
+		}
+	}
+	if d := result.Determinism; d != nil {
+		switch {
+		case d.Err != nil:
+			fmt.Println("  determinism check failed:", d.Err)
+		case d.Second.R == nil:
+			fmt.Println("  determinism check failed:", d.Second.E)
+		default:
+			if d.Identical {
+				fmt.Println("  second run: output identical")
+			} else {
+				fmt.Println("  second run: OUTPUT DIFFERS")
+			}
+			fmt.Println("    verdict:       " + d.Second.V.String())
+			fmt.Println("    time consumed: " + strTime(d.Second.R.UserTime) + " sec")
+			fmt.Println("    time passed:   " + strTime(d.Second.R.WallTime) + " sec")
+			fmt.Println("    peak memory:   " + strMemory(d.Second.R.PeakMemory) + " bytes")
+		}
+	}
 	fmt.Println()
 
 	for _, v := range pipeRecords {
@@ -191,6 +419,17 @@ type RunResult struct {
 	S *subprocess.Subprocess
 	R *subprocess.SubprocessResult
 	T processType
+
+	// Re-run with extended time limit, see -tl-margin.
+	Extended *RunResult
+	// See -check-determinism.
+	Determinism *determinismResult
+}
+
+type determinismResult struct {
+	Second    *RunResult
+	Identical bool
+	Err       error
 }
 
 var failLog = FailText
@@ -223,3 +462,12 @@ func FailXml(err error, state string) {
 	}
 	fmt.Println(string(d))
 }
+
+// exitCodeText shows the exit code both ways, if it's negative as a signed value.
+func exitCodeText(r *subprocess.SubprocessResult) string {
+	unsigned := strconv.FormatUint(uint64(r.ExitCode), 10)
+	if signed := r.SignedExitCode(); signed < 0 {
+		return strconv.Itoa(int(signed)) + " (" + unsigned + " unsigned)"
+	}
+	return unsigned
+}
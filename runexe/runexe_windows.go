@@ -1,10 +1,12 @@
 package main
 
 import (
+	"fmt"
 	"strings"
 	"syscall"
 
 	"github.com/contester/runlib/subprocess"
+	"golang.org/x/sys/windows"
 )
 
 func desktopNeeded(program, interactor *processConfig) bool {
@@ -24,10 +26,82 @@ func loadLibraryNeeded(program, interactor *processConfig) bool {
 	return true
 }
 
-func setInject(p *subprocess.PlatformOptions, injectDll string) {
+func setInject(p *subprocess.PlatformOptions, injectDll string, injectEnv []string, reportLastError, reportChildView bool) error {
 	if injectDll != "" {
 		p.InjectDLL = []string{injectDll}
 	}
+	p.ReportLastError = reportLastError
+	p.ReportChildView = reportChildView
+	for _, v := range injectEnv {
+		k, val, ok := strings.Cut(v, "=")
+		if !ok {
+			return fmt.Errorf("invalid -inject-env %q, must be key=value", v)
+		}
+		if p.InjectEnv == nil {
+			p.InjectEnv = make(map[string]string)
+		}
+		p.InjectEnv[k] = val
+	}
+	return nil
+}
+
+func setDllSearch(p *subprocess.PlatformOptions, dirs []string, unrestricted bool, manifest string) {
+	p.DllDirectories = dirs
+	p.UnrestrictedDllSearch = unrestricted
+	p.Manifest = manifest
+}
+
+var priorityClasses = map[string]uint32{
+	"idle":     windows.IDLE_PRIORITY_CLASS,
+	"below":    windows.BELOW_NORMAL_PRIORITY_CLASS,
+	"normal":   windows.NORMAL_PRIORITY_CLASS,
+	"above":    windows.ABOVE_NORMAL_PRIORITY_CLASS,
+	"high":     windows.HIGH_PRIORITY_CLASS,
+	"realtime": windows.REALTIME_PRIORITY_CLASS,
+}
+
+func setPseudoConsole(p *subprocess.PlatformOptions, enable bool) {
+	p.PseudoConsole = enable
+}
+
+func setAppContainer(p *subprocess.PlatformOptions, name string, capabilities []string) {
+	p.AppContainer = name
+	p.AppContainerCapabilities = capabilities
+}
+
+func setRegistryPolicy(p *subprocess.PlatformOptions, deny bool, allow []string) {
+	p.DenyRegistry = deny
+	p.RegistryAllowlist = allow
+}
+
+func setNamedObjectAudit(p *subprocess.PlatformOptions, enable bool, limit uint32) {
+	p.AuditNamedObjects = enable
+	p.NamedObjectLimit = limit
+}
+
+func setPrivileges(p *subprocess.PlatformOptions, remove []string, keep, largePages bool) {
+	p.RemovePrivileges = remove
+	p.KeepPrivileges = keep
+	p.AllowLargePages = largePages
+}
+
+func setFailPolicies(p *subprocess.PlatformOptions, open, closed []string) error {
+	var err error
+	p.FailPolicies, err = subprocess.ParseFailPolicies(open, closed)
+	return err
+}
+
+func setPriority(p *subprocess.PlatformOptions, class string, thread int, io string) error {
+	if class != "" {
+		var ok bool
+		if p.PriorityClass, ok = priorityClasses[class]; !ok {
+			return fmt.Errorf("unknown priority class %q", class)
+		}
+	}
+	p.MainThreadPriority = int32(thread)
+	var err error
+	p.IoPriority, err = subprocess.ParseIoPriority(io)
+	return err
 }
 
 func newPlatformOptions() *subprocess.PlatformOptions {
@@ -1,10 +1,15 @@
 package main
 
 import (
+	"flag"
 	"fmt"
 	"net"
 	"net/rpc"
 	"os"
+	"os/signal"
+	"strings"
+	"sync"
+	"syscall"
 	"time"
 
 	"github.com/contester/rpc4/rpc4go"
@@ -14,7 +19,17 @@ import (
 	log "github.com/sirupsen/logrus"
 )
 
+var allowNoDesktop = flag.Bool("allow-no-desktop", false, "run without desktop isolation if it can't be created")
+var sharedWinsta = flag.Bool("shared-winsta", false, "create isolation desktop in the current window station")
+var desktopAccess = flag.String("desktop-access", "", "comma-separated accounts or SIDs granted desktop access, sandbox accounts by default")
+var detect32BitTimeout = flag.Duration("detect32-timeout", platform.DEFAULT_DETECT_32BIT_TIMEOUT, "kill the 32-bit detector helper after this time")
+var no32BitInjection = flag.Bool("no-32bit-injection", false, "never extract and run the 32-bit detector helper, fail DLL injection into 32-bit processes")
+var warmupInject = flag.String("warmup-inject", "", "comma-separated DLLs to check for injection at startup, before the first run")
+var drainTimeout = flag.Duration("drain-timeout", time.Minute, "on shutdown, wait this long for running tests before aborting them")
+
 func main() {
+	flag.Parse()
+
 	f, err := os.OpenFile("server0.log", os.O_APPEND|os.O_CREATE|os.O_RDWR, 0666)
 	if err != nil {
 		fmt.Printf("error opening file: %v", err)
@@ -26,12 +41,34 @@ func main() {
 	log.SetOutput(f)
 	log.SetLevel(log.DebugLevel)
 
-	globalData, err := platform.CreateGlobalData(true)
+	var accounts []string
+	if *desktopAccess != "" {
+		accounts = strings.Split(*desktopAccess, ",")
+	} else if accounts, err = service.SandboxAccounts("server.ini"); err != nil {
+		log.Fatal(err)
+		return
+	}
+
+	globalData, err := platform.CreateGlobalData(platform.GlobalDataOptions{
+		NeedDesktop:         true,
+		AllowNoDesktop:      *allowNoDesktop,
+		SharedWindowStation: *sharedWinsta,
+		DesktopAccounts:     accounts,
+		Detect32BitTimeout:  *detect32BitTimeout,
+		No32BitInjection:    *no32BitInjection,
+	})
 	if err != nil {
 		log.Fatal(err)
 		return
 	}
 
+	if *warmupInject != "" {
+		if err = globalData.WarmupInjection(strings.Split(*warmupInject, ",")); err != nil {
+			log.Fatal(err)
+			return
+		}
+	}
+
 	c, err := service.NewContester("server.ini", globalData)
 	if err != nil {
 		log.Fatal(err)
@@ -40,6 +77,19 @@ func main() {
 
 	rpc.Register(c)
 
+	var srv server
+
+	stop := make(chan os.Signal, 1)
+	signal.Notify(stop, os.Interrupt, syscall.SIGTERM)
+	go func() {
+		sig := <-stop
+		log.Infof("Got %s, draining runs for up to %s", sig, *drainTimeout)
+		c.Shutdown(*drainTimeout)
+		if !srv.stop() {
+			os.Exit(0)
+		}
+	}()
+
 	d := net.Dialer{
 		Timeout:   time.Minute,
 		DualStack: true,
@@ -53,7 +103,53 @@ func main() {
 			time.Sleep(time.Second * 5)
 			continue
 		}
-		rpc.DefaultServer.ServeCodec(rpc4go.NewServerCodec(conn))
-		conn.Close()
+		if !srv.serve(conn) {
+			log.Info("Replies sent, exiting")
+			return
+		}
+	}
+}
+
+// server tracks the connection being served, so shutdown can stop reading requests from it and still send replies
+// to the ones already read.
+type server struct {
+	mu       sync.Mutex
+	conn     net.Conn
+	stopping bool
+}
+
+// serve handles requests from conn until it breaks, and returns false if that's because of stop.
+func (s *server) serve(conn net.Conn) bool {
+	defer conn.Close()
+	s.mu.Lock()
+	if s.stopping {
+		s.mu.Unlock()
+		return false
+	}
+	s.conn = conn
+	s.mu.Unlock()
+
+	// ServeCodec waits for replies to requests it has read before it returns.
+	rpc.DefaultServer.ServeCodec(rpc4go.NewServerCodec(conn))
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.conn = nil
+	return !s.stopping
+}
+
+// stop makes serve return once replies are sent. It returns false if there is no connection to wait for.
+func (s *server) stop() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.stopping = true
+	if s.conn == nil {
+		return false
+	}
+	if tc, ok := s.conn.(*net.TCPConn); ok {
+		tc.CloseRead()
+	} else {
+		s.conn.Close()
 	}
+	return true
 }
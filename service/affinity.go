@@ -0,0 +1,114 @@
+package service
+
+import (
+	"sync"
+
+	"github.com/contester/runlib/subprocess"
+)
+
+// coreAllocator shares host CPUs between concurrent runs. Cores are split anew whenever a run starts or finishes,
+// so a lone run gets all of them, and concurrent runs get equal contiguous shares (or share single cores, if there
+// are more runs than cores). Runs learn about new masks through Subprocess.AffinityUpdates.
+type coreAllocator struct {
+	mu    sync.Mutex
+	cores []int
+	runs  []*coreLease
+}
+
+type coreLease struct {
+	mask    uint64
+	updates chan uint64
+}
+
+func newCoreAllocator(hostMask uint64) *coreAllocator {
+	a := &coreAllocator{}
+	for cpu := 0; cpu < 64; cpu++ {
+		if hostMask&(1<<cpu) != 0 {
+			a.cores = append(a.cores, cpu)
+		}
+	}
+	return a
+}
+
+// acquire returns a lease for a new run, and its initial mask.
+func (a *coreAllocator) acquire() (*coreLease, uint64) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	l := &coreLease{updates: make(chan uint64, 1)}
+	a.runs = append(a.runs, l)
+	a.rebalance(l)
+	return l, l.mask
+}
+
+func (a *coreAllocator) release(l *coreLease) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	for i, v := range a.runs {
+		if v == l {
+			a.runs = append(a.runs[:i], a.runs[i+1:]...)
+			break
+		}
+	}
+	a.rebalance(nil)
+}
+
+// rebalance assigns masks to all runs. Runs other than fresh are sent their new mask, replacing one not applied yet.
+func (a *coreAllocator) rebalance(fresh *coreLease) {
+	n, c := len(a.runs), len(a.cores)
+	if n == 0 || c == 0 {
+		return
+	}
+	start := 0
+	for i, l := range a.runs {
+		var mask uint64
+		if n >= c {
+			mask = 1 << a.cores[i%c]
+		} else {
+			size := c / n
+			if i < c%n {
+				size++
+			}
+			for _, cpu := range a.cores[start : start+size] {
+				mask |= 1 << cpu
+			}
+			start += size
+		}
+		if mask == l.mask {
+			continue
+		}
+		l.mask = mask
+		if l == fresh {
+			continue
+		}
+		select {
+		case <-l.updates:
+		default:
+		}
+		l.updates <- mask
+	}
+}
+
+// lease gives sub its share of host cores, if dynamic affinity is on and sub isn't pinned already. A Deterministic
+// sub is pinned to the last core of its share. Call the returned func once sub has finished.
+func (s *Contester) lease(sub *subprocess.Subprocess) func() {
+	if s.Cores == nil || sub.ProcessAffinityMask != 0 {
+		return func() {}
+	}
+	l, mask := s.Cores.acquire()
+	sub.ProcessAffinityMask = mask
+	sub.AffinityUpdates = l.updates
+	return func() { s.Cores.release(l) }
+}
+
+// execute runs sub on its share of host cores. It's tracked for Shutdown.
+func (s *Contester) execute(sub *subprocess.Subprocess) (*subprocess.SubprocessResult, error) {
+	if err := s.drain.begin(); err != nil {
+		return nil, err
+	}
+	defer s.drain.runs.Done()
+	if sub.Abort == nil {
+		sub.Abort = s.drain.abort
+	}
+	defer s.lease(sub)()
+	return sub.Execute()
+}
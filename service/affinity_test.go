@@ -0,0 +1,97 @@
+package service
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/contester/runlib/subprocess"
+)
+
+func TestCoreAllocatorRebalance(t *testing.T) {
+	cases := []struct {
+		hostMask uint64
+		runs     int
+		masks    []uint64
+	}{
+		{0xF, 1, []uint64{0xF}},
+		{0xF, 2, []uint64{0x3, 0xC}},
+		{0xF, 3, []uint64{0x3, 0x4, 0x8}},
+		{0xF, 4, []uint64{0x1, 0x2, 0x4, 0x8}},
+		{0xF, 5, []uint64{0x1, 0x2, 0x4, 0x8, 0x1}},
+		{0xAA, 2, []uint64{0x0A, 0xA0}},
+		{0x7, 2, []uint64{0x3, 0x4}},
+		{0, 2, []uint64{0, 0}},
+	}
+	for _, c := range cases {
+		a := newCoreAllocator(c.hostMask)
+		var leases []*coreLease
+		for i := 0; i < c.runs; i++ {
+			l, mask := a.acquire()
+			if mask != l.mask {
+				t.Errorf("%#x, run %d: acquire returned %#x, lease has %#x", c.hostMask, i, mask, l.mask)
+			}
+			leases = append(leases, l)
+		}
+		var masks []uint64
+		for i, l := range leases {
+			masks = append(masks, l.mask)
+			// Runs are only told about changes, the latest one replacing those not applied yet.
+			select {
+			case m := <-l.updates:
+				if m != l.mask {
+					t.Errorf("%#x, run %d: pending update %#x, lease has %#x", c.hostMask, i, m, l.mask)
+				}
+			default:
+			}
+		}
+		if !reflect.DeepEqual(masks, c.masks) {
+			t.Errorf("%#x, %d runs: got masks %#x, want %#x", c.hostMask, c.runs, masks, c.masks)
+		}
+	}
+}
+
+func TestCoreAllocatorRelease(t *testing.T) {
+	a := newCoreAllocator(0xF)
+	first, _ := a.acquire()
+	second, _ := a.acquire()
+	<-first.updates
+	a.release(first)
+	select {
+	case m := <-second.updates:
+		if m != 0xF {
+			t.Errorf("after release: got %#x, want 0xf", m)
+		}
+	default:
+		t.Error("after release: no update for the remaining run")
+	}
+	if len(first.updates) != 0 {
+		t.Error("after release: update sent to the released run")
+	}
+}
+
+func TestLease(t *testing.T) {
+	s := &Contester{Cores: newCoreAllocator(0xF)}
+	pinned := &subprocess.Subprocess{ProcessAffinityMask: 0x1}
+	s.lease(pinned)()
+	if pinned.ProcessAffinityMask != 0x1 || pinned.AffinityUpdates != nil {
+		t.Errorf("pinned: got mask %#x, updates %v", pinned.ProcessAffinityMask, pinned.AffinityUpdates)
+	}
+	// A communication group takes a lease per process.
+	group := []*subprocess.Subprocess{{}, {}}
+	var done []func()
+	for _, sub := range group {
+		done = append(done, s.lease(sub))
+	}
+	if len(s.Cores.runs) != 2 {
+		t.Errorf("group: got %d leases, want 2", len(s.Cores.runs))
+	}
+	if group[1].ProcessAffinityMask != 0xC || group[0].AffinityUpdates == nil {
+		t.Errorf("group: got masks %#x, %#x", group[0].ProcessAffinityMask, group[1].ProcessAffinityMask)
+	}
+	for _, d := range done {
+		d()
+	}
+	if len(s.Cores.runs) != 0 {
+		t.Errorf("after release: %d leases left", len(s.Cores.runs))
+	}
+}
@@ -0,0 +1,107 @@
+package service
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/contester/runlib/contester_proto"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// auditLog appends a JSON line for every finished run, for audit and offline rejudging analysis. Unlike the
+// application log, it's meant to be parsed, and only grows.
+type auditLog struct {
+	mu sync.Mutex
+	f  *os.File
+}
+
+type auditRecord struct {
+	RunID string `json:"run_id,omitempty"`
+	// SHA-256 of the request, so runs of the same spec can be matched.
+	SpecHash string    `json:"spec_hash"`
+	Host     string    `json:"host"`
+	Start    time.Time `json:"start"`
+	Finish   time.Time `json:"finish"`
+	// Request and result, without data blobs and the remote authorization tokens.
+	Request json.RawMessage `json:"request"`
+	Result  json.RawMessage `json:"result,omitempty"`
+	Error   string          `json:"error,omitempty"`
+}
+
+func openAuditLog(name string) (*auditLog, error) {
+	f, err := os.OpenFile(name, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0644)
+	if err != nil {
+		return nil, fmt.Errorf("openAuditLog: %w", err)
+	}
+	return &auditLog{f: f}, nil
+}
+
+func stripRedirect(r *contester_proto.RedirectParameters) {
+	if r != nil {
+		r.Buffer = nil
+		r.RemoteAuthorizationToken = ""
+	}
+}
+
+// record writes the run down. Failing to, it only logs: the run itself is fine.
+func (a *auditLog) record(host string, start time.Time, request *contester_proto.LocalExecutionParameters,
+	result *contester_proto.LocalExecutionResult, runErr error) {
+	if a == nil {
+		return
+	}
+	r := auditRecord{
+		RunID:  request.GetRunId(),
+		Host:   host,
+		Start:  start,
+		Finish: time.Now(),
+	}
+	spec, err := proto.MarshalOptions{Deterministic: true}.Marshal(request)
+	if err != nil {
+		log.Errorf("audit log: %s", err)
+		return
+	}
+	sum := sha256.Sum256(spec)
+	r.SpecHash = hex.EncodeToString(sum[:])
+
+	req := proto.Clone(request).(*contester_proto.LocalExecutionParameters)
+	stripRedirect(req.StdIn)
+	stripRedirect(req.StdOut)
+	stripRedirect(req.StdErr)
+	for _, f := range req.StageFiles {
+		f.Data = nil
+	}
+	if r.Request, err = protojson.Marshal(req); err != nil {
+		log.Errorf("audit log: %s", err)
+		return
+	}
+	if result != nil {
+		res := proto.Clone(result).(*contester_proto.LocalExecutionResult)
+		res.StdOut, res.StdErr, res.StdIn, res.FileOutput = nil, nil, nil, nil
+		if r.Result, err = protojson.Marshal(res); err != nil {
+			log.Errorf("audit log: %s", err)
+			return
+		}
+	}
+	if runErr != nil {
+		r.Error = runErr.Error()
+	}
+	line, err := json.Marshal(&r)
+	if err != nil {
+		log.Errorf("audit log: %s", err)
+		return
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if _, err = a.f.Write(append(line, '\n')); err != nil {
+		log.Errorf("audit log: %s", err)
+	}
+}
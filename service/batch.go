@@ -0,0 +1,154 @@
+package service
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/contester/runlib/contester_proto"
+	"github.com/contester/runlib/subprocess"
+)
+
+const (
+	batchDeadlineNote = "batch wall time limit exceeded"
+	batchStoppedNote  = "batch stopped"
+)
+
+func (s *Contester) LocalBatchRun(request *contester_proto.LocalBatchRun, response *contester_proto.LocalBatchRunResult) error {
+	return s.runBatch(request, nil, func(r *contester_proto.LocalBatchRunResult_Test) {
+		response.Tests = append(response.Tests, r)
+	})
+}
+
+// runBatch runs the tests in order, passing each one to emit once it's done or skipped. Closing stop skips the
+// rest, and stops the test running.
+func (s *Contester) runBatch(request *contester_proto.LocalBatchRun, stop <-chan struct{}, emit func(*contester_proto.LocalBatchRunResult_Test)) error {
+	start := time.Now()
+	limit := subprocess.DuFromMicros(request.GetBatchWallTimeLimitMicros())
+	skip := func(r *contester_proto.LocalBatchRunResult_Test, note string) {
+		r.Status = contester_proto.LocalBatchRunResult_SKIPPED
+		r.Note = note
+		emit(r)
+	}
+	for i, test := range request.Tests {
+		r := &contester_proto.LocalBatchRunResult_Test{}
+		if isClosed(stop) {
+			skip(r, batchStoppedNote)
+			continue
+		}
+
+		var remaining time.Duration
+		if limit > 0 {
+			if remaining = limit - time.Since(start); remaining <= 0 {
+				skip(r, batchDeadlineNote)
+				continue
+			}
+		}
+
+		testStart := time.Now()
+		result, applied, cut, err := s.runBatchTest(test, remaining, stop)
+		if err != nil {
+			s.audit.record(s.InvokerId, testStart, test, nil, err)
+			return fmt.Errorf("test %d: %w", i+1, err)
+		}
+		r.Result = &contester_proto.LocalExecutionResult{}
+		fillResult(result, r.Result)
+		result.Release()
+		applied.mark(r.Result)
+		r.Result.Metadata = test.GetMetadata()
+		s.audit.record(s.InvokerId, testStart, test, r.Result, nil)
+		leanResult(test, r.Result)
+		if cut && result.SuccessCode&subprocess.EF_WALL_TIME_LIMIT_HIT != 0 {
+			// Stopped by the batch deadline, not by its own limit.
+			skip(r, batchDeadlineNote)
+			continue
+		}
+		if result.SuccessCode&subprocess.EF_ABORTED != 0 && isClosed(stop) {
+			skip(r, batchStoppedNote)
+			continue
+		}
+		r.Verdict = batchVerdict(test, result)
+		emit(r)
+		if request.GetStopOnFailure() && r.Verdict != contester_proto.LocalBatchRunResult_ACCEPTED {
+			break
+		}
+	}
+	return nil
+}
+
+// batchVerdict judges a test by how the run ended, then by the output comparison, if the test asked for one.
+func batchVerdict(test *contester_proto.LocalExecutionParameters, r *subprocess.SubprocessResult) contester_proto.LocalBatchRunResult_Verdict {
+	c := r.SuccessCode
+	switch {
+	case r.OutputLimitExceeded || r.ErrorLimitExceeded:
+		return contester_proto.LocalBatchRunResult_OUTPUT_LIMIT_EXCEEDED
+	case c&(subprocess.EF_ABORTED|subprocess.EF_REMOTE_DISCONNECTED|subprocess.EF_JUDGE_STALLED|subprocess.EF_INPUT_FAILED) != 0:
+		return contester_proto.LocalBatchRunResult_FAIL
+	case c&subprocess.EF_CANNOT_START != 0:
+		return contester_proto.LocalBatchRunResult_CANNOT_START
+	case c&subprocess.EF_MULTITHREADED != 0:
+		return contester_proto.LocalBatchRunResult_MULTITHREADED
+	case c&subprocess.EF_INPUT_STALLED != 0:
+		return contester_proto.LocalBatchRunResult_INPUT_STALLED
+	case c&(subprocess.EF_PROCESS_LIMIT_HIT|subprocess.EF_PROCESS_LIMIT_HIT_POST|subprocess.EF_TOTAL_PROCESS_LIMIT_HIT|subprocess.EF_HANDLE_LIMIT_HIT|subprocess.EF_DISTINCT_FILE_LIMIT_HIT|subprocess.EF_REGISTRY_ACCESS_DENIED) != 0:
+		return contester_proto.LocalBatchRunResult_SECURITY_VIOLATION
+	case c&(subprocess.EF_INACTIVE|subprocess.EF_WALL_TIME_LIMIT_HIT|subprocess.EF_WALL_TIME_LIMIT_HIT_POST|subprocess.EF_WALL_TO_CPU_RATIO_POST) != 0:
+		return contester_proto.LocalBatchRunResult_IDLENESS_LIMIT_EXCEEDED
+	case c&(subprocess.EF_TIME_LIMIT_HIT|subprocess.EF_TIME_LIMIT_HIT_POST|subprocess.EF_KERNEL_TIME_LIMIT_HIT|subprocess.EF_KERNEL_TIME_LIMIT_HIT_POST) != 0:
+		return contester_proto.LocalBatchRunResult_TIME_LIMIT_EXCEEDED
+	case c&(subprocess.EF_MEMORY_LIMIT_HIT|subprocess.EF_MEMORY_LIMIT_HIT_POST|subprocess.EF_VIRTUAL_MEMORY_LIMIT_HIT|subprocess.EF_VIRTUAL_MEMORY_LIMIT_HIT_POST) != 0:
+		return contester_proto.LocalBatchRunResult_MEMORY_LIMIT_EXCEEDED
+	case r.EnvironmentError:
+		return contester_proto.LocalBatchRunResult_ENVIRONMENT_ERROR
+	case c != 0 || r.ExitCode != 0:
+		return contester_proto.LocalBatchRunResult_RUNTIME_ERROR
+	}
+	if test.GetStdOut().GetCompareAnswer() == "" {
+		return contester_proto.LocalBatchRunResult_ACCEPTED
+	}
+	switch {
+	case r.Comparison == nil:
+		return contester_proto.LocalBatchRunResult_FAIL
+	case !r.Comparison.Equal:
+		return contester_proto.LocalBatchRunResult_WRONG_ANSWER
+	}
+	return contester_proto.LocalBatchRunResult_ACCEPTED
+}
+
+// runBatchTest executes one test, cutting its wall time limit to remaining if that is positive and shorter. Returns
+// the limits it got and whether the wall time limit was cut. Closing stop aborts the test.
+func (s *Contester) runBatchTest(request *contester_proto.LocalExecutionParameters, remaining time.Duration, stop <-chan struct{}) (*subprocess.SubprocessResult, appliedLimits, bool, error) {
+	sandbox, err := findSandbox(s.Sandboxes, request)
+	if err != nil {
+		return nil, appliedLimits{}, false, err
+	}
+
+	sandbox.Mutex.Lock()
+	defer sandbox.Mutex.Unlock()
+	defer s.postRun(sandbox)
+
+	if err = chmodRequestIfNeeded(sandbox, request); err != nil {
+		return nil, appliedLimits{}, false, err
+	}
+
+	sub, applied, err := s.setupSubprocess(request, sandbox, true)
+	if err != nil {
+		return nil, appliedLimits{}, false, err
+	}
+
+	var cut bool
+	if remaining > 0 && (sub.WallTimeLimit == 0 || sub.WallTimeLimit > remaining) {
+		sub.WallTimeLimit = remaining
+		cut = true
+	}
+
+	if stop != nil {
+		abort, release := eitherClosed(stop, s.drain.abort)
+		defer release()
+		sub.Abort = abort
+	}
+	result, err := s.execute(sub)
+	if err != nil {
+		return nil, appliedLimits{}, false, err
+	}
+	return result, applied, cut, nil
+}
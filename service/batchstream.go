@@ -0,0 +1,156 @@
+package service
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/contester/runlib/contester_proto"
+	"github.com/contester/runlib/subprocess"
+)
+
+// Batches done this long ago are dropped even if their results were never fully polled.
+const batchRetention = 10 * time.Minute
+
+// batchRun is a LocalBatchRun running in the background, see StartBatchRun.
+type batchRun struct {
+	mu    sync.Mutex
+	tests []*contester_proto.LocalBatchRunResult_Test
+	done  time.Time
+	err   error
+	// Closed and replaced whenever a test is added or the batch is done.
+	updated chan struct{}
+
+	stop     chan struct{}
+	stopOnce sync.Once
+}
+
+func (b *batchRun) add(r *contester_proto.LocalBatchRunResult_Test) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.tests = append(b.tests, r)
+	close(b.updated)
+	b.updated = make(chan struct{})
+}
+
+func (b *batchRun) finish(err error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.done, b.err = time.Now(), err
+	close(b.updated)
+	b.updated = make(chan struct{})
+}
+
+// batches are the background batches by id.
+type batches struct {
+	mu   sync.Mutex
+	runs map[string]*batchRun
+}
+
+func (s *batches) add(b *batchRun) (string, error) {
+	var id [8]byte
+	if _, err := rand.Read(id[:]); err != nil {
+		return "", err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.runs == nil {
+		s.runs = map[string]*batchRun{}
+	}
+	for k, v := range s.runs {
+		v.mu.Lock()
+		if !v.done.IsZero() && time.Since(v.done) > batchRetention {
+			delete(s.runs, k)
+		}
+		v.mu.Unlock()
+	}
+	s.runs[hex.EncodeToString(id[:])] = b
+	return hex.EncodeToString(id[:]), nil
+}
+
+func (s *batches) get(id string) *batchRun {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.runs[id]
+}
+
+func (s *batches) remove(id string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.runs, id)
+}
+
+// StartBatchRun starts the batch and returns right away, for PollBatchRun to get results as they come.
+func (s *Contester) StartBatchRun(request *contester_proto.LocalBatchRun, response *contester_proto.StartBatchRunResult) error {
+	b := &batchRun{updated: make(chan struct{}), stop: make(chan struct{})}
+	id, err := s.batches.add(b)
+	if err != nil {
+		return err
+	}
+	go func() {
+		b.finish(s.runBatch(request, b.stop, b.add))
+	}()
+	response.BatchId = id
+	return nil
+}
+
+func (s *Contester) PollBatchRun(request *contester_proto.PollBatchRun, response *contester_proto.PollBatchRunResult) error {
+	b := s.batches.get(request.GetBatchId())
+	if b == nil {
+		return fmt.Errorf("unknown batch %q", request.GetBatchId())
+	}
+	if request.GetStop() {
+		b.stopOnce.Do(func() { close(b.stop) })
+	}
+	from := int(request.GetFrom())
+	b.mu.Lock()
+	if len(b.tests) <= from && b.done.IsZero() && request.GetWaitMicros() > 0 {
+		updated := b.updated
+		b.mu.Unlock()
+		select {
+		case <-updated:
+		case <-time.After(subprocess.DuFromMicros(request.GetWaitMicros())):
+		}
+		b.mu.Lock()
+	}
+	if from < len(b.tests) {
+		response.Tests = b.tests[from:]
+	}
+	if !b.done.IsZero() {
+		response.Done = true
+		if b.err != nil {
+			response.Error = b.err.Error()
+		}
+	}
+	b.mu.Unlock()
+	if response.Done {
+		s.batches.remove(request.GetBatchId())
+	}
+	return nil
+}
+
+func isClosed(c <-chan struct{}) bool {
+	select {
+	case <-c:
+		return true
+	default:
+		return false
+	}
+}
+
+// eitherClosed returns a channel closed once a or b is. Call release when it's no longer needed.
+func eitherClosed(a, b <-chan struct{}) (<-chan struct{}, func()) {
+	c, done := make(chan struct{}), make(chan struct{})
+	go func() {
+		select {
+		case <-a:
+			close(c)
+		case <-b:
+			close(c)
+		case <-done:
+		}
+	}()
+	return c, func() { close(done) }
+}
@@ -0,0 +1,98 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/contester/runlib/checker"
+	"github.com/contester/runlib/contester_proto"
+	"github.com/contester/runlib/subprocess"
+)
+
+func (s *Contester) LocalExecuteWithChecker(request *contester_proto.LocalExecuteWithChecker, response *contester_proto.LocalExecuteWithCheckerResult) error {
+	convention := request.GetCheckerConvention()
+	if convention == "" {
+		convention = "testlib"
+	}
+	codes, ok := s.CheckerConventions[convention]
+	if !ok {
+		return fmt.Errorf("unknown checker convention %q", convention)
+	}
+
+	solutionSandbox, err := findSandbox(s.Sandboxes, request.Solution)
+	if err != nil {
+		return err
+	}
+
+	checkerSandbox, err := findSandbox(s.Sandboxes, request.Checker)
+	if err != nil {
+		return err
+	}
+
+	// Checker usually runs in the same sandbox, next to the files it checks.
+	for _, sandbox := range lockOrder(solutionSandbox, checkerSandbox) {
+		sandbox.Mutex.Lock()
+		defer sandbox.Mutex.Unlock()
+		defer s.postRun(sandbox)
+	}
+
+	if err = chmodRequestIfNeeded(solutionSandbox, request.Solution); err != nil {
+		return err
+	}
+	if err = chmodRequestIfNeeded(checkerSandbox, request.Checker); err != nil {
+		return err
+	}
+
+	solution, solutionApplied, err := s.setupSubprocess(request.Solution, solutionSandbox, true)
+	if err != nil {
+		return err
+	}
+
+	chk, checkerApplied, err := s.setupSubprocess(request.Checker, checkerSandbox, true)
+	if err != nil {
+		return err
+	}
+
+	// Both runs go through execute, to be drained on shutdown and get cores, and are audited like LocalExecute ones.
+	runs := []*contester_proto.LocalExecutionParameters{request.Solution, request.Checker}
+	var starts []time.Time
+	execute := func(sub *subprocess.Subprocess) (*subprocess.SubprocessResult, error) {
+		starts = append(starts, time.Now())
+		return s.execute(sub)
+	}
+	result, err := checker.RunWithChecker(solution, chk, checker.Files{
+		Input:  request.GetInputFile(),
+		Output: request.GetOutputFile(),
+		Answer: request.GetAnswerFile(),
+	}, codes, execute)
+	if err != nil {
+		if n := len(starts); n > 0 {
+			s.audit.record(s.InvokerId, starts[n-1], runs[n-1], nil, err)
+		}
+		return err
+	}
+
+	response.Solution = &contester_proto.LocalExecutionResult{}
+	fillResult(result.Solution, response.Solution)
+	result.Solution.Release()
+	solutionApplied.mark(response.Solution)
+	response.Solution.Metadata = request.Solution.GetMetadata()
+	s.audit.record(s.InvokerId, starts[0], request.Solution, response.Solution, nil)
+	leanResult(request.Solution, response.Solution)
+	if result.Checker != nil {
+		response.Checker = &contester_proto.LocalExecutionResult{}
+		fillResult(result.Checker, response.Checker)
+		result.Checker.Release()
+		checkerApplied.mark(response.Checker)
+		response.Checker.Metadata = request.Checker.GetMetadata()
+		s.audit.record(s.InvokerId, starts[1], request.Checker, response.Checker, nil)
+		leanResult(request.Checker, response.Checker)
+	} else if len(starts) > 1 {
+		s.audit.record(s.InvokerId, starts[1], request.Checker, nil, errors.New(result.Message))
+	}
+	response.Verdict = contester_proto.LocalExecuteWithCheckerResult_Verdict(result.Verdict)
+	response.CheckerMessage = result.Message
+	response.Score = result.Score
+	return nil
+}
@@ -0,0 +1,96 @@
+package service
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/contester/runlib/contester_proto"
+	"github.com/contester/runlib/subprocess"
+)
+
+// Used when the request doesn't set max_message_size.
+const defaultMaxMessageSize = 1024 * 1024
+
+// routeTable delivers only what's listed in the request.
+type routeTable map[[2]int]bool
+
+func (t routeTable) Route(from, to int, data []byte) bool {
+	return t[[2]int{from, to}]
+}
+
+func (s *Contester) LocalRunCommunication(request *contester_proto.LocalRunCommunication, response *contester_proto.LocalRunCommunicationResult) error {
+	var sandboxes []*Sandbox
+	for i, p := range request.Processes {
+		sandbox, err := findSandbox(s.Sandboxes, p)
+		if err != nil {
+			return fmt.Errorf("process %d: %w", i, err)
+		}
+		sandboxes = append(sandboxes, sandbox)
+	}
+
+	for _, sandbox := range lockOrder(sandboxes...) {
+		sandbox.Mutex.Lock()
+		defer sandbox.Mutex.Unlock()
+		defer s.postRun(sandbox)
+	}
+
+	var subs []*subprocess.Subprocess
+	var applied []appliedLimits
+	for i, p := range request.Processes {
+		if err := chmodRequestIfNeeded(sandboxes[i], p); err != nil {
+			return err
+		}
+		sub, a, err := s.setupSubprocess(p, sandboxes[i], false)
+		if err != nil {
+			return fmt.Errorf("process %d: %w", i, err)
+		}
+		subs = append(subs, sub)
+		applied = append(applied, a)
+	}
+
+	var sw subprocess.Switch
+	if len(request.Routes) > 0 {
+		t := routeTable{}
+		for _, r := range request.Routes {
+			t[[2]int{int(r.GetFrom()), int(r.GetTo())}] = true
+		}
+		sw = t
+	}
+	maxMessageSize := int(request.GetMaxMessageSize())
+	if maxMessageSize == 0 {
+		maxMessageSize = defaultMaxMessageSize
+	}
+
+	if err := s.drain.begin(); err != nil {
+		return err
+	}
+	defer s.drain.runs.Done()
+	for _, sub := range subs {
+		sub.Abort = s.drain.abort
+		defer s.lease(sub)()
+	}
+	start := time.Now()
+	result, err := subprocess.RunCommunication(subs, sw, maxMessageSize)
+	if err != nil {
+		for _, p := range request.Processes {
+			s.audit.record(s.InvokerId, start, p, nil, err)
+		}
+		return err
+	}
+	for i, r := range result.Results {
+		c := &contester_proto.LocalExecutionResult{}
+		fillResult(r, c)
+		r.Release()
+		applied[i].mark(c)
+		c.Metadata = request.Processes[i].GetMetadata()
+		response.Results = append(response.Results, c)
+		s.audit.record(s.InvokerId, start, request.Processes[i], c, nil)
+		leanResult(request.Processes[i], c)
+	}
+	for _, row := range result.Messages {
+		response.Messages = append(response.Messages, &contester_proto.LocalRunCommunicationResult_Row{Sent: row})
+	}
+	response.Deadlock = result.Deadlock
+	response.ProtocolErrors = result.ProtocolErrors
+	return nil
+}
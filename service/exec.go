@@ -1,10 +1,17 @@
 package service
 
 import (
+	"bytes"
+	"errors"
+	"fmt"
 	"sync"
+	"time"
 
+	"github.com/contester/runlib/compare"
 	"github.com/contester/runlib/contester_proto"
+	"github.com/contester/runlib/diagnostics"
 	"github.com/contester/runlib/subprocess"
+	"google.golang.org/protobuf/proto"
 )
 
 func fillEnv(src *contester_proto.LocalEnvironment) ([]string, bool) {
@@ -19,21 +26,36 @@ func fillEnv(src *contester_proto.LocalEnvironment) ([]string, bool) {
 	return result, true
 }
 
-func parseSuccessCode(succ uint32) *contester_proto.ExecutionResultFlags {
+func parseSuccessCode(succ uint64) *contester_proto.ExecutionResultFlags {
 	if succ == 0 {
 		return nil
 	}
 	return &contester_proto.ExecutionResultFlags{
-		Killed:                 succ&subprocess.EF_KILLED != 0,
-		TimeLimitHit:           succ&subprocess.EF_TIME_LIMIT_HIT != 0,
-		KernelTimeLimitHit:     succ&subprocess.EF_KERNEL_TIME_LIMIT_HIT != 0,
-		WallTimeLimitHit:       succ&subprocess.EF_WALL_TIME_LIMIT_HIT != 0,
-		MemoryLimitHit:         succ&subprocess.EF_MEMORY_LIMIT_HIT != 0,
-		Inactive:               succ&subprocess.EF_INACTIVE != 0,
-		TimeLimitHitPost:       succ&subprocess.EF_TIME_LIMIT_HIT_POST != 0,
-		KernelTimeLimitHitPost: succ&subprocess.EF_KERNEL_TIME_LIMIT_HIT_POST != 0,
-		MemoryLimitHitPost:     succ&subprocess.EF_MEMORY_LIMIT_HIT_POST != 0,
-		ProcessLimitHit:        succ&subprocess.EF_PROCESS_LIMIT_HIT != 0,
+		Killed:                    succ&subprocess.EF_KILLED != 0,
+		TimeLimitHit:              succ&subprocess.EF_TIME_LIMIT_HIT != 0,
+		KernelTimeLimitHit:        succ&subprocess.EF_KERNEL_TIME_LIMIT_HIT != 0,
+		WallTimeLimitHit:          succ&subprocess.EF_WALL_TIME_LIMIT_HIT != 0,
+		MemoryLimitHit:            succ&subprocess.EF_MEMORY_LIMIT_HIT != 0,
+		Inactive:                  succ&subprocess.EF_INACTIVE != 0,
+		TimeLimitHitPost:          succ&subprocess.EF_TIME_LIMIT_HIT_POST != 0,
+		KernelTimeLimitHitPost:    succ&subprocess.EF_KERNEL_TIME_LIMIT_HIT_POST != 0,
+		MemoryLimitHitPost:        succ&subprocess.EF_MEMORY_LIMIT_HIT_POST != 0,
+		ProcessLimitHit:           succ&subprocess.EF_PROCESS_LIMIT_HIT != 0,
+		VirtualMemoryLimitHit:     succ&subprocess.EF_VIRTUAL_MEMORY_LIMIT_HIT != 0,
+		VirtualMemoryLimitHitPost: succ&subprocess.EF_VIRTUAL_MEMORY_LIMIT_HIT_POST != 0,
+		ArtifactSizeLimitHit:      succ&subprocess.EF_ARTIFACT_SIZE_LIMIT_HIT != 0,
+		ArtifactSizeLimitHitPost:  succ&subprocess.EF_ARTIFACT_SIZE_LIMIT_HIT_POST != 0,
+		Aborted:                   succ&subprocess.EF_ABORTED != 0,
+		RegistryAccessDenied:      succ&subprocess.EF_REGISTRY_ACCESS_DENIED != 0,
+		JudgeStalled:              succ&subprocess.EF_JUDGE_STALLED != 0,
+		TotalProcessLimitHit:      succ&subprocess.EF_TOTAL_PROCESS_LIMIT_HIT != 0,
+		HandleLimitHit:            succ&subprocess.EF_HANDLE_LIMIT_HIT != 0,
+		DistinctFileLimitHit:      succ&subprocess.EF_DISTINCT_FILE_LIMIT_HIT != 0,
+		InputFailed:               succ&subprocess.EF_INPUT_FAILED != 0,
+		Multithreaded:             succ&subprocess.EF_MULTITHREADED != 0,
+		CannotStart:               succ&subprocess.EF_CANNOT_START != 0,
+		InputStalled:              succ&subprocess.EF_INPUT_STALLED != 0,
+		WallToCpuRatioPost:        succ&subprocess.EF_WALL_TO_CPU_RATIO_POST != 0,
 	}
 }
 
@@ -56,13 +78,118 @@ func parseTime(r *subprocess.SubprocessResult) *contester_proto.ExecutionResultT
 	return &result
 }
 
+func parseMemorySamples(samples []subprocess.MemorySample) []*contester_proto.MemorySample {
+	if len(samples) == 0 {
+		return nil
+	}
+	result := make([]*contester_proto.MemorySample, 0, len(samples))
+	for _, v := range samples {
+		result = append(result, &contester_proto.MemorySample{
+			OffsetMicros: subprocess.GetMicros(v.Offset),
+			Commit:       v.Commit,
+			WorkingSet:   v.WorkingSet,
+		})
+	}
+	return result
+}
+
+func parseOutputChunks(chunks []subprocess.OutputChunk) []*contester_proto.OutputChunk {
+	if len(chunks) == 0 {
+		return nil
+	}
+	result := make([]*contester_proto.OutputChunk, 0, len(chunks))
+	for _, v := range chunks {
+		result = append(result, &contester_proto.OutputChunk{
+			OffsetMicros: subprocess.GetMicros(v.Offset),
+			Size:         uint64(v.Size),
+		})
+	}
+	return result
+}
+
+func parseComparison(c *compare.Result) *contester_proto.Comparison {
+	if c == nil {
+		return nil
+	}
+	return &contester_proto.Comparison{
+		Equal:    c.Equal,
+		Token:    uint64(c.Token),
+		Expected: c.Expected,
+		Actual:   c.Actual,
+		Message:  c.Message,
+
+		EmptyOutput:    c.EmptyOutput,
+		ExpectedLine:   uint64(c.ExpectedLine),
+		ActualLine:     uint64(c.ActualLine),
+		ExpectedBefore: c.ExpectedBefore,
+		ActualBefore:   c.ActualBefore,
+		ExpectedAfter:  c.ExpectedAfter,
+		ActualAfter:    c.ActualAfter,
+		Answer:         uint32(c.Answer),
+	}
+}
+
+func parseKillSnapshot(s *subprocess.KillSnapshot) *contester_proto.KillSnapshot {
+	if s == nil {
+		return nil
+	}
+	result := &contester_proto.KillSnapshot{
+		DumpFile: s.DumpFile,
+	}
+	for _, v := range s.Threads {
+		result.Threads = append(result.Threads, &contester_proto.KillSnapshot_Thread{
+			Id:               v.ID,
+			UserTimeMicros:   subprocess.GetMicros(v.UserTime),
+			KernelTimeMicros: subprocess.GetMicros(v.KernelTime),
+		})
+	}
+	return result
+}
+
 func fillRedirect(r *contester_proto.RedirectParameters) *subprocess.Redirect {
 	if r == nil {
 		return nil
 	}
 
-	var result subprocess.Redirect
-	if r.GetFilename() != "" {
+	result := subprocess.Redirect{
+		CaptureSize:  int64(r.GetCaptureSize()),
+		MaxInputSize: int64(r.GetMaxInputSize()),
+		RecordChunks: int(r.GetRecordChunks()),
+	}
+	if r.GetCompareAnswer() != "" {
+		result.CompareOptions = compare.Options{
+			Epsilon:           r.GetCompareOptions().GetEpsilon(),
+			RejectEmptyOutput: r.GetCompareOptions().GetRejectEmptyOutput(),
+			Binary:            r.GetCompareOptions().GetBinary(),
+			IgnoreCase:        r.GetCompareOptions().GetIgnoreCase(),
+		}
+		if r.GetMappedFilename() != "" {
+			result.Filename = r.GetMappedFilename()
+			result.Mode = subprocess.REDIRECT_MAPPED
+			result.MaxOutputSize = int64(r.GetMappedSize())
+			result.Answers = append([]string{r.GetCompareAnswer()}, r.GetCompareAlternatives()...)
+		} else {
+			result.Filename = r.GetCompareAnswer()
+			result.Mode = subprocess.REDIRECT_COMPARE
+			result.OtherAnswers = r.GetCompareAlternatives()
+		}
+	} else if len(r.GetSources()) > 0 {
+		result.Mode = subprocess.REDIRECT_CONCAT
+		for _, s := range r.GetSources() {
+			source := subprocess.InputSource{Filename: s.GetFilename()}
+			if s.Data != nil {
+				source.Data, _ = s.Data.Bytes()
+			}
+			result.Sources = append(result.Sources, source)
+		}
+	} else if r.GetNamedPipe() != "" {
+		result.Filename = r.GetNamedPipe()
+		result.Mode = subprocess.REDIRECT_NAMED_PIPE
+	} else if r.GetMappedFilename() != "" {
+		result.Filename = r.GetMappedFilename()
+		result.Mode = subprocess.REDIRECT_MAPPED
+		result.MaxOutputSize = int64(r.GetMappedSize())
+	} else if r.GetFilename() != "" {
 		result.Filename = r.GetFilename()
 		result.Mode = subprocess.REDIRECT_FILE
 	} else if r.GetMemory() {
@@ -70,10 +197,43 @@ func fillRedirect(r *contester_proto.RedirectParameters) *subprocess.Redirect {
 		if r.Buffer != nil {
 			result.Data, _ = r.Buffer.Bytes()
 		}
+		if r.GetHeadSize() > 0 {
+			result.Mode = subprocess.REDIRECT_MEMORY_HEAD_TAIL
+			result.HeadSize = int64(r.GetHeadSize())
+			result.MaxOutputSize = int64(r.GetTailSize())
+			result.InLines = r.GetInLines()
+		} else if r.GetTailSize() > 0 {
+			result.Mode = subprocess.REDIRECT_MEMORY_TAIL
+			result.MaxOutputSize = int64(r.GetTailSize())
+		}
 	}
 	return &result
 }
 
+func fillFileIO(f *contester_proto.FileIO) *subprocess.FileIO {
+	if f == nil {
+		return nil
+	}
+	return &subprocess.FileIO{
+		InputName:     f.GetInputName(),
+		OutputName:    f.GetOutputName(),
+		InputFile:     f.GetInputFile(),
+		OutputMaxSize: int64(f.GetOutputMaxSize()),
+	}
+}
+
+func fillStageFiles(files []*contester_proto.StagedFile) []subprocess.StagedFile {
+	var result []subprocess.StagedFile
+	for _, f := range files {
+		result = append(result, subprocess.StagedFile{
+			Name:   f.GetName(),
+			Source: f.GetSource(),
+			Data:   f.GetData(),
+		})
+	}
+	return result
+}
+
 func findSandbox(s []SandboxPair, request *contester_proto.LocalExecutionParameters) (*Sandbox, error) {
 	if request.GetSandboxId() != "" {
 		return getSandboxById(s, request.GetSandboxId())
@@ -81,17 +241,120 @@ func findSandbox(s []SandboxPair, request *contester_proto.LocalExecutionParamet
 	return getSandboxByPath(s, request.GetCurrentDirectory())
 }
 
+// outputBlob is NewBlob for output which is released once the response is filled: the blob can't keep its memory.
+func outputBlob(data []byte) *contester_proto.Blob {
+	b, _ := contester_proto.NewBlob(data)
+	if b != nil && b.Compression == nil {
+		b.Data = bytes.Clone(b.Data)
+	}
+	return b
+}
+
 func fillResult(result *subprocess.SubprocessResult, response *contester_proto.LocalExecutionResult) {
 	response.TotalProcesses = result.TotalProcesses
+	response.PeakActiveProcesses = result.PeakActiveProcesses
 	response.ReturnCode = result.ExitCode
+	response.SignedReturnCode = result.SignedExitCode()
 	response.Flags = parseSuccessCode(result.SuccessCode)
 	response.Time = parseTime(result)
 	response.Memory = result.PeakMemory
-	response.StdOut, _ = contester_proto.NewBlob(result.Output)
-	response.StdErr, _ = contester_proto.NewBlob(result.Error)
+	response.PeakProcessMemory = result.PeakProcessMemory
+	response.PeakJobMemory = result.PeakJobMemory
+	response.PeakVirtualMemory = result.PeakVirtualMemory
+	response.ArtifactSize = result.ArtifactSize
+	response.IsolationDowngraded = result.IsolationDowngraded
+	for _, f := range result.DowngradedFeatures {
+		response.DowngradedFeatures = append(response.DowngradedFeatures, string(f))
+	}
+	response.StdOut = outputBlob(result.Output)
+	response.StdErr = outputBlob(result.Error)
+	response.StdIn, _ = contester_proto.NewBlob(result.Input)
+	response.FileOutput, _ = contester_proto.NewBlob(result.FileOutput)
+	response.MemorySamples = parseMemorySamples(result.MemorySamples)
+	response.KillWaitMicros = uint64(result.KillWaitTime.Microseconds())
+	response.CloseMicros = uint64(result.CloseTime.Microseconds())
+	response.CpuCycles = result.CpuCycles
+	response.HardFaults = result.HardFaults
+	response.PagingTimeMicros = subprocess.GetMicros(result.PagingTime)
+	response.WorkingSetTrims = result.WorkingSetTrims
+	response.ModulesLoadedCount = result.ModulesLoadedCount
+	response.PeakThreadCount = result.PeakThreadCount
+	response.PeakHandleCount = result.PeakHandleCount
+	response.NamedObjectCount = result.NamedObjectCount
+	response.NamedObjectLimitExceeded = result.NamedObjectLimitExceeded
+	response.DistinctFileCount = result.DistinctFileCount
+	response.PeakSocketCount = result.PeakSocketCount
+	response.SocketLimitExceeded = result.SocketLimitExceeded
+	response.ModuleLimitExceeded = result.ModuleLimitExceeded
+	response.IoWaitEstimateMicros = subprocess.GetMicros(result.IoWaitEstimate)
+	response.SchedulerDelayMicros = subprocess.GetMicros(result.SchedulerDelay)
+	response.StdinLimitHit = result.StdinLimitHit
+	response.InputError = result.InputError
+	response.UnreadInput = result.UnreadInput
+	response.InputWaitMicros = subprocess.GetMicros(result.InputWaitTime)
+	response.EnvironmentError = result.EnvironmentError
+	response.EnvironmentRetries = result.EnvironmentRetries
+	response.FirstEnvironmentError = result.FirstEnvironmentError
+	response.StdOutSize = uint64(result.OutputSize)
+	response.StdErrSize = uint64(result.ErrorSize)
+	response.Privileges = result.Privileges
+	response.Nonce = result.Nonce
+	response.AffinityMasks = result.AffinityMasks
+	response.NonceFound = result.NonceFound
+	response.Reason = result.Reason
+	response.ExitedInGrace = result.ExitedInGrace
+	response.StdoutClosedEarly = result.StdOutClosedEarly
+	response.TightestLimit = result.TightestLimit
+	response.TightestLimitUsage = result.TightestLimitUsage
+	if result.HostLoad != nil {
+		response.HostLoad = &contester_proto.HostLoad{
+			CpuUtilization: result.HostLoad.CpuUtilization,
+			ConcurrentRuns: result.HostLoad.ConcurrentRuns,
+
+			BaseFrequencyMhz:    result.HostLoad.BaseFrequencyMhz,
+			CurrentFrequencyMhz: result.HostLoad.CurrentFrequencyMhz,
+		}
+	}
+	response.EnergyMicrojoules = result.EnergyMicrojoules
+	response.StdoutCleanlyClosed = result.StdoutCleanlyClosed
+	response.FasterThanExpected = result.FasterThanExpected
+	response.WallToCpuRatioExceeded = result.WallToCpuRatioExceeded
+	response.StdOutChunks = parseOutputChunks(result.OutputChunks)
+	response.StdErrChunks = parseOutputChunks(result.ErrorChunks)
+	response.Comparison = parseComparison(result.Comparison)
+	response.CpuRatePercent = result.CpuRatePercent
+	response.IoPriority = uint32(result.IoPriority)
+	response.KillSnapshot = parseKillSnapshot(result.KillSnapshot)
 }
 
-func (s *Contester) setupSubprocess(request *contester_proto.LocalExecutionParameters, sandbox *Sandbox, doRedirects bool) (sub *subprocess.Subprocess, err error) {
+// leanResult cuts the result down for lean_result requests.
+func leanResult(request *contester_proto.LocalExecutionParameters, r *contester_proto.LocalExecutionResult) {
+	if !request.GetLeanResult() || r == nil {
+		return
+	}
+	flags, t, memory, code, reason := r.Flags, r.Time, r.Memory, r.ReturnCode, r.Reason
+	stdOut, stdErr, stdIn, fileOutput := r.StdOut, r.StdErr, r.StdIn, r.FileOutput
+	comparison, diags, launchError, clamped := r.Comparison, r.Diagnostics, r.LaunchError, r.LimitsClamped
+	metadata := r.Metadata
+	proto.Reset(r)
+	r.Flags, r.Time, r.Memory, r.ReturnCode, r.Reason = flags, t, memory, code, reason
+	r.StdOut, r.StdErr, r.StdIn, r.FileOutput = stdOut, stdErr, stdIn, fileOutput
+	r.Comparison, r.Diagnostics, r.LaunchError, r.LimitsClamped = comparison, diags, launchError, clamped
+	r.Metadata = metadata
+}
+
+func fillLaunchError(e *subprocess.LaunchError, response *contester_proto.LocalExecutionResult) {
+	response.Flags = &contester_proto.ExecutionResultFlags{LaunchFailed: true}
+	response.LaunchError = e.Error()
+	response.StdOut, _ = contester_proto.NewBlob(e.Stdout)
+	response.StdErr, _ = contester_proto.NewBlob(e.Stderr)
+}
+
+func (s *Contester) setupSubprocess(request *contester_proto.LocalExecutionParameters, sandbox *Sandbox, doRedirects bool) (sub *subprocess.Subprocess, applied appliedLimits, err error) {
+	if request, applied.clamped, err = s.runParameters(request); err != nil {
+		return nil, applied, err
+	}
+	applied.memoryLimit = request.GetMemoryLimit()
 	sub = subprocess.SubprocessCreate()
 
 	sub.Cmd = &subprocess.CommandLine{
@@ -101,16 +364,68 @@ func (s *Contester) setupSubprocess(request *contester_proto.LocalExecutionParam
 	}
 
 	sub.CurrentDirectory = request.CurrentDirectory
+	sub.ExecutableSha256 = request.GetExecutableSha256()
 
 	sub.TimeLimit = subprocess.DuFromMicros(request.GetTimeLimitMicros())
 	sub.KernelTimeLimit = subprocess.DuFromMicros(request.GetKernelTimeLimitMicros())
 	sub.WallTimeLimit = subprocess.DuFromMicros(request.GetWallTimeLimitMicros())
+	sub.MinExpectedTime = subprocess.DuFromMicros(request.GetMinExpectedTimeMicros())
+	sub.MaxWallToCpuRatio = request.GetMaxWallToCpuRatio()
+	sub.FailOnWallToCpuRatio = request.GetFailOnWallToCpuRatio()
 	sub.MemoryLimit = request.GetMemoryLimit()
+	if request.GetMemoryAccounting() == contester_proto.LocalExecutionParameters_WORKING_SET {
+		sub.MemoryAccounting = subprocess.MEMORY_ACCOUNTING_WORKING_SET
+	}
+	sub.VirtualMemoryLimit = request.GetVirtualMemoryLimit()
+	sub.ArtifactSizeLimit = request.GetArtifactSizeLimit()
 	sub.CheckIdleness = request.GetCheckIdleness()
+	sub.WatchdogTimeout = s.WatchdogTimeout
+	sub.ExitCodeClasses = s.ExitCodeClasses
+	sub.RetryOnEnvironmentError = request.GetRetryOnEnvironmentError()
+	sub.KillGracePeriod = subprocess.DuFromMicros(request.GetKillGracePeriodMicros())
+	sub.InputStallTimeout = subprocess.DuFromMicros(request.GetInputStallTimeoutMicros())
+	sub.ExcludeInputWait = request.GetExcludeInputWait()
+	sub.CrashDumps = request.GetCrashDumps()
+	if request.GetContinueAfterStdoutClose() {
+		sub.OnStdOutClose = subprocess.STDOUT_CLOSE_CONTINUE
+	}
+	sub.StartProbe = subprocess.DuFromMicros(request.GetStartProbeMicros())
 	sub.RestrictUi = request.GetRestrictUi()
 	sub.NoJob = request.GetNoJob()
+	if sub.TotalProcessLimit = request.GetTotalProcessLimit(); sub.TotalProcessLimit > 0 {
+		sub.FailOnJobCreationFailure = true
+	}
+	sub.CpuRatePercent = request.GetCpuRatePercent()
+	sub.KillSnapshotDir = request.GetKillSnapshotDir()
+	sub.MemorySampleInterval = subprocess.DuFromMicros(request.GetMemorySampleIntervalMicros())
+	sub.MinMemoryChecks = request.GetMinMemoryChecks()
+	sub.MeasureCycles = request.GetMeasureCycles()
+	sub.MeasurePaging = request.GetMeasurePaging()
+	sub.Deterministic = request.GetDeterministic()
+	sub.MeasureHostLoad = request.GetMeasureHostLoad()
+	sub.CountModules = request.GetCountModules()
+	sub.CountThreads = request.GetCountThreads()
+	sub.MaxThreads = request.GetMaxThreads()
+	sub.CountHandles = request.GetCountHandles()
+	sub.HandleLimit = request.GetHandleLimit()
+	sub.CountDistinctFiles = request.GetCountDistinctFiles()
+	sub.DistinctFileLimit = request.GetDistinctFileLimit()
+	sub.CountSockets = request.GetCountSockets()
+	sub.SocketLimit = request.GetSocketLimit()
+	sub.ModuleLimit = request.GetModuleLimit()
+	sub.MeasureIoWait = request.GetMeasureIoWait()
+	sub.MeasureSchedulerDelay = request.GetMeasureSchedulerDelay()
+	if request.GetNonce() != "" || request.GetNonceEnv() != "" || request.GetNonceFirstLine() {
+		sub.Nonce = &subprocess.Nonce{
+			Value:     request.GetNonce(),
+			EnvName:   request.GetNonceEnv(),
+			FirstLine: request.GetNonceFirstLine(),
+			Verify:    request.GetVerifyNonce(),
+		}
+	}
 
 	sub.Environment, sub.NoInheritEnvironment = fillEnv(request.Environment)
+	sub.Locale = request.GetLocale()
 
 	if doRedirects {
 		sub.StdIn = fillRedirect(request.StdIn)
@@ -122,6 +437,9 @@ func (s *Contester) setupSubprocess(request *contester_proto.LocalExecutionParam
 	} else {
 		sub.StdErr = fillRedirect(request.StdErr)
 	}
+	sub.FileIO = fillFileIO(request.FileIo)
+	sub.StageFiles = fillStageFiles(request.StageFiles)
+	sub.RemoveStagedFiles = request.GetRemoveStagedFiles()
 
 	sub.Options = &subprocess.PlatformOptions{}
 
@@ -155,29 +473,78 @@ func (s *Contester) LocalExecute(request *contester_proto.LocalExecutionParamete
 
 	sandbox.Mutex.Lock()
 	defer sandbox.Mutex.Unlock()
+	defer s.postRun(sandbox)
 
 	err = chmodRequestIfNeeded(sandbox, request)
 	if err != nil {
 		return err
 	}
 
-	sub, err := s.setupSubprocess(request, sandbox, true)
+	parsers, err := s.diagnosticParsers(request.DiagnosticParsers)
+	if err != nil {
+		return err
+	}
+
+	sub, applied, err := s.setupSubprocess(request, sandbox, true)
 
 	if err != nil {
 		return err
 	}
 
-	result, err := sub.Execute()
+	start := time.Now()
+	result, err := s.execute(sub)
 
+	var launchErr *subprocess.LaunchError
+	if errors.As(err, &launchErr) {
+		fillLaunchError(launchErr, response)
+		response.Metadata = request.GetMetadata()
+		s.audit.record(s.InvokerId, start, request, response, nil)
+		return nil
+	}
 	if err != nil {
+		s.audit.record(s.InvokerId, start, request, nil, err)
 		return err
 	}
+	defer result.Release()
 
 	fillResult(result, response)
+	applied.mark(response)
+	response.Metadata = request.GetMetadata()
+	if len(parsers) != 0 {
+		response.Diagnostics = append(parseDiagnostics(result.Output, parsers), parseDiagnostics(result.Error, parsers)...)
+	}
+	s.audit.record(s.InvokerId, start, request, response, nil)
+	leanResult(request, response)
 
 	return nil
 }
 
+func (s *Contester) diagnosticParsers(names []string) ([]*diagnostics.Parser, error) {
+	var result []*diagnostics.Parser
+	for _, name := range names {
+		p, ok := s.DiagnosticParsers[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown diagnostic parser %q", name)
+		}
+		result = append(result, p)
+	}
+	return result, nil
+}
+
+func parseDiagnostics(text []byte, parsers []*diagnostics.Parser) []*contester_proto.Diagnostic {
+	var result []*contester_proto.Diagnostic
+	for _, d := range diagnostics.Parse(text, parsers) {
+		result = append(result, &contester_proto.Diagnostic{
+			File:     d.File,
+			Line:     uint32(d.Line),
+			Column:   uint32(d.Column),
+			Severity: d.Severity,
+			Message:  d.Message,
+		})
+	}
+	return result
+}
+
 func (s *Contester) LocalExecuteConnected(request *contester_proto.LocalExecuteConnected, response *contester_proto.LocalExecuteConnectedResult) error {
 	firstSandbox, err := findSandbox(s.Sandboxes, request.First)
 	if err != nil {
@@ -191,9 +558,11 @@ func (s *Contester) LocalExecuteConnected(request *contester_proto.LocalExecuteC
 
 	firstSandbox.Mutex.Lock()
 	defer firstSandbox.Mutex.Unlock()
+	defer s.postRun(firstSandbox)
 
 	secondSandbox.Mutex.Lock()
 	defer secondSandbox.Mutex.Unlock()
+	defer s.postRun(secondSandbox)
 
 	err = chmodRequestIfNeeded(firstSandbox, request.First)
 	if err != nil {
@@ -205,12 +574,12 @@ func (s *Contester) LocalExecuteConnected(request *contester_proto.LocalExecuteC
 		return err
 	}
 
-	first, err := s.setupSubprocess(request.First, firstSandbox, false)
+	first, firstApplied, err := s.setupSubprocess(request.First, firstSandbox, false)
 	if err != nil {
 		return err
 	}
 
-	second, err := s.setupSubprocess(request.Second, secondSandbox, false)
+	second, secondApplied, err := s.setupSubprocess(request.Second, secondSandbox, false)
 	if err != nil {
 		return err
 	}
@@ -219,24 +588,37 @@ func (s *Contester) LocalExecuteConnected(request *contester_proto.LocalExecuteC
 	if err != nil {
 		return err
 	}
+	first.StdIn.CaptureSize = int64(request.First.GetStdIn().GetCaptureSize())
+	second.StdIn.CaptureSize = int64(request.Second.GetStdIn().GetCaptureSize())
+	first.StdIn.MaxInputSize = int64(request.First.GetStdIn().GetMaxInputSize())
+	second.StdIn.MaxInputSize = int64(request.Second.GetStdIn().GetMaxInputSize())
+	first.StdOut.RecordChunks = int(request.First.GetStdOut().GetRecordChunks())
+	second.StdOut.RecordChunks = int(request.Second.GetStdOut().GetRecordChunks())
 
 	var wg sync.WaitGroup
 	var e1, e2 error
 
-	runaway := func(sp *subprocess.Subprocess, ep *error, cp **contester_proto.LocalExecutionResult) {
+	runaway := func(sp *subprocess.Subprocess, request *contester_proto.LocalExecutionParameters, applied appliedLimits, ep *error, cp **contester_proto.LocalExecutionResult) {
 		defer wg.Done()
-		r, e := sp.Execute()
+		start := time.Now()
+		r, e := s.execute(sp)
 		if e != nil {
 			*ep = e
+			s.audit.record(s.InvokerId, start, request, nil, e)
 			return
 		}
 		*cp = &contester_proto.LocalExecutionResult{}
 		fillResult(r, *cp)
+		r.Release()
+		applied.mark(*cp)
+		(*cp).Metadata = request.GetMetadata()
+		s.audit.record(s.InvokerId, start, request, *cp, nil)
+		leanResult(request, *cp)
 	}
 
 	wg.Add(2)
-	go runaway(first, &e1, &response.First)
-	go runaway(second, &e2, &response.Second)
+	go runaway(first, request.First, firstApplied, &e1, &response.First)
+	go runaway(second, request.Second, secondApplied, &e2, &response.Second)
 
 	wg.Wait()
 
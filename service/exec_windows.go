@@ -7,6 +7,29 @@ import (
 
 func (s *Contester) localPlatformSetup(sub *subprocess.Subprocess, request *contester_proto.LocalExecutionParameters) error {
 	sub.Options.Environment = s.GData
+	sub.Options.DllDirectories = request.GetDllDirectories()
+	sub.Options.UnrestrictedDllSearch = request.GetUnrestrictedDllSearch()
+	sub.Options.Manifest = request.GetManifest()
+	sub.Options.PriorityClass = request.GetPriorityClass()
+	sub.Options.MainThreadPriority = request.GetMainThreadPriority()
+	sub.Options.IoPriority = subprocess.IoPriority(request.GetIoPriority())
+	sub.Options.PseudoConsole = request.GetPseudoConsole()
+	sub.Options.RemovePrivileges = request.GetRemovePrivileges()
+	sub.Options.KeepPrivileges = request.GetKeepPrivileges()
+	sub.Options.AllowLargePages = request.GetAllowLargePages()
+	var err error
+	if sub.Options.FailPolicies, err = subprocess.ParseFailPolicies(request.GetFailOpen(), request.GetFailClosed()); err != nil {
+		return err
+	}
+	sub.Options.AuditNamedObjects = request.GetAuditNamedObjects()
+	sub.Options.NamedObjectLimit = request.GetNamedObjectLimit()
+	if sub.Options.AppContainer = request.GetAppContainer(); sub.Options.AppContainer != "" {
+		// The container replaces the sandbox account.
+		sub.Login = nil
+		sub.Options.AppContainerCapabilities = request.GetAppContainerCapabilities()
+		sub.Options.DenyRegistry = request.GetDenyRegistry()
+		sub.Options.RegistryAllowlist = request.GetRegistryAllowlist()
+	}
 	return nil
 }
 
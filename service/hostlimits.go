@@ -0,0 +1,105 @@
+package service
+
+import (
+	"fmt"
+
+	"github.com/contester/runlib/contester_proto"
+	"google.golang.org/protobuf/proto"
+)
+
+// hostLimits are the largest limits the host agrees to run with, so that a bad request can't make it thrash or tie
+// up a sandbox for hours. Zero means no bound.
+type hostLimits struct {
+	MaxMemoryLimit         uint64
+	MaxTimeLimitMicros     uint64
+	MaxWallTimeLimitMicros uint64
+	// Fail requests over a bound instead of clamping them.
+	Reject bool
+}
+
+// over returns the request field name and bound of each limit the request asks more than; a limit left unset is
+// not over, it gets the bound.
+func (l *hostLimits) over(request *contester_proto.LocalExecutionParameters) []string {
+	var result []string
+	check := func(name string, v, max uint64) {
+		if max > 0 && v > max {
+			result = append(result, fmt.Sprintf("%s %d > %d", name, v, max))
+		}
+	}
+	check("memory_limit", request.GetMemoryLimit(), l.MaxMemoryLimit)
+	check("time_limit_micros", request.GetTimeLimitMicros(), l.MaxTimeLimitMicros)
+	check("wall_time_limit_micros", request.GetWallTimeLimitMicros(), l.MaxWallTimeLimitMicros)
+	return result
+}
+
+// bound returns the request with its limits cut down to the host bounds, or an error if they are over and l.Reject is
+// set. The request itself isn't changed.
+func (l *hostLimits) bound(request *contester_proto.LocalExecutionParameters) (*contester_proto.LocalExecutionParameters, error) {
+	if l.MaxMemoryLimit == 0 && l.MaxTimeLimitMicros == 0 && l.MaxWallTimeLimitMicros == 0 {
+		return request, nil
+	}
+	if over := l.over(request); len(over) > 0 && l.Reject {
+		return nil, fmt.Errorf("limits over the host bounds: %v", over)
+	}
+	result := proto.Clone(request).(*contester_proto.LocalExecutionParameters)
+	clamp := func(v *uint64, max uint64) {
+		if max > 0 && (*v == 0 || *v > max) {
+			*v = max
+		}
+	}
+	clamp(&result.MemoryLimit, l.MaxMemoryLimit)
+	clamp(&result.TimeLimitMicros, l.MaxTimeLimitMicros)
+	clamp(&result.WallTimeLimitMicros, l.MaxWallTimeLimitMicros)
+	return result, nil
+}
+
+// Smallest memory limit memory_limit_fraction resolves to, however little memory the host has.
+const minFractionalMemoryLimit = 64 * 1024 * 1024
+
+// withMemoryFraction returns the request with memory_limit_fraction turned into memory_limit, if that isn't set.
+func withMemoryFraction(request *contester_proto.LocalExecutionParameters) *contester_proto.LocalExecutionParameters {
+	fraction := request.GetMemoryLimitFraction()
+	if fraction <= 0 || request.GetMemoryLimit() != 0 {
+		return request
+	}
+	if fraction > 1 {
+		fraction = 1
+	}
+	limit := uint64(fraction * float64(getHostCapabilities(request.GetNoJob()).PhysicalMemory))
+	if limit < minFractionalMemoryLimit {
+		limit = minFractionalMemoryLimit
+	}
+	result := proto.Clone(request).(*contester_proto.LocalExecutionParameters)
+	result.MemoryLimit = limit
+	return result
+}
+
+// runParameters is the request as it's run: on top of its profile, with memory_limit_fraction resolved and limits
+// within the host bounds. Also tells if that lowered any limit.
+func (s *Contester) runParameters(request *contester_proto.LocalExecutionParameters) (*contester_proto.LocalExecutionParameters, bool, error) {
+	request, err := s.withProfile(request)
+	if err != nil {
+		return nil, false, err
+	}
+	request = withMemoryFraction(request)
+	clamped := len(s.Limits.over(request)) > 0
+	if request, err = s.Limits.bound(request); err != nil {
+		return nil, false, err
+	}
+	return request, clamped, nil
+}
+
+// appliedLimits is what runParameters made of the limits of a request, as the run got them.
+type appliedLimits struct {
+	clamped     bool
+	memoryLimit uint64
+}
+
+// mark fills limits_clamped and applied_memory_limit of the result.
+func (a appliedLimits) mark(r *contester_proto.LocalExecutionResult) {
+	if r == nil {
+		return
+	}
+	r.LimitsClamped = a.clamped
+	r.AppliedMemoryLimit = a.memoryLimit
+}
@@ -0,0 +1,85 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/contester/runlib/contester_proto"
+)
+
+func TestHostLimitsBound(t *testing.T) {
+	cases := []struct {
+		limits                hostLimits
+		memory, time, wall    uint64
+		wMemory, wTime, wWall uint64
+		fails                 bool
+	}{
+		{hostLimits{}, 100, 10, 20, 100, 10, 20, false},
+		{hostLimits{}, 0, 0, 0, 0, 0, 0, false},
+		{hostLimits{MaxMemoryLimit: 64}, 100, 10, 20, 64, 10, 20, false},
+		{hostLimits{MaxMemoryLimit: 64}, 32, 10, 20, 32, 10, 20, false},
+		{hostLimits{MaxMemoryLimit: 64}, 0, 10, 20, 64, 10, 20, false},
+		{hostLimits{MaxTimeLimitMicros: 5, MaxWallTimeLimitMicros: 15}, 100, 10, 20, 100, 5, 15, false},
+		{hostLimits{MaxTimeLimitMicros: 5, MaxWallTimeLimitMicros: 15}, 100, 0, 0, 100, 5, 15, false},
+		{hostLimits{MaxMemoryLimit: 64, Reject: true}, 32, 10, 20, 32, 10, 20, false},
+		{hostLimits{MaxMemoryLimit: 64, Reject: true}, 0, 10, 20, 64, 10, 20, false},
+		{hostLimits{MaxMemoryLimit: 64, Reject: true}, 100, 10, 20, 0, 0, 0, true},
+		{hostLimits{MaxWallTimeLimitMicros: 15, Reject: true}, 100, 10, 20, 0, 0, 0, true},
+	}
+	for _, c := range cases {
+		request := &contester_proto.LocalExecutionParameters{MemoryLimit: c.memory, TimeLimitMicros: c.time, WallTimeLimitMicros: c.wall}
+		r, err := c.limits.bound(request)
+		if c.fails {
+			if err == nil {
+				t.Errorf("%+v, %d %d %d: no error", c.limits, c.memory, c.time, c.wall)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%+v, %d %d %d: %s", c.limits, c.memory, c.time, c.wall, err)
+			continue
+		}
+		if r.GetMemoryLimit() != c.wMemory || r.GetTimeLimitMicros() != c.wTime || r.GetWallTimeLimitMicros() != c.wWall {
+			t.Errorf("%+v, %d %d %d: got %d %d %d, want %d %d %d", c.limits, c.memory, c.time, c.wall,
+				r.GetMemoryLimit(), r.GetTimeLimitMicros(), r.GetWallTimeLimitMicros(), c.wMemory, c.wTime, c.wWall)
+		}
+		if request.GetMemoryLimit() != c.memory || request.GetTimeLimitMicros() != c.time || request.GetWallTimeLimitMicros() != c.wall {
+			t.Errorf("%+v, %d %d %d: request changed to %v", c.limits, c.memory, c.time, c.wall, request)
+		}
+	}
+}
+
+func TestWithMemoryFraction(t *testing.T) {
+	physical := getHostCapabilities(false).PhysicalMemory
+	if physical == 0 {
+		t.Skip("no physical memory size")
+	}
+	atLeastMin := func(v uint64) uint64 {
+		if v < minFractionalMemoryLimit {
+			return minFractionalMemoryLimit
+		}
+		return v
+	}
+	cases := []struct {
+		memory   uint64
+		fraction float64
+		want     uint64
+	}{
+		{0, 0, 0},
+		{100, 0, 100},
+		{100, 0.5, 100},
+		{0, -1, 0},
+		{0, 0.5, atLeastMin(uint64(0.5 * float64(physical)))},
+		{0, 1, physical},
+		{0, 2, physical},
+		{0, 1e-12, minFractionalMemoryLimit},
+	}
+	for _, c := range cases {
+		request := &contester_proto.LocalExecutionParameters{MemoryLimit: c.memory, MemoryLimitFraction: c.fraction}
+		if got := withMemoryFraction(request).GetMemoryLimit(); got != c.want {
+			t.Errorf("memory %d, fraction %g: got %d, want %d", c.memory, c.fraction, got, c.want)
+		}
+		if request.GetMemoryLimit() != c.memory {
+			t.Errorf("memory %d, fraction %g: request changed to %d", c.memory, c.fraction, request.GetMemoryLimit())
+		}
+	}
+}
@@ -0,0 +1,112 @@
+package service
+
+import (
+	"math/bits"
+
+	"github.com/contester/runlib/contester_proto"
+)
+
+// hostCapabilities is what the host can give to a run and which limits it can enforce.
+type hostCapabilities struct {
+	PhysicalMemory uint64
+	CpuMask        uint64
+
+	CpuRate          bool
+	WorkingSetMemory bool
+	PseudoConsole    bool
+}
+
+// CheckLimits tells if the host can enforce limits of the request, without running anything. It's meant to be asked
+// before assigning a problem to the host: e.g. a memory limit above physical memory can't really be reached.
+func (s *Contester) CheckLimits(request *contester_proto.CheckLimitsRequest, response *contester_proto.CheckLimitsResponse) error {
+	caps := getHostCapabilities(request.GetParameters().GetNoJob())
+	p := withMemoryFraction(request.GetParameters())
+
+	response.PhysicalMemory = caps.PhysicalMemory
+	response.CpuCount = uint32(bits.OnesCount64(caps.CpuMask))
+
+	add := func(name string, requested uint64, status contester_proto.CheckLimitsResponse_Status, effective uint64, note string) {
+		response.Limits = append(response.Limits, &contester_proto.CheckLimitsResponse_Limit{
+			Name:      name,
+			Status:    status,
+			Requested: requested,
+			Effective: effective,
+			Note:      note,
+		})
+	}
+	honored := func(name string, requested uint64) {
+		add(name, requested, contester_proto.CheckLimitsResponse_HONORED, requested, "")
+	}
+	impossible := func(name string, requested uint64, note string) {
+		add(name, requested, contester_proto.CheckLimitsResponse_IMPOSSIBLE, 0, note)
+	}
+
+	capped := func(name string, v, max uint64) bool {
+		if max > 0 && v > max {
+			if s.Limits.Reject {
+				impossible(name, v, "exceeds the bound configured for this host, the run would fail")
+			} else {
+				add(name, v, contester_proto.CheckLimitsResponse_CLAMPED, max, "exceeds the bound configured for this host")
+			}
+			return true
+		}
+		return false
+	}
+
+	if v := p.GetMemoryLimit(); v > 0 && !capped("memory_limit", v, s.Limits.MaxMemoryLimit) {
+		if caps.PhysicalMemory > 0 && v > caps.PhysicalMemory {
+			add("memory_limit", v, contester_proto.CheckLimitsResponse_CLAMPED, caps.PhysicalMemory,
+				"exceeds physical memory, the process would be paging long before hitting the limit")
+		} else {
+			honored("memory_limit", v)
+		}
+	}
+	if p.GetMemoryAccounting() == contester_proto.LocalExecutionParameters_WORKING_SET {
+		if caps.WorkingSetMemory {
+			honored("memory_accounting", uint64(p.GetMemoryAccounting()))
+		} else {
+			impossible("memory_accounting", uint64(p.GetMemoryAccounting()), "working set accounting is Windows only")
+		}
+	}
+	for _, v := range []struct {
+		name  string
+		value uint64
+		max   uint64
+	}{
+		{"time_limit_micros", p.GetTimeLimitMicros(), s.Limits.MaxTimeLimitMicros},
+		{"kernel_time_limit_micros", p.GetKernelTimeLimitMicros(), 0},
+		{"wall_time_limit_micros", p.GetWallTimeLimitMicros(), s.Limits.MaxWallTimeLimitMicros},
+		{"virtual_memory_limit", p.GetVirtualMemoryLimit(), 0},
+		{"artifact_size_limit", p.GetArtifactSizeLimit(), 0},
+	} {
+		if v.value > 0 && !capped(v.name, v.value, v.max) {
+			honored(v.name, v.value)
+		}
+	}
+	if v := p.GetProcessLimit(); v > 0 {
+		// LocalExecute doesn't pass it on to the job.
+		impossible("process_limit", uint64(v), "not applied by this service")
+	}
+	if v := p.GetTotalProcessLimit(); v > 0 {
+		if PLATFORM_ID != "linux" && !p.GetNoJob() {
+			honored("total_process_limit", uint64(v))
+		} else {
+			impossible("total_process_limit", uint64(v), "needs a job object on Windows")
+		}
+	}
+	if v := p.GetCpuRatePercent(); v > 0 && v < 100 {
+		if caps.CpuRate {
+			honored("cpu_rate_percent", uint64(v))
+		} else {
+			impossible("cpu_rate_percent", uint64(v), "needs a job object on Windows 8 or later")
+		}
+	}
+	if p.GetPseudoConsole() {
+		if caps.PseudoConsole {
+			honored("pseudo_console", 1)
+		} else {
+			impossible("pseudo_console", 1, "needs Windows 10 1809 or later, plain redirects are used instead")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,28 @@
+package service
+
+import (
+	"golang.org/x/sys/unix"
+
+	log "github.com/sirupsen/logrus"
+)
+
+func getHostCapabilities(noJob bool) hostCapabilities {
+	var caps hostCapabilities
+	var info unix.Sysinfo_t
+	if err := unix.Sysinfo(&info); err == nil {
+		caps.PhysicalMemory = uint64(info.Totalram) * uint64(info.Unit)
+	} else {
+		log.Errorf("getHostCapabilities: sysinfo: %s", err)
+	}
+	var set unix.CPUSet
+	if err := unix.SchedGetaffinity(0, &set); err == nil {
+		for i := 0; i < 64; i++ {
+			if set.IsSet(i) {
+				caps.CpuMask |= 1 << i
+			}
+		}
+	} else {
+		log.Errorf("getHostCapabilities: sched_getaffinity: %s", err)
+	}
+	return caps
+}
@@ -0,0 +1,29 @@
+package service
+
+import (
+	"syscall"
+
+	"github.com/contester/runlib/win32"
+
+	log "github.com/sirupsen/logrus"
+)
+
+func getHostCapabilities(noJob bool) hostCapabilities {
+	caps := hostCapabilities{
+		CpuRate:          !noJob && win32.IsWindows8OrGreater(),
+		WorkingSetMemory: true,
+		PseudoConsole:    win32.HasPseudoConsole(),
+	}
+	if m, err := win32.GlobalMemoryStatusEx(); err == nil {
+		caps.PhysicalMemory = m.TotalPhys
+	} else {
+		log.Errorf("getHostCapabilities: %s", err)
+	}
+	// Runs inherit our affinity, so that is what they can get.
+	if mask, _, err := win32.GetProcessAffinityMask(syscall.Handle(^uintptr(0))); err == nil {
+		caps.CpuMask = mask
+	} else {
+		log.Errorf("getHostCapabilities: %s", err)
+	}
+	return caps
+}
@@ -0,0 +1,31 @@
+package service
+
+import (
+	"context"
+	"os/exec"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// Used when the config doesn't set PostRunTimeout.
+const defaultPostRunTimeout = 30 * time.Second
+
+// postRun runs the PostRun command of the config with the sandbox path appended, once a run in the sandbox is over,
+// however it ended. It's called deferred while the sandbox is still locked, so the next run finds it reset. The
+// command only restores host state, so failing or timing out is logged, and the run result stands.
+func (s *Contester) postRun(sandbox *Sandbox) {
+	if len(s.PostRun) == 0 {
+		return
+	}
+	timeout := s.PostRunTimeout
+	if timeout == 0 {
+		timeout = defaultPostRunTimeout
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	args := append(append([]string(nil), s.PostRun[1:]...), sandbox.Path)
+	if output, err := exec.CommandContext(ctx, s.PostRun[0], args...).CombinedOutput(); err != nil {
+		log.Errorf("Post-run command for %s: %s, output: %q", sandbox.Path, err, output)
+	}
+}
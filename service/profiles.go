@@ -0,0 +1,68 @@
+package service
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/contester/runlib/contester_proto"
+	"google.golang.org/protobuf/proto"
+)
+
+// profileConfig is a [profile "name"] section of the config: defaults for runs naming it in profile, like
+// tighter limits for a language. Flags can only be turned on by a profile, not off by the run. There is no process
+// limit, as LocalExecute doesn't apply process_limit; gcfg refuses a config setting one.
+type profileConfig struct {
+	TimeLimitMicros     uint64
+	WallTimeLimitMicros uint64
+	MemoryLimit         uint64
+	CheckIdleness       bool
+	// NAME=value, can be repeated. Makes the environment explicit, like environment of the request.
+	Env []string
+}
+
+func newProfile(name string, c *profileConfig) (*contester_proto.LocalExecutionParameters, error) {
+	p := &contester_proto.LocalExecutionParameters{
+		TimeLimitMicros:     c.TimeLimitMicros,
+		WallTimeLimitMicros: c.WallTimeLimitMicros,
+		MemoryLimit:         c.MemoryLimit,
+		CheckIdleness:       c.CheckIdleness,
+	}
+	if len(c.Env) > 0 {
+		p.Environment = &contester_proto.LocalEnvironment{}
+		for _, v := range c.Env {
+			key, value, ok := strings.Cut(v, "=")
+			if !ok {
+				return nil, fmt.Errorf("profile %q: env %q is not NAME=value", name, v)
+			}
+			p.Environment.Variable = append(p.Environment.Variable, &contester_proto.LocalEnvironment_Variable{Name: key, Value: value})
+		}
+	}
+	return p, nil
+}
+
+// withProfile returns the request on top of its profile: fields the request sets win, environment variables are
+// merged by name.
+func (s *Contester) withProfile(request *contester_proto.LocalExecutionParameters) (*contester_proto.LocalExecutionParameters, error) {
+	if request.GetProfile() == "" {
+		return request, nil
+	}
+	profile, ok := s.Profiles[request.GetProfile()]
+	if !ok {
+		return nil, fmt.Errorf("unknown profile %q", request.GetProfile())
+	}
+	result := proto.Clone(profile).(*contester_proto.LocalExecutionParameters)
+	proto.Merge(result, request)
+	if env := result.Environment; env != nil && profile.Environment != nil && request.Environment != nil {
+		// Merge appended the request's variables after the profile's: keep the last of each name.
+		seen := map[string]bool{}
+		var vars []*contester_proto.LocalEnvironment_Variable
+		for i := len(env.Variable) - 1; i >= 0; i-- {
+			if v := env.Variable[i]; !seen[v.GetName()] {
+				seen[v.GetName()] = true
+				vars = append([]*contester_proto.LocalEnvironment_Variable{v}, vars...)
+			}
+		}
+		env.Variable = vars
+	}
+	return result, nil
+}
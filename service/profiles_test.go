@@ -0,0 +1,79 @@
+package service
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/contester/runlib/contester_proto"
+)
+
+func envOf(vars ...string) *contester_proto.LocalEnvironment {
+	env := &contester_proto.LocalEnvironment{}
+	for i := 0; i+1 < len(vars); i += 2 {
+		env.Variable = append(env.Variable, &contester_proto.LocalEnvironment_Variable{Name: vars[i], Value: vars[i+1]})
+	}
+	return env
+}
+
+func envPairs(env *contester_proto.LocalEnvironment) []string {
+	var result []string
+	for _, v := range env.GetVariable() {
+		result = append(result, v.GetName()+"="+v.GetValue())
+	}
+	return result
+}
+
+func TestWithProfile(t *testing.T) {
+	java, err := newProfile("java", &profileConfig{
+		TimeLimitMicros: 2000000,
+		MemoryLimit:     256 << 20,
+		CheckIdleness:   true,
+		Env:             []string{"PATH=/opt/java/bin", "JAVA_OPTS=-Xss64m"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := &Contester{Profiles: map[string]*contester_proto.LocalExecutionParameters{"java": java}}
+
+	cases := []struct {
+		request *contester_proto.LocalExecutionParameters
+		time    uint64
+		memory  uint64
+		idle    bool
+		env     []string
+	}{
+		{&contester_proto.LocalExecutionParameters{TimeLimitMicros: 1000}, 1000, 0, false, nil},
+		{&contester_proto.LocalExecutionParameters{Profile: "java"}, 2000000, 256 << 20, true,
+			[]string{"PATH=/opt/java/bin", "JAVA_OPTS=-Xss64m"}},
+		{&contester_proto.LocalExecutionParameters{Profile: "java", TimeLimitMicros: 1000}, 1000, 256 << 20, true,
+			[]string{"PATH=/opt/java/bin", "JAVA_OPTS=-Xss64m"}},
+		{&contester_proto.LocalExecutionParameters{Profile: "java", MemoryLimit: 64 << 20, CheckIdleness: false}, 2000000, 64 << 20, true,
+			[]string{"PATH=/opt/java/bin", "JAVA_OPTS=-Xss64m"}},
+		{&contester_proto.LocalExecutionParameters{Profile: "java", Environment: envOf("PATH", "/usr/bin", "TZ", "UTC")}, 2000000, 256 << 20, true,
+			[]string{"JAVA_OPTS=-Xss64m", "PATH=/usr/bin", "TZ=UTC"}},
+	}
+	for _, c := range cases {
+		r, err := s.withProfile(c.request)
+		if err != nil {
+			t.Errorf("%v: %s", c.request, err)
+			continue
+		}
+		if r.GetTimeLimitMicros() != c.time || r.GetMemoryLimit() != c.memory || r.GetCheckIdleness() != c.idle {
+			t.Errorf("%v: got time %d, memory %d, idleness %v, want %d, %d, %v", c.request,
+				r.GetTimeLimitMicros(), r.GetMemoryLimit(), r.GetCheckIdleness(), c.time, c.memory, c.idle)
+		}
+		if env := envPairs(r.GetEnvironment()); !reflect.DeepEqual(env, c.env) {
+			t.Errorf("%v: got environment %q, want %q", c.request, env, c.env)
+		}
+	}
+	if len(java.GetEnvironment().GetVariable()) != 2 || java.GetTimeLimitMicros() != 2000000 {
+		t.Errorf("profile changed to %v", java)
+	}
+
+	if _, err := s.withProfile(&contester_proto.LocalExecutionParameters{Profile: "cobol"}); err == nil {
+		t.Error("unknown profile: no error")
+	}
+	if _, err := newProfile("bad", &profileConfig{Env: []string{"PATH"}}); err == nil {
+		t.Error("env without a value: no error")
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
@@ -92,3 +93,18 @@ func resolvePath(s []SandboxPair, source string, restricted bool) (string, *Sand
 
 	return source, nil, nil
 }
+
+// lockOrder lists each of sandboxes once, sorted by Path. Requests using several sandboxes lock them in this order, so
+// concurrent ones can't deadlock on them.
+func lockOrder(sandboxes ...*Sandbox) []*Sandbox {
+	seen := map[*Sandbox]bool{}
+	var ordered []*Sandbox
+	for _, sandbox := range sandboxes {
+		if !seen[sandbox] {
+			seen[sandbox] = true
+			ordered = append(ordered, sandbox)
+		}
+	}
+	sort.Slice(ordered, func(i, j int) bool { return ordered[i].Path < ordered[j].Path })
+	return ordered
+}
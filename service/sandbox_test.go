@@ -0,0 +1,33 @@
+package service
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestLockOrder(t *testing.T) {
+	a, b, c := &Sandbox{Path: "a"}, &Sandbox{Path: "b"}, &Sandbox{Path: "c"}
+	cases := []struct {
+		sandboxes []*Sandbox
+		want      []*Sandbox
+	}{
+		{[]*Sandbox{a}, []*Sandbox{a}},
+		{[]*Sandbox{a, a}, []*Sandbox{a}},
+		{[]*Sandbox{b, a}, []*Sandbox{a, b}},
+		{[]*Sandbox{a, b}, []*Sandbox{a, b}},
+		{[]*Sandbox{c, a, c, b, a}, []*Sandbox{a, b, c}},
+	}
+	for _, c := range cases {
+		if got := lockOrder(c.sandboxes...); !reflect.DeepEqual(got, c.want) {
+			t.Errorf("%v: got %v, want %v", paths(c.sandboxes), paths(got), paths(c.want))
+		}
+	}
+}
+
+func paths(sandboxes []*Sandbox) []string {
+	var r []string
+	for _, s := range sandboxes {
+		r = append(r, s.Path)
+	}
+	return r
+}
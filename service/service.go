@@ -1,12 +1,16 @@
 package service
 
 import (
+	"fmt"
 	"os"
 	"path/filepath"
 	"strconv"
 	"strings"
+	"time"
 
+	"github.com/contester/runlib/checker"
 	"github.com/contester/runlib/contester_proto"
+	"github.com/contester/runlib/diagnostics"
 	"github.com/contester/runlib/platform"
 	"github.com/contester/runlib/subprocess"
 	"gopkg.in/gcfg.v1"
@@ -26,6 +30,29 @@ type Contester struct {
 	ProgramFiles  []string
 
 	GData *platform.GlobalData
+
+	DiagnosticParsers map[string]*diagnostics.Parser
+	// Exit code conventions of checkers, by name.
+	CheckerConventions map[string]checker.ExitCodes
+	// Defaults for runs, by the name requests give in profile.
+	Profiles map[string]*contester_proto.LocalExecutionParameters
+	// Set if DynamicAffinity is on in the config.
+	Cores *coreAllocator
+
+	// Command run on a sandbox after each run in it, see postRun.
+	PostRun        []string
+	PostRunTimeout time.Duration
+
+	// Bounds on limits runs can ask for.
+	Limits hostLimits
+	// See subprocess.Subprocess.WatchdogTimeout.
+	WatchdogTimeout time.Duration
+	// See subprocess.Subprocess.ExitCodeClasses.
+	ExitCodeClasses map[uint32]subprocess.ExitCodeClass
+
+	drain   drain
+	audit   *auditLog
+	batches batches
 }
 
 func getHostname() string {
@@ -86,7 +113,7 @@ func configureSandboxes(config *contesterConfig) ([]SandboxPair, error) {
 			}
 		}
 
-		restrictedUser := "tester" + strconv.Itoa(index)
+		restrictedUser := runUserName(index)
 
 		e = setAcl(result[index].Run.Path, restrictedUser)
 		if e != nil {
@@ -101,6 +128,23 @@ func configureSandboxes(config *contesterConfig) ([]SandboxPair, error) {
 	return result, nil
 }
 
+func runUserName(index int) string {
+	return "tester" + strconv.Itoa(index)
+}
+
+// SandboxAccounts returns names of accounts run sandboxes from configFile use.
+func SandboxAccounts(configFile string) ([]string, error) {
+	var config contesterConfig
+	if err := gcfg.ReadFileInto(&config, configFile); err != nil {
+		return nil, err
+	}
+	result := make([]string, len(getPasswords(&config)))
+	for i := range result {
+		result[i] = runUserName(i)
+	}
+	return result, nil
+}
+
 func checkSandbox(path string) error {
 	err := os.MkdirAll(path, os.ModeDir|0755)
 	if err != nil {
@@ -113,7 +157,33 @@ type contesterConfig struct {
 	Default struct {
 		Server, Passwords, Path string
 		SandboxCount            int
+		// Share host cores between concurrent runs, instead of letting them all use every core.
+		DynamicAffinity bool
+		// Append a JSON line per finished run to this file.
+		AuditLog string
+		// Cleanup command run after each run with the sandbox path as the last argument, like deleting temporary
+		// files or resetting the profile, and its timeout in seconds.
+		PostRun        string
+		PostRunTimeout int
+		// Largest limits a run can get; requests above them are clamped, or fail with RejectOverLimits.
+		MaxMemoryLimit         uint64
+		MaxTimeLimitMicros     uint64
+		MaxWallTimeLimitMicros uint64
+		RejectOverLimits       bool
+		// Seconds without progress of the loop monitoring a run before the run is killed, 0 to disable.
+		WatchdogTimeout int
+		// Exit codes to count as the host's fault or the solution's, on top of the defaults, see
+		// subprocess.ParseExitCodeClasses.
+		ExitCodeClasses string
+	}
+	Diagnostics map[string]*struct {
+		Pattern string
 	}
+	Checker map[string]*struct {
+		// See checker.ParseExitCodes.
+		ExitCodes string
+	}
+	Profile map[string]*profileConfig
 }
 
 func NewContester(configFile string, gData *platform.GlobalData) (*Contester, error) {
@@ -134,6 +204,7 @@ func NewContester(configFile string, gData *platform.GlobalData) (*Contester, er
 		PathSeparator: string(os.PathSeparator),
 		GData:         gData,
 	}
+	result.drain.abort = make(chan struct{})
 
 	var err error
 	result.Sandboxes, err = configureSandboxes(&config)
@@ -141,6 +212,53 @@ func NewContester(configFile string, gData *platform.GlobalData) (*Contester, er
 		return nil, err
 	}
 
+	if config.Default.AuditLog != "" {
+		if result.audit, err = openAuditLog(config.Default.AuditLog); err != nil {
+			return nil, err
+		}
+	}
+	result.PostRun = strings.Fields(config.Default.PostRun)
+	result.PostRunTimeout = time.Duration(config.Default.PostRunTimeout) * time.Second
+	result.WatchdogTimeout = time.Duration(config.Default.WatchdogTimeout) * time.Second
+	if result.ExitCodeClasses, err = subprocess.ParseExitCodeClasses(config.Default.ExitCodeClasses); err != nil {
+		return nil, err
+	}
+	result.Limits = hostLimits{
+		MaxMemoryLimit:         config.Default.MaxMemoryLimit,
+		MaxTimeLimitMicros:     config.Default.MaxTimeLimitMicros,
+		MaxWallTimeLimitMicros: config.Default.MaxWallTimeLimitMicros,
+		Reject:                 config.Default.RejectOverLimits,
+	}
+	if config.Default.DynamicAffinity {
+		result.Cores = newCoreAllocator(getHostCapabilities(false).CpuMask)
+	}
+
+	result.DiagnosticParsers = diagnostics.Builtin()
+	for name, v := range config.Diagnostics {
+		if result.DiagnosticParsers[name], err = diagnostics.NewParser(name, v.Pattern); err != nil {
+			return nil, err
+		}
+	}
+
+	result.CheckerConventions = map[string]checker.ExitCodes{"testlib": checker.Testlib}
+	for name, v := range config.Checker {
+		if result.CheckerConventions[name], err = checker.ParseExitCodes(v.ExitCodes); err != nil {
+			return nil, fmt.Errorf("checker %q: %w", name, err)
+		}
+	}
+
+	result.Profiles = map[string]*contester_proto.LocalExecutionParameters{}
+	for name, v := range config.Profile {
+		if result.Profiles[name], err = newProfile(name, v); err != nil {
+			return nil, err
+		}
+		// Runs are bounded after the profile is applied, so a profile over the bounds would only get every run using
+		// it clamped, or rejected: refuse it here instead.
+		if over := result.Limits.over(result.Profiles[name]); len(over) > 0 {
+			return nil, fmt.Errorf("profile %q: limits over the host bounds: %v", name, over)
+		}
+	}
+
 	return &result, nil
 }
 
@@ -0,0 +1,55 @@
+package service
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+var ErrShuttingDown = errors.New("service is shutting down")
+
+// drain tracks runs in flight, so shutdown can wait for them.
+type drain struct {
+	mu       sync.Mutex
+	stopping bool
+	runs     sync.WaitGroup
+	// Closed when the drain timeout is over, see Subprocess.Abort.
+	abort     chan struct{}
+	abortOnce sync.Once
+}
+
+// begin registers a run, unless shutdown has started. Call runs.Done when it's over.
+func (d *drain) begin() error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.stopping {
+		return ErrShuttingDown
+	}
+	d.runs.Add(1)
+	return nil
+}
+
+// Shutdown makes new runs fail with ErrShuttingDown and waits for the ones in flight. Those still going after
+// timeout are stopped and return with the aborted flag, so the coordinator can requeue them.
+func (s *Contester) Shutdown(timeout time.Duration) {
+	s.drain.mu.Lock()
+	s.drain.stopping = true
+	s.drain.mu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		s.drain.runs.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return
+	case <-time.After(timeout):
+	}
+	log.Warningf("Shutdown: aborting runs still going after %s", timeout)
+	// Shutdown may be called again, say on a second signal, while runs are still aborting.
+	s.drain.abortOnce.Do(func() { close(s.drain.abort) })
+	<-done
+}
@@ -0,0 +1,21 @@
+package subprocess
+
+import (
+	log "github.com/sirupsen/logrus"
+)
+
+// pollAffinity applies the latest mask from AffinityUpdates, if there is one.
+func (sub *Subprocess) pollAffinity(p *PlatformData, result *SubprocessResult) {
+	select {
+	case mask := <-sub.AffinityUpdates:
+		if sub.Deterministic {
+			mask = lastCpu(mask)
+		}
+		if err := p.setAffinity(mask); err != nil {
+			log.Warningf("setAffinity(b%b): %s", mask, err)
+			return
+		}
+		result.AffinityMasks = append(result.AffinityMasks, mask)
+	default:
+	}
+}
@@ -0,0 +1,35 @@
+package subprocess
+
+import (
+	"os"
+	"strconv"
+
+	"golang.org/x/sys/unix"
+)
+
+func cpuSet(mask uint64) *unix.CPUSet {
+	var set unix.CPUSet
+	for cpu := 0; cpu < 64; cpu++ {
+		if mask&(1<<cpu) != 0 {
+			set.Set(cpu)
+		}
+	}
+	return &set
+}
+
+// setAffinity changes affinity of all threads of the process. Its children keep theirs.
+func (p *PlatformData) setAffinity(mask uint64) error {
+	set := cpuSet(mask)
+	tasks, err := os.ReadDir("/proc/" + strconv.Itoa(p.Pid) + "/task")
+	if err != nil {
+		return unix.SchedSetaffinity(p.Pid, set)
+	}
+	for _, t := range tasks {
+		if tid, err := strconv.Atoi(t.Name()); err == nil {
+			if err = unix.SchedSetaffinity(tid, set); err != nil && err != unix.ESRCH {
+				return err
+			}
+		}
+	}
+	return nil
+}
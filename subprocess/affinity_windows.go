@@ -0,0 +1,21 @@
+package subprocess
+
+import (
+	"syscall"
+
+	"github.com/contester/runlib/win32"
+)
+
+// setAffinity changes affinity of the whole job, or of the process if there's no job.
+func (p *PlatformData) setAffinity(mask uint64) error {
+	if p.hJob == syscall.InvalidHandle || p.hJob == 0 {
+		return win32.SetProcessAffinityMask(p.hProcess, mask)
+	}
+	einfo, err := win32.GetJobObjectExtendedLimitInformation(p.hJob)
+	if err != nil {
+		return err
+	}
+	einfo.BasicLimitInformation.Affinity = uintptr(mask)
+	einfo.BasicLimitInformation.LimitFlags |= win32.JOB_OBJECT_LIMIT_AFFINITY
+	return win32.SetJobObjectExtendedLimitInformation(p.hJob, einfo)
+}
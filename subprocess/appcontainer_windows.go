@@ -0,0 +1,77 @@
+package subprocess
+
+import (
+	"fmt"
+	"unsafe"
+
+	"github.com/contester/runlib/win32"
+	"golang.org/x/sys/windows"
+)
+
+// Well-known capabilities by their manifest names, for PlatformOptions.AppContainerCapabilities. Most runtimes need
+// none of them: stdio, the current directory and files readable to ALL APPLICATION PACKAGES (system directories,
+// Program Files, most of HKLM) work without capabilities. Runtimes installed elsewhere need read access granted to
+// ALL APPLICATION PACKAGES (S-1-15-2-1) on their directory, which is an ACL, not a capability.
+// Interactors over TCP need internetClientServer or privateNetworkClientServer; connecting to localhost also needs
+// a loopback exemption for the container (CheckNetIsolation LoopbackExempt -a -n=<name>).
+var AppContainerCapabilities = map[string]string{
+	"internetClient":             "S-1-15-3-1",
+	"internetClientServer":       "S-1-15-3-2",
+	"privateNetworkClientServer": "S-1-15-3-3",
+}
+
+func capabilitySids(caps []string) ([]windows.SIDAndAttributes, error) {
+	var result []windows.SIDAndAttributes
+	for _, c := range caps {
+		if s, ok := AppContainerCapabilities[c]; ok {
+			c = s
+		}
+		sid, err := windows.StringToSid(c)
+		if err != nil {
+			return nil, fmt.Errorf("capability %q: %w", c, err)
+		}
+		result = append(result, windows.SIDAndAttributes{Sid: sid, Attributes: windows.SE_GROUP_ENABLED})
+	}
+	return result, nil
+}
+
+// attachAppContainer sets up attrs to start the process in the AppContainer from options, and gives the container
+// full access to the current directory. The returned SID must be freed with FreeSid once the process is created.
+func (d *SubprocessData) attachAppContainer(sub *Subprocess, attrs *windows.ProcThreadAttributeListContainer) (*windows.SID, error) {
+	caps, err := capabilitySids(sub.Options.AppContainerCapabilities)
+	if err != nil {
+		return nil, err
+	}
+	sid, err := win32.CreateAppContainerProfile(sub.Options.AppContainer)
+	if err != nil {
+		return nil, err
+	}
+	if sub.CurrentDirectory != "" {
+		if err = grantSidAccess(sub.CurrentDirectory, windows.SE_FILE_OBJECT, sid, windows.GENERIC_ALL, windows.SUB_CONTAINERS_AND_OBJECTS_INHERIT); err != nil {
+			windows.FreeSid(sid)
+			return nil, err
+		}
+	}
+	if sub.Options.DenyRegistry {
+		for _, key := range sub.Options.RegistryAllowlist {
+			if err = grantSidAccess(key, windows.SE_REGISTRY_KEY, sid, windows.KEY_READ, windows.SUB_CONTAINERS_ONLY_INHERIT); err != nil {
+				windows.FreeSid(sid)
+				return nil, err
+			}
+		}
+		policy := uint32(win32.PROCESS_CREATION_ALL_APPLICATION_PACKAGES_OPT_OUT)
+		if err = attrs.Update(win32.PROC_THREAD_ATTRIBUTE_ALL_APPLICATION_PACKAGES_POLICY, unsafe.Pointer(&policy), unsafe.Sizeof(policy)); err != nil {
+			windows.FreeSid(sid)
+			return nil, fmt.Errorf("UpdateProcThreadAttribute: %w", err)
+		}
+	}
+	sc := &win32.SecurityCapabilities{AppContainerSid: sid, CapabilityCount: uint32(len(caps))}
+	if len(caps) > 0 {
+		sc.Capabilities = &caps[0]
+	}
+	if err = attrs.Update(win32.PROC_THREAD_ATTRIBUTE_SECURITY_CAPABILITIES, unsafe.Pointer(sc), unsafe.Sizeof(*sc)); err != nil {
+		windows.FreeSid(sid)
+		return nil, fmt.Errorf("UpdateProcThreadAttribute: %w", err)
+	}
+	return sid, nil
+}
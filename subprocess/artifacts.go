@@ -0,0 +1,40 @@
+package subprocess
+
+import (
+	"io/fs"
+	"path/filepath"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// directorySize returns total size of regular files under dir. Files which disappear during the walk (compiler
+// temporaries) are skipped.
+func directorySize(dir string) (uint64, error) {
+	var total uint64
+	err := filepath.WalkDir(dir, func(path string, e fs.DirEntry, err error) error {
+		if err != nil {
+			if path == dir {
+				return err
+			}
+			return nil
+		}
+		if !e.Type().IsRegular() {
+			return nil
+		}
+		if info, err := e.Info(); err == nil {
+			total += uint64(info.Size())
+		}
+		return nil
+	})
+	return total, err
+}
+
+func (sub *Subprocess) updateArtifactSize(result *SubprocessResult) uint64 {
+	size, err := directorySize(sub.CurrentDirectory)
+	if err != nil {
+		log.Errorf("Error getting artifact size: %s", err)
+		return result.ArtifactSize
+	}
+	result.ArtifactSize = size
+	return size
+}
@@ -0,0 +1,36 @@
+package subprocess
+
+import (
+	"bytes"
+	"sync"
+)
+
+// outputBuffers keeps memory of captured stdout and stderr for reuse by later runs, see SubprocessResult.Release.
+var outputBuffers = sync.Pool{New: func() any { return new(bytes.Buffer) }}
+
+// Buffers which grew larger than this aren't pooled, so one huge output doesn't stay around for good.
+const maxPooledBuffer = 4 * MAX_MEM_OUTPUT
+
+func getOutputBuffer() *bytes.Buffer {
+	b := outputBuffers.Get().(*bytes.Buffer)
+	b.Reset()
+	return b
+}
+
+func putOutputBuffer(b *bytes.Buffer) {
+	if b == nil || b.Cap() > maxPooledBuffer {
+		return
+	}
+	b.Reset()
+	outputBuffers.Put(b)
+}
+
+// Release hands memory of Output and Error back for reuse by later runs, and sets them to nil. They (and anything
+// sliced from them) must not be used afterwards: the next run overwrites them. Copy out what is needed before
+// calling it. Not calling it is fine, the memory is then collected as usual.
+func (r *SubprocessResult) Release() {
+	for _, b := range r.buffers {
+		putOutputBuffer(b)
+	}
+	r.buffers, r.Output, r.Error = nil, nil, nil
+}
@@ -0,0 +1,82 @@
+package subprocess
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"time"
+)
+
+// OutputChunk is a single read of the process output.
+type OutputChunk struct {
+	Offset time.Duration // since run start
+	Size   int
+}
+
+// chunkTimeline relays the child output to the original destination, recording when each chunk arrived.
+type chunkTimeline struct {
+	max    int
+	chunks []OutputChunk
+}
+
+// relayOutput puts a pipe between the child and dst, showing each read to observe. dst must be owned by us (pending
+// in closeAfterStart), since it's closed once the child closes its end; otherwise dst is returned as is, with false.
+func (d *SubprocessData) relayOutput(dst *os.File, name string, observe func([]byte)) (*os.File, bool, error) {
+	n := len(d.closeAfterStart)
+	if n == 0 || d.closeAfterStart[n-1] != dst {
+		return dst, false, nil
+	}
+
+	reader, writer, e := os.Pipe()
+	if e != nil {
+		return nil, false, fmt.Errorf("%s: os.Pipe: %w", name, e)
+	}
+	d.closeAfterStart[n-1] = writer
+
+	d.startAfterStart = append(d.startAfterStart, func() error {
+		defer dst.Close()
+		defer reader.Close()
+		buf := make([]byte, 32*1024)
+		for {
+			nr, err := reader.Read(buf)
+			if nr > 0 {
+				observe(buf[:nr])
+				if _, werr := dst.Write(buf[:nr]); werr != nil {
+					return werr
+				}
+			}
+			if err == io.EOF {
+				return nil
+			}
+			if err != nil {
+				return err
+			}
+		}
+	})
+	d.cleanupIfFailed = append(d.cleanupIfFailed, func() {
+		io.Copy(dst, reader)
+		reader.Close()
+		dst.Close()
+	})
+	return writer, true, nil
+}
+
+func (d *SubprocessData) recordChunks(dst *os.File, maxChunks int) (*os.File, *chunkTimeline, error) {
+	t := &chunkTimeline{max: maxChunks}
+	f, ok, err := d.relayOutput(dst, "recordChunks", func(p []byte) {
+		if len(t.chunks) < t.max {
+			t.chunks = append(t.chunks, OutputChunk{Offset: time.Since(d.runStart), Size: len(p)})
+		}
+	})
+	if !ok {
+		t = nil
+	}
+	return f, t, err
+}
+
+func (t *chunkTimeline) Chunks() []OutputChunk {
+	if t == nil {
+		return nil
+	}
+	return t.chunks
+}
@@ -0,0 +1,291 @@
+package subprocess
+
+import (
+	"bufio"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+)
+
+// RunCommunication protocol. Processes talk to the bus over their stdout and stdin, integers are little-endian
+// uint32:
+//
+//	send:    'S' destination length payload
+//	receive: 'R' source (ANY_SOURCE for any)
+//
+// A receive is answered on stdin with: source length payload. Processes are numbered by their position in subs.
+const ANY_SOURCE = 0xFFFFFFFF
+
+// Switch decides what happens to each message sent. Returning false drops it.
+type Switch interface {
+	Route(from, to int, data []byte) bool
+}
+
+type CommunicationResult struct {
+	Results []*SubprocessResult
+	// Messages[from][to] is the number of messages routed from one process to another.
+	Messages [][]int64
+	// Every process still talking was waiting to receive, so all were aborted.
+	Deadlock bool
+	// Malformed frames; the bus stops reading the offending process.
+	ProtocolErrors []string
+}
+
+const notWaiting = -2
+
+type busEvent struct {
+	from int
+	// 'S', 'R', or 0 when the process stdout is closed.
+	kind byte
+	peer int
+	data []byte
+	err  error
+}
+
+type busMessage struct {
+	from int
+	data []byte
+}
+
+type busProcess struct {
+	stdin   chan []byte
+	queue   []busMessage
+	waitFor int
+	open    bool
+}
+
+// tryDeliver answers a pending receive with the first queued message it accepts.
+func (p *busProcess) tryDeliver() {
+	if p.waitFor == notWaiting {
+		return
+	}
+	for k, m := range p.queue {
+		if p.waitFor >= 0 && m.from != p.waitFor {
+			continue
+		}
+		p.queue = append(p.queue[:k], p.queue[k+1:]...)
+		frame := binary.LittleEndian.AppendUint32(nil, uint32(m.from))
+		frame = binary.LittleEndian.AppendUint32(frame, uint32(len(m.data)))
+		p.stdin <- append(frame, m.data...)
+		p.waitFor = notWaiting
+		return
+	}
+}
+
+func readFrames(from int, r io.Reader, count, maxMessageSize int, events chan<- busEvent, quit <-chan struct{}) {
+	send := func(e busEvent) bool {
+		select {
+		case events <- e:
+			return true
+		case <-quit:
+			return false
+		}
+	}
+	br := bufio.NewReader(r)
+	var word [4]byte
+	readWord := func() (uint32, error) {
+		if _, err := io.ReadFull(br, word[:]); err != nil {
+			return 0, err
+		}
+		return binary.LittleEndian.Uint32(word[:]), nil
+	}
+	for {
+		e := busEvent{from: from}
+		kind, err := br.ReadByte()
+		if err != nil {
+			send(e)
+			return
+		}
+		peer, err := readWord()
+		if err == nil && kind == 'S' {
+			var size uint32
+			if size, err = readWord(); err == nil {
+				if int64(size) > int64(maxMessageSize) {
+					err = fmt.Errorf("message of %d bytes, limit is %d", size, maxMessageSize)
+				} else {
+					e.data = make([]byte, size)
+					_, err = io.ReadFull(br, e.data)
+				}
+			}
+		}
+		switch {
+		case err != nil:
+			if err == io.EOF {
+				err = io.ErrUnexpectedEOF
+			}
+			e.err = err
+		case kind != 'S' && kind != 'R':
+			e.err = fmt.Errorf("unknown frame kind %q", kind)
+		case kind == 'R' && peer == ANY_SOURCE:
+			e.kind, e.peer = kind, -1
+		case peer >= uint32(count):
+			e.err = fmt.Errorf("no process %d", peer)
+		default:
+			e.kind, e.peer = kind, int(peer)
+		}
+		if !send(e) || e.err != nil {
+			return
+		}
+	}
+}
+
+func writeFrames(w *os.File, frames <-chan []byte) {
+	defer w.Close()
+	for f := range frames {
+		if _, err := w.Write(f); err != nil {
+			break
+		}
+	}
+	for range frames {
+	}
+}
+
+// RunCommunication runs subs together, routing messages between them through sw (nil delivers everything). Their
+// stdin and stdout are taken over by the bus, other settings and limits apply to each process as usual; Abort of
+// any of them aborts all.
+func RunCommunication(subs []*Subprocess, sw Switch, maxMessageSize int) (*CommunicationResult, error) {
+	n := len(subs)
+	result := &CommunicationResult{
+		Results:  make([]*SubprocessResult, n),
+		Messages: make([][]int64, n),
+	}
+
+	var childFiles, ourReaders []*os.File
+	closeAll := func(files []*os.File) {
+		for _, f := range files {
+			f.Close()
+		}
+	}
+	procs := make([]busProcess, n)
+	for i := range subs {
+		inR, inW, err := hackPipe()
+		if err != nil {
+			closeAll(childFiles)
+			closeAll(ourReaders)
+			return nil, fmt.Errorf("RunCommunication: stdin pipe: %w", err)
+		}
+		outR, outW, err := hackPipe()
+		if err != nil {
+			inR.Close()
+			inW.Close()
+			closeAll(childFiles)
+			closeAll(ourReaders)
+			return nil, fmt.Errorf("RunCommunication: stdout pipe: %w", err)
+		}
+		childFiles = append(childFiles, inR, outW)
+		ourReaders = append(ourReaders, outR)
+		procs[i] = busProcess{stdin: make(chan []byte, 1), waitFor: notWaiting, open: true}
+		go writeFrames(inW, procs[i].stdin)
+		result.Messages[i] = make([]int64, n)
+	}
+
+	aborts := make([]chan struct{}, n)
+	var abortOnce sync.Once
+	abortAll := func() {
+		abortOnce.Do(func() {
+			for _, c := range aborts {
+				close(c)
+			}
+		})
+	}
+
+	events := make(chan busEvent)
+	quit := make(chan struct{})
+	for i := range subs {
+		go readFrames(i, ourReaders[i], n, maxMessageSize, events, quit)
+	}
+
+	type execResult struct {
+		i   int
+		err error
+	}
+	executed := make(chan execResult, n)
+	for i, sub := range subs {
+		aborts[i] = make(chan struct{})
+		if sub.Abort != nil {
+			// Set by the caller: stops the whole group.
+			go func(abort <-chan struct{}) {
+				select {
+				case <-abort:
+					abortAll()
+				case <-quit:
+				}
+			}(sub.Abort)
+		}
+		sub.Abort = aborts[i]
+		sub.StdIn = &Redirect{Mode: REDIRECT_PIPE, Pipe: childFiles[2*i]}
+		sub.StdOut = &Redirect{Mode: REDIRECT_PIPE, Pipe: childFiles[2*i+1]}
+	}
+	for i, sub := range subs {
+		go func(i int, sub *Subprocess) {
+			r, err := sub.Execute()
+			// Normally closed after start already; if the process never started, this is what ends its stdout.
+			childFiles[2*i].Close()
+			childFiles[2*i+1].Close()
+			result.Results[i] = r
+			executed <- execResult{i, err}
+		}(i, sub)
+	}
+
+	deadlocked := func() bool {
+		talking := 0
+		for i := range procs {
+			if procs[i].open {
+				if procs[i].waitFor == notWaiting {
+					return false
+				}
+				talking++
+			}
+		}
+		return talking > 0
+	}
+
+	var firstErr error
+	open, running := n, n
+	for running > 0 {
+		select {
+		case e := <-events:
+			switch {
+			case e.err != nil:
+				result.ProtocolErrors = append(result.ProtocolErrors, fmt.Sprintf("process %d: %s", e.from, e.err))
+				ourReaders[e.from].Close()
+				fallthrough
+			case e.kind == 0:
+				procs[e.from].open = false
+				open--
+			case e.kind == 'S':
+				if sw == nil || sw.Route(e.from, e.peer, e.data) {
+					result.Messages[e.from][e.peer]++
+					procs[e.peer].queue = append(procs[e.peer].queue, busMessage{e.from, e.data})
+					procs[e.peer].tryDeliver()
+				}
+			case e.kind == 'R':
+				procs[e.from].waitFor = e.peer
+				procs[e.from].tryDeliver()
+			}
+			if open > 0 && !result.Deadlock && deadlocked() {
+				result.Deadlock = true
+				abortAll()
+			}
+		case r := <-executed:
+			running--
+			if r.err != nil {
+				if firstErr == nil {
+					firstErr = r.err
+				}
+				abortAll()
+			}
+		}
+	}
+	close(quit)
+	closeAll(ourReaders)
+	for i := range procs {
+		close(procs[i].stdin)
+	}
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	return result, nil
+}
@@ -0,0 +1,116 @@
+package subprocess
+
+import (
+	"bytes"
+	"encoding/binary"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// frame builds a bus frame: kind, then each of words, then payload.
+func frame(kind byte, payload string, words ...uint32) []byte {
+	f := []byte{kind}
+	for _, w := range words {
+		f = binary.LittleEndian.AppendUint32(f, w)
+	}
+	return append(f, payload...)
+}
+
+// reply builds what a receiving process reads on stdin.
+func reply(from uint32, payload string) []byte {
+	f := binary.LittleEndian.AppendUint32(nil, from)
+	f = binary.LittleEndian.AppendUint32(f, uint32(len(payload)))
+	return append(f, payload...)
+}
+
+func TestReadFrames(t *testing.T) {
+	type event struct {
+		kind byte
+		peer int
+		data string
+		err  string
+	}
+	cases := []struct {
+		name  string
+		input []byte
+		want  []event
+	}{
+		{"send and receive", append(frame('S', "hi", 1, 2), frame('R', "", ANY_SOURCE)...),
+			[]event{{'S', 1, "hi", ""}, {'R', -1, "", ""}, {}}},
+		{"receive from peer", frame('R', "", 1), []event{{'R', 1, "", ""}, {}}},
+		{"empty message", frame('S', "", 0, 0), []event{{'S', 0, "", ""}, {}}},
+		{"unknown frame kind", frame('X', "", 0), []event{{err: "unknown frame kind"}}},
+		{"oversize frame", frame('S', "hello", 0, 5), []event{{err: "message of 5 bytes, limit is 4"}}},
+		{"bad peer on send", frame('S', "hi", 2, 2), []event{{err: "no process 2"}}},
+		{"bad peer on receive", frame('R', "", 7), []event{{err: "no process 7"}}},
+		{"truncated header", frame('S', "", 1)[:3], []event{{err: "unexpected EOF"}}},
+		{"truncated size", frame('S', "", 1), []event{{err: "unexpected EOF"}}},
+		{"truncated body", frame('S', "hi", 1, 4), []event{{err: "unexpected EOF"}}},
+		{"stops after an error", append(frame('X', "", 0), frame('R', "", 1)...), []event{{err: "unknown frame kind"}}},
+	}
+	for _, c := range cases {
+		events := make(chan busEvent, 16)
+		readFrames(3, bytes.NewReader(c.input), 2, 4, events, nil)
+		close(events)
+		var got []event
+		for e := range events {
+			if e.from != 3 {
+				t.Errorf("%s: event from %d, want 3", c.name, e.from)
+			}
+			g := event{kind: e.kind, peer: e.peer, data: string(e.data)}
+			if e.err != nil {
+				g = event{err: e.err.Error()}
+			}
+			got = append(got, g)
+		}
+		if len(got) != len(c.want) {
+			t.Errorf("%s: got %+v, want %+v", c.name, got, c.want)
+			continue
+		}
+		for i := range got {
+			if c.want[i].err != "" && strings.Contains(got[i].err, c.want[i].err) {
+				continue
+			}
+			if got[i] != c.want[i] {
+				t.Errorf("%s: event %d: got %+v, want %+v", c.name, i, got[i], c.want[i])
+			}
+		}
+	}
+}
+
+func TestTryDeliver(t *testing.T) {
+	queue := []busMessage{{1, []byte("a")}, {2, []byte("b")}, {1, []byte("c")}}
+	cases := []struct {
+		name      string
+		waitFor   int
+		queue     []busMessage
+		delivered []byte
+		rest      []busMessage
+		waiting   int
+	}{
+		{"not waiting", notWaiting, queue, nil, queue, notWaiting},
+		{"any source", -1, queue, reply(1, "a"), queue[1:], notWaiting},
+		{"skips other sources", 2, queue, reply(2, "b"), []busMessage{queue[0], queue[2]}, notWaiting},
+		{"nothing from source", 0, queue, nil, queue, 0},
+		{"empty queue", -1, nil, nil, nil, -1},
+	}
+	for _, c := range cases {
+		p := busProcess{stdin: make(chan []byte, 1), waitFor: c.waitFor, queue: append([]busMessage(nil), c.queue...)}
+		p.tryDeliver()
+		var delivered []byte
+		select {
+		case delivered = <-p.stdin:
+		default:
+		}
+		if !bytes.Equal(delivered, c.delivered) {
+			t.Errorf("%s: delivered %q, want %q", c.name, delivered, c.delivered)
+		}
+		if !reflect.DeepEqual(p.queue, c.rest) {
+			t.Errorf("%s: queue %v, want %v", c.name, p.queue, c.rest)
+		}
+		if p.waitFor != c.waiting {
+			t.Errorf("%s: waiting for %d, want %d", c.name, p.waitFor, c.waiting)
+		}
+	}
+}
@@ -0,0 +1,11 @@
+package subprocess
+
+// lastCpu keeps only the highest CPU of the mask. CPU 0 usually gets more interrupts, so the last one is quieter.
+func lastCpu(mask uint64) uint64 {
+	for bit := uint64(1) << 63; bit != 0; bit >>= 1 {
+		if mask&bit != 0 {
+			return bit
+		}
+	}
+	return 0
+}
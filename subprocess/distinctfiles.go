@@ -0,0 +1,32 @@
+package subprocess
+
+import "time"
+
+// distinctFiles keeps the set of files the process was seen opening over the run, see Subprocess.CountDistinctFiles.
+type distinctFiles struct {
+	seen map[string]struct{}
+	// Windows: when the audit log was last read.
+	polled time.Time
+}
+
+func newDistinctFiles(sub *Subprocess) *distinctFiles {
+	if !sub.CountDistinctFiles && sub.DistinctFileLimit == 0 {
+		return nil
+	}
+	return &distinctFiles{seen: make(map[string]struct{})}
+}
+
+// observe adds names to the set, and tells if it's over the limit.
+func (c *distinctFiles) observe(sub *Subprocess, names []string) bool {
+	for _, name := range names {
+		c.seen[name] = struct{}{}
+	}
+	return sub.DistinctFileLimit > 0 && uint32(len(c.seen)) > sub.DistinctFileLimit
+}
+
+func (c *distinctFiles) setResult(result *SubprocessResult) {
+	if c == nil {
+		return
+	}
+	result.DistinctFileCount = uint32(len(c.seen))
+}
@@ -0,0 +1,28 @@
+package subprocess
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// Update adds the files the process has open now, and tells if that's over DistinctFileLimit. Descriptors which
+// aren't files on disk (pipes, sockets) don't count.
+func (c *distinctFiles) Update(sub *Subprocess, pid int) bool {
+	if c == nil {
+		return false
+	}
+	dir := "/proc/" + strconv.Itoa(pid) + "/fd"
+	fds, err := os.ReadDir(dir)
+	if err != nil {
+		return false
+	}
+	var names []string
+	for _, fd := range fds {
+		if target, err := os.Readlink(filepath.Join(dir, fd.Name())); err == nil && strings.HasPrefix(target, "/") {
+			names = append(names, target)
+		}
+	}
+	return c.observe(sub, names)
+}
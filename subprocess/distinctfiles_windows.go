@@ -0,0 +1,45 @@
+package subprocess
+
+import (
+	"fmt"
+	"syscall"
+	"time"
+
+	"github.com/contester/runlib/win32"
+	"golang.org/x/sys/windows"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// Update adds the files the process asked to open since the last call, from the audit log, and tells if that's
+// over DistinctFileLimit.
+func (c *distinctFiles) Update(sub *Subprocess, hProcess syscall.Handle, started time.Time) bool {
+	if c == nil {
+		return false
+	}
+	since := c.polled
+	if since.IsZero() {
+		since = started
+	}
+	now := time.Now()
+	pid, _ := windows.GetProcessId(windows.Handle(hProcess))
+	// Events can land in the log a bit after they're timestamped; the overlap is deduplicated anyway.
+	names, err := fileOpens(pid, since.Add(-time.Second))
+	if err != nil {
+		log.Debugf("Error reading file audit events: %s", err)
+		return false
+	}
+	c.polled = now
+	return c.observe(sub, names)
+}
+
+// fileOpens returns names of files the process asked to open since the given time, granted or not (event 4656 in
+// the Security log). Windows only logs them with auditing of the file system enabled (auditpol /set
+// /subcategory:"File System" /success:enable /failure:enable) and a SACL on the files, like one set on the sandbox
+// and system directories.
+func fileOpens(pid uint32, since time.Time) ([]string, error) {
+	query := fmt.Sprintf("*[System[EventID=4656 and TimeCreated[@SystemTime>='%s']]"+
+		" and EventData[Data[@Name='ObjectType']='File' and Data[@Name='ProcessId']='%#x']]",
+		since.UTC().Format("2006-01-02T15:04:05.000Z"), pid)
+	return win32.EventStrings("Security", query, "Event/EventData/Data[@Name='ObjectName']")
+}
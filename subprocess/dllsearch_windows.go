@@ -0,0 +1,88 @@
+package subprocess
+
+import (
+	"fmt"
+	"path/filepath"
+	"syscall"
+
+	"github.com/contester/runlib/win32"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// restrictDllSearch changes DLL search order of the frozen process, so libraries aren't loaded
+// from its current directory. With no DllDirectories, current directory is just removed from
+// the search order; otherwise only application directory, system directories and DllDirectories
+// are searched.
+// Static imports are resolved by the loader when the first thread (our remote one) starts, with
+// the system's safe search order, so it only affects libraries loaded at runtime.
+func restrictDllSearch(d *SubprocessData, sub *Subprocess) error {
+	process := d.platformData.hProcess
+	same, err := isSameBitness(process)
+	if err != nil {
+		return err
+	}
+	if !same {
+		// kernel32 addresses from this process are meaningless there.
+		if len(sub.Options.DllDirectories) != 0 {
+			return fmt.Errorf("%w: DLL directories aren't supported for processes of different bitness", ErrUserError)
+		}
+		log.Warningf("restrictDllSearch: not restricting DLL search for %q of different bitness", sub.Cmd.ApplicationName)
+		return nil
+	}
+
+	if len(sub.Options.DllDirectories) == 0 {
+		return callRemoteWithString(process, "SetDllDirectoryW", "")
+	}
+
+	setDefault, err := win32.GetKernel32ProcAddress("SetDefaultDllDirectories")
+	if err != nil {
+		return err
+	}
+	if r, err := callRemote(process, setDefault, win32.LOAD_LIBRARY_SEARCH_DEFAULT_DIRS); err != nil || r == 0 {
+		return fmt.Errorf("SetDefaultDllDirectories: %d, %w", r, err)
+	}
+	for _, dir := range sub.Options.DllDirectories {
+		if !filepath.IsAbs(dir) {
+			dir = filepath.Join(sub.CurrentDirectory, dir)
+		}
+		if err = callRemoteWithString(process, "AddDllDirectory", dir); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func isSameBitness(process syscall.Handle) (bool, error) {
+	remote, err := win32.IsWow64Process(process)
+	if err != nil {
+		return false, err
+	}
+	local, err := win32.IsWow64Process(syscall.Handle(^uintptr(0)))
+	if err != nil {
+		return false, err
+	}
+	return remote == local, nil
+}
+
+// callRemoteWithString calls kernel32 function with the string argument, and fails if it returns zero.
+func callRemoteWithString(process syscall.Handle, name, arg string) error {
+	fn, err := win32.GetKernel32ProcAddress(name)
+	if err != nil {
+		return err
+	}
+	remoteArg, err := writeRemoteString(process, arg)
+	if err != nil {
+		return err
+	}
+	defer win32.VirtualFreeEx(process, remoteArg, 0, win32.MEM_RELEASE)
+
+	r, err := callRemote(process, fn, remoteArg)
+	if err != nil {
+		return fmt.Errorf("%s(%q): %w", name, arg, err)
+	}
+	if r == 0 {
+		return fmt.Errorf("%s(%q) failed in remote process", name, arg)
+	}
+	return nil
+}
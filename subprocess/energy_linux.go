@@ -0,0 +1,48 @@
+package subprocess
+
+import (
+	"os"
+	"strconv"
+	"strings"
+)
+
+const raplPackagePath = "/sys/class/powercap/intel-rapl:0/"
+
+// energyCounter measures package energy with RAPL.
+type energyCounter struct {
+	start, maxRange uint64
+}
+
+func readRaplValue(name string) (uint64, bool) {
+	data, err := os.ReadFile(raplPackagePath + name)
+	if err != nil {
+		return 0, false
+	}
+	v, err := strconv.ParseUint(strings.TrimSpace(string(data)), 10, 64)
+	return v, err == nil
+}
+
+// startEnergyCounter returns nil if RAPL isn't available.
+func startEnergyCounter() *energyCounter {
+	start, ok := readRaplValue("energy_uj")
+	if !ok {
+		return nil
+	}
+	maxRange, _ := readRaplValue("max_energy_range_uj")
+	return &energyCounter{start: start, maxRange: maxRange}
+}
+
+func (e *energyCounter) Stop() uint64 {
+	if e == nil {
+		return 0
+	}
+	end, ok := readRaplValue("energy_uj")
+	if !ok {
+		return 0
+	}
+	if end < e.start {
+		// Counter wrapped around.
+		return e.maxRange - e.start + end
+	}
+	return end - e.start
+}
@@ -0,0 +1,47 @@
+package subprocess
+
+import (
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// Pause before running again, so a transient condition has a chance to pass.
+const environmentRetryDelay = 250 * time.Millisecond
+
+// canRepeat tells if the run can be done again as it was: its stdio doesn't come from, or go to, a peer which was
+// already consumed by the first attempt.
+func (sub *Subprocess) canRepeat() bool {
+	for _, r := range []*Redirect{sub.StdIn, sub.StdOut, sub.StdErr} {
+		if r == nil {
+			continue
+		}
+		switch r.Mode {
+		case REDIRECT_PIPE, REDIRECT_SOCKET, REDIRECT_REMOTE, REDIRECT_NAMED_PIPE:
+			return false
+		}
+	}
+	return true
+}
+
+// retryEnvironmentError repeats the run while it ends with an environment error, up to RetryOnEnvironmentError
+// times. Runs talking to an interactor, or aborted, aren't repeated. The last result is returned, noting the
+// retries and the first error.
+func (sub *Subprocess) retryEnvironmentError(result *SubprocessResult, err error) (*SubprocessResult, error) {
+	if err != nil || !result.EnvironmentError || !sub.canRepeat() {
+		return result, err
+	}
+	first := result.Reason
+	var retries uint32
+	for err == nil && result.EnvironmentError && retries < sub.RetryOnEnvironmentError && !sub.aborted() {
+		log.Warningf("retrying %q after an environment error: %s", sub.Cmd.ApplicationName, result.Reason)
+		result.Release()
+		time.Sleep(environmentRetryDelay)
+		retries++
+		result, err = sub.execute()
+	}
+	if result != nil {
+		result.EnvironmentRetries, result.FirstEnvironmentError = retries, first
+	}
+	return result, err
+}
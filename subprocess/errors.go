@@ -12,6 +12,27 @@ func IsUserError(err error) bool {
 	return errors.Is(err, ErrUserError)
 }
 
+// LaunchError is returned by Execute when no process ran. Stdout and Stderr have what was written to memory
+// redirects before the failure, if anything.
+type LaunchError struct {
+	Err    error
+	Stdout []byte
+	Stderr []byte
+}
+
+func (e *LaunchError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *LaunchError) Unwrap() error {
+	return e.Err
+}
+
+func IsLaunchError(err error) bool {
+	var e *LaunchError
+	return errors.As(err, &e)
+}
+
 func extractErrno(e error) (syscall.Errno, bool) {
 	if e == nil {
 		return 0, false
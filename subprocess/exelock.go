@@ -0,0 +1,49 @@
+package subprocess
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+// lockExecutable opens the executable, checks it against ExecutableSha256, and returns it open; it has to stay open
+// until the process is created. On Windows the file can't be written, renamed or deleted while it's open, so the
+// bytes checked are the bytes launched. Linux can't prevent that, the check there only narrows the window: the path
+// still has to resolve to the file hashed when it is done. Returns nil if there is no hash to check.
+func (sub *Subprocess) lockExecutable() (*os.File, error) {
+	if sub.ExecutableSha256 == "" {
+		return nil, nil
+	}
+	name := sub.Cmd.ApplicationName
+	if name == "" {
+		return nil, fmt.Errorf("ExecutableSha256 needs ApplicationName: %w", ErrUserError)
+	}
+	f, err := openExecutable(name)
+	if err != nil {
+		return nil, err
+	}
+	h := sha256.New()
+	if _, err = io.Copy(h, f); err != nil {
+		f.Close()
+		return nil, fmt.Errorf("hashing %q: %w", name, err)
+	}
+	if sum := hex.EncodeToString(h.Sum(nil)); sum != strings.ToLower(sub.ExecutableSha256) {
+		f.Close()
+		return nil, fmt.Errorf("executable %q has sha256 %s, expected %s", name, sum, sub.ExecutableSha256)
+	}
+	opened, err := f.Stat()
+	if err == nil {
+		var current os.FileInfo
+		if current, err = os.Stat(name); err == nil && !os.SameFile(opened, current) {
+			err = fmt.Errorf("replaced while it was checked")
+		}
+	}
+	if err != nil {
+		f.Close()
+		return nil, fmt.Errorf("executable %q: %w", name, err)
+	}
+	return f, nil
+}
@@ -0,0 +1,22 @@
+package subprocess
+
+import (
+	"os"
+
+	"golang.org/x/sys/windows"
+)
+
+// openExecutable shares the file for reading only, which CreateProcess needs; writes, renames and deletes fail until
+// it's closed.
+func openExecutable(name string) (*os.File, error) {
+	p, err := windows.UTF16PtrFromString(name)
+	if err != nil {
+		return nil, err
+	}
+	h, err := windows.CreateFile(p, windows.GENERIC_READ, windows.FILE_SHARE_READ, nil, windows.OPEN_EXISTING,
+		windows.FILE_ATTRIBUTE_NORMAL, 0)
+	if err != nil {
+		return nil, &os.PathError{Op: "CreateFile", Path: name, Err: err}
+	}
+	return os.NewFile(uintptr(h), name), nil
+}
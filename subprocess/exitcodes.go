@@ -0,0 +1,57 @@
+package subprocess
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ExitCodeClass tells whose fault a run ending with a given exit code is.
+type ExitCodeClass int
+
+const (
+	// The solution crashed or returned an error.
+	EXIT_CODE_RUNTIME_ERROR ExitCodeClass = iota
+	// The host couldn't run it properly, like a missing runtime DLL; worth a rejudge elsewhere.
+	EXIT_CODE_ENVIRONMENT_ERROR
+)
+
+// ParseExitCodeClasses parses a list like "0xC0000135=ENVIRONMENT, 3=RUNTIME", separated by commas or spaces.
+// Codes are decimal, or hex with 0x.
+func ParseExitCodeClasses(s string) (map[uint32]ExitCodeClass, error) {
+	classes := map[string]ExitCodeClass{"RUNTIME": EXIT_CODE_RUNTIME_ERROR, "ENVIRONMENT": EXIT_CODE_ENVIRONMENT_ERROR}
+	result := map[uint32]ExitCodeClass{}
+	for _, pair := range strings.FieldsFunc(s, func(r rune) bool { return r == ',' || r == ' ' || r == '\t' }) {
+		code, name, ok := strings.Cut(pair, "=")
+		if !ok {
+			return nil, fmt.Errorf("exit code class %q: no class", pair)
+		}
+		n, err := strconv.ParseUint(code, 0, 32)
+		if err != nil {
+			return nil, fmt.Errorf("exit code class %q: %w", pair, err)
+		}
+		c, ok := classes[strings.ToUpper(name)]
+		if !ok {
+			return nil, fmt.Errorf("exit code class %q: unknown class %q", pair, name)
+		}
+		result[uint32(n)] = c
+	}
+	return result, nil
+}
+
+// exitCodeClass looks the code up in Subprocess.ExitCodeClasses, then in the platform defaults.
+func (sub *Subprocess) exitCodeClass(code uint32) ExitCodeClass {
+	if c, ok := sub.ExitCodeClasses[code]; ok {
+		return c
+	}
+	if _, ok := loaderFailures[code]; ok {
+		return EXIT_CODE_ENVIRONMENT_ERROR
+	}
+	return EXIT_CODE_RUNTIME_ERROR
+}
+
+// SignedExitCode is ExitCode as the program's return value, for languages where it's signed: -1 rather than
+// 0xFFFFFFFF on Windows, or rather than 255 on Linux, where only a byte is passed on.
+func (r *SubprocessResult) SignedExitCode() int32 {
+	return signedExitCode(r.ExitCode)
+}
@@ -0,0 +1,42 @@
+package subprocess
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseExitCodeClasses(t *testing.T) {
+	cases := []struct {
+		s     string
+		want  map[uint32]ExitCodeClass
+		fails bool
+	}{
+		{"", map[uint32]ExitCodeClass{}, false},
+		{"3=RUNTIME", map[uint32]ExitCodeClass{3: EXIT_CODE_RUNTIME_ERROR}, false},
+		{"0xC0000135=ENVIRONMENT, 3=runtime", map[uint32]ExitCodeClass{0xC0000135: EXIT_CODE_ENVIRONMENT_ERROR, 3: EXIT_CODE_RUNTIME_ERROR}, false},
+		{"1=environment 2=Environment\t4=RUNTIME", map[uint32]ExitCodeClass{1: EXIT_CODE_ENVIRONMENT_ERROR, 2: EXIT_CODE_ENVIRONMENT_ERROR, 4: EXIT_CODE_RUNTIME_ERROR}, false},
+		{"3=RUNTIME,3=ENVIRONMENT", map[uint32]ExitCodeClass{3: EXIT_CODE_ENVIRONMENT_ERROR}, false},
+		{"0xFFFFFFFF=RUNTIME", map[uint32]ExitCodeClass{0xFFFFFFFF: EXIT_CODE_RUNTIME_ERROR}, false},
+		{"3", nil, true},
+		{"3=CRASH", nil, true},
+		{"x=RUNTIME", nil, true},
+		{"-1=RUNTIME", nil, true},
+		{"0x100000000=RUNTIME", nil, true},
+	}
+	for _, c := range cases {
+		got, err := ParseExitCodeClasses(c.s)
+		if c.fails {
+			if err == nil {
+				t.Errorf("%q: got %v, want an error", c.s, got)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%q: %s", c.s, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, c.want) {
+			t.Errorf("%q: got %v, want %v", c.s, got, c.want)
+		}
+	}
+}
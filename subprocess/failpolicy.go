@@ -0,0 +1,79 @@
+package subprocess
+
+import "fmt"
+
+// SecurityFeature is a hardening step a host may be unable to apply, like on an older Windows version or without a
+// privilege. Windows only for now. Only steps that have a weaker fallback are listed: integrity levels and process
+// mitigation policies aren't applied by this package, and network isolation comes with AppContainer, which is
+// requested per run and refuses it when the container can't be set up.
+type SecurityFeature string
+
+const (
+	// The isolation desktop. Fails closed, unless the host is set up with platform.GlobalDataOptions.AllowNoDesktop.
+	FEATURE_DESKTOP SecurityFeature = "desktop"
+	// Removing privileges from the process token, see PlatformOptions.RemovePrivileges. Fails closed. Before
+	// policies, removing the default set was best effort and only an explicit RemovePrivileges list failed the run;
+	// hosts relying on that need FAIL_OPEN for this feature.
+	FEATURE_PRIVILEGES SecurityFeature = "privileges"
+	// Restricting the DLL search order, see restrictDllSearch. Fails open, unless there are DllDirectories.
+	FEATURE_DLL_SEARCH SecurityFeature = "dll-search"
+)
+
+var securityFeatures = []SecurityFeature{FEATURE_DESKTOP, FEATURE_PRIVILEGES, FEATURE_DLL_SEARCH}
+
+// FailPolicy says what happens to a run when a SecurityFeature can't be applied.
+type FailPolicy int
+
+const (
+	// Whatever the feature defaults to, see the SecurityFeature constants.
+	FAIL_DEFAULT FailPolicy = iota
+	// Refuse the run.
+	FAIL_CLOSED
+	// Run with weaker isolation, listing the feature in SubprocessResult.DowngradedFeatures.
+	FAIL_OPEN
+)
+
+// ParseFailPolicies makes the policy map from lists of feature names which fail open and which fail closed.
+func ParseFailPolicies(open, closed []string) (map[SecurityFeature]FailPolicy, error) {
+	var result map[SecurityFeature]FailPolicy
+	add := func(names []string, p FailPolicy) error {
+		for _, name := range names {
+			f, err := parseSecurityFeature(name)
+			if err != nil {
+				return err
+			}
+			if result == nil {
+				result = make(map[SecurityFeature]FailPolicy)
+			}
+			if prev, ok := result[f]; ok && prev != p {
+				return fmt.Errorf("%w: %q can't fail both open and closed", ErrUserError, name)
+			}
+			result[f] = p
+		}
+		return nil
+	}
+	if err := add(open, FAIL_OPEN); err != nil {
+		return nil, err
+	}
+	if err := add(closed, FAIL_CLOSED); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func parseSecurityFeature(name string) (SecurityFeature, error) {
+	for _, f := range securityFeatures {
+		if string(f) == name {
+			return f, nil
+		}
+	}
+	return "", fmt.Errorf("%w: unknown security feature %q, known are %v", ErrUserError, name, securityFeatures)
+}
+
+// failOpen tells if the run may go on without feature, given its default policy def.
+func failOpen(policies map[SecurityFeature]FailPolicy, feature SecurityFeature, def FailPolicy) bool {
+	if p := policies[feature]; p != FAIL_DEFAULT {
+		return p == FAIL_OPEN
+	}
+	return def == FAIL_OPEN
+}
@@ -0,0 +1,62 @@
+package subprocess
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestParseFailPolicies(t *testing.T) {
+	cases := []struct {
+		open, closed []string
+		want         map[SecurityFeature]FailPolicy
+		fails        bool
+	}{
+		{nil, nil, nil, false},
+		{[]string{"desktop"}, nil, map[SecurityFeature]FailPolicy{FEATURE_DESKTOP: FAIL_OPEN}, false},
+		{[]string{"desktop"}, []string{"privileges", "dll-search"},
+			map[SecurityFeature]FailPolicy{FEATURE_DESKTOP: FAIL_OPEN, FEATURE_PRIVILEGES: FAIL_CLOSED, FEATURE_DLL_SEARCH: FAIL_CLOSED}, false},
+		{[]string{"desktop", "desktop"}, nil, map[SecurityFeature]FailPolicy{FEATURE_DESKTOP: FAIL_OPEN}, false},
+		{[]string{"desktop"}, []string{"desktop"}, nil, true},
+		{[]string{"Desktop"}, nil, nil, true},
+		{nil, []string{"seccomp"}, nil, true},
+	}
+	for _, c := range cases {
+		got, err := ParseFailPolicies(c.open, c.closed)
+		if c.fails {
+			if !errors.Is(err, ErrUserError) {
+				t.Errorf("open %q, closed %q: got %v, %v, want a user error", c.open, c.closed, got, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("open %q, closed %q: %s", c.open, c.closed, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, c.want) {
+			t.Errorf("open %q, closed %q: got %v, want %v", c.open, c.closed, got, c.want)
+		}
+	}
+}
+
+func TestFailOpen(t *testing.T) {
+	policies := map[SecurityFeature]FailPolicy{FEATURE_DESKTOP: FAIL_OPEN, FEATURE_PRIVILEGES: FAIL_CLOSED}
+	cases := []struct {
+		policies map[SecurityFeature]FailPolicy
+		feature  SecurityFeature
+		def      FailPolicy
+		want     bool
+	}{
+		{nil, FEATURE_DLL_SEARCH, FAIL_OPEN, true},
+		{nil, FEATURE_PRIVILEGES, FAIL_CLOSED, false},
+		{policies, FEATURE_DESKTOP, FAIL_CLOSED, true},
+		{policies, FEATURE_PRIVILEGES, FAIL_OPEN, false},
+		{policies, FEATURE_DLL_SEARCH, FAIL_OPEN, true},
+		{policies, FEATURE_DLL_SEARCH, FAIL_CLOSED, false},
+	}
+	for _, c := range cases {
+		if got := failOpen(c.policies, c.feature, c.def); got != c.want {
+			t.Errorf("%v, %s, default %d: got %v, want %v", c.policies, c.feature, c.def, got, c.want)
+		}
+	}
+}
@@ -0,0 +1,14 @@
+package subprocess
+
+import log "github.com/sirupsen/logrus"
+
+// downgrade handles feature failing to apply with err, per PlatformOptions.FailPolicies with def as the default:
+// returns err if the run must be refused, or records the feature as downgraded and returns nil.
+func (d *SubprocessData) downgrade(sub *Subprocess, feature SecurityFeature, def FailPolicy, err error) error {
+	if !failOpen(sub.Options.FailPolicies, feature, def) {
+		return err
+	}
+	log.Warningf("CreateFrozen: running without %s: %s", feature, err)
+	d.platformData.downgraded = append(d.platformData.downgraded, feature)
+	return nil
+}
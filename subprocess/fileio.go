@@ -0,0 +1,92 @@
+package subprocess
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+)
+
+// FileIO is for problems where the solution reads and writes named files in its current directory instead of
+// standard streams.
+type FileIO struct {
+	// Names in CurrentDirectory, "input.txt" and "output.txt" if empty.
+	InputName, OutputName string
+	// Copied to InputName before the start, if set.
+	InputFile string
+	// If positive, no more than this is collected from OutputName.
+	OutputMaxSize int64
+}
+
+// path is name, or def if it's empty, in dir. Names reaching outside dir are refused.
+func (f *FileIO) path(dir, name, def string) (string, error) {
+	if name == "" {
+		name = def
+	}
+	if !filepath.IsLocal(name) {
+		return "", fmt.Errorf("%w: FileIO name %q is outside current directory", ErrUserError, name)
+	}
+	return filepath.Join(dir, name), nil
+}
+
+func (f *FileIO) inputPath(dir string) (string, error) {
+	return f.path(dir, f.InputName, "input.txt")
+}
+
+func (f *FileIO) outputPath(dir string) (string, error) {
+	return f.path(dir, f.OutputName, "output.txt")
+}
+
+// stage puts the input in place and removes stale output, so it's not collected if solution writes nothing.
+func (f *FileIO) stage(dir string) error {
+	output, err := f.outputPath(dir)
+	if err != nil {
+		return err
+	}
+	input, err := f.inputPath(dir)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(output); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("FileIO: %w", err)
+	}
+	if f.InputFile == "" {
+		return nil
+	}
+	src, err := os.Open(f.InputFile)
+	if err != nil {
+		return fmt.Errorf("FileIO: %w", err)
+	}
+	defer src.Close()
+	dst, err := os.Create(input)
+	if err != nil {
+		return fmt.Errorf("FileIO: %w", err)
+	}
+	if _, err = io.Copy(dst, src); err != nil {
+		dst.Close()
+		return fmt.Errorf("FileIO: copy input: %w", err)
+	}
+	return dst.Close()
+}
+
+// collect returns contents of the output file, or nil if there's none.
+func (f *FileIO) collect(dir string) ([]byte, error) {
+	name, err := f.outputPath(dir)
+	if err != nil {
+		return nil, err
+	}
+	file, err := os.Open(name)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("FileIO: %w", err)
+	}
+	defer file.Close()
+	var r io.Reader = file
+	if f.OutputMaxSize > 0 {
+		r = io.LimitReader(file, f.OutputMaxSize)
+	}
+	return io.ReadAll(r)
+}
@@ -0,0 +1,38 @@
+package subprocess
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+)
+
+func TestFileIOPath(t *testing.T) {
+	dir := filepath.FromSlash("/judge/run")
+	cases := []struct {
+		name string
+		want string
+	}{
+		{"", filepath.Join(dir, "input.txt")},
+		{"in.txt", filepath.Join(dir, "in.txt")},
+		{filepath.FromSlash("sub/in.txt"), filepath.Join(dir, "sub", "in.txt")},
+		{filepath.FromSlash("sub/../in.txt"), filepath.Join(dir, "in.txt")},
+		{"..", ""},
+		{filepath.FromSlash("../in.txt"), ""},
+		{filepath.FromSlash("../../x"), ""},
+		{filepath.FromSlash("sub/../../x"), ""},
+		{filepath.FromSlash("/etc/passwd"), ""},
+	}
+	var f FileIO
+	for _, c := range cases {
+		got, err := f.path(dir, c.name, "input.txt")
+		if c.want == "" {
+			if !errors.Is(err, ErrUserError) {
+				t.Errorf("%q: got %q, %v, want a user error", c.name, got, err)
+			}
+			continue
+		}
+		if err != nil || got != c.want {
+			t.Errorf("%q: got %q, %v, want %q", c.name, got, err, c.want)
+		}
+	}
+}
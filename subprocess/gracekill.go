@@ -0,0 +1,9 @@
+package subprocess
+
+// Limits after which the process gets Subprocess.KillGracePeriod to stop by itself. Not for output limits, where
+// more output is the problem, nor for memory ones, which the OS enforces as well.
+const graceKillFlags = EF_TIME_LIMIT_HIT | EF_KERNEL_TIME_LIMIT_HIT | EF_WALL_TIME_LIMIT_HIT | EF_INACTIVE
+
+func (sub *Subprocess) killGracefully(successCode uint64) bool {
+	return sub.KillGracePeriod > 0 && successCode&graceKillFlags != 0
+}
@@ -0,0 +1,47 @@
+package subprocess
+
+import (
+	"errors"
+	"sync"
+	"syscall"
+
+	"github.com/contester/runlib/win32"
+	"golang.org/x/sys/windows"
+)
+
+var ctrlBreak struct {
+	sync.Mutex
+	once sync.Once
+	err  error
+}
+
+// swallowCtrlBreak is installed for good: a CTRL_BREAK reaching us while we're attached to the child's console
+// would otherwise end up in the default handler, which exits the process.
+var swallowCtrlBreak = syscall.NewCallback(func(event uint32) uintptr {
+	if event == win32.CTRL_BREAK_EVENT {
+		return 1
+	}
+	return 0
+})
+
+// stopGracefully sends CTRL_BREAK to the process group of the child, through its console. That needs attaching to the
+// console, and a process has at most one, so a judge with a console of its own (runexe in a terminal) can't do it.
+func stopGracefully(pid uint32) error {
+	ctrlBreak.Lock()
+	defer ctrlBreak.Unlock()
+	if win32.HasConsole() {
+		return errors.New("attached to a console already, can't attach to the child's")
+	}
+	ctrlBreak.once.Do(func() {
+		ctrlBreak.err = win32.SetConsoleCtrlHandler(swallowCtrlBreak, true)
+	})
+	if ctrlBreak.err != nil {
+		return ctrlBreak.err
+	}
+	if err := win32.AttachConsole(pid); err != nil {
+		return err
+	}
+	defer win32.FreeConsole()
+	// The child is created with CREATE_NEW_PROCESS_GROUP, so its group id is its pid.
+	return windows.GenerateConsoleCtrlEvent(win32.CTRL_BREAK_EVENT, pid)
+}
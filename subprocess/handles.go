@@ -0,0 +1,29 @@
+package subprocess
+
+// handleCounter keeps the largest number of handles (file descriptors on Linux) seen open at once, sampled every
+// TimeQuantum.
+type handleCounter struct {
+	peak uint32
+}
+
+func newHandleCounter(sub *Subprocess) *handleCounter {
+	if !sub.CountHandles && sub.HandleLimit == 0 {
+		return nil
+	}
+	return &handleCounter{}
+}
+
+// observe records a sample, and tells if it's over the limit.
+func (c *handleCounter) observe(sub *Subprocess, n uint32) bool {
+	if n > c.peak {
+		c.peak = n
+	}
+	return sub.HandleLimit > 0 && n > sub.HandleLimit
+}
+
+func (c *handleCounter) setResult(result *SubprocessResult) {
+	if c == nil {
+		return
+	}
+	result.PeakHandleCount = c.peak
+}
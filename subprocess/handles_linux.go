@@ -0,0 +1,18 @@
+package subprocess
+
+import (
+	"os"
+	"strconv"
+)
+
+// Update counts open file descriptors of the process, and tells if that's over HandleLimit.
+func (c *handleCounter) Update(sub *Subprocess, pid int) bool {
+	if c == nil {
+		return false
+	}
+	fds, err := os.ReadDir("/proc/" + strconv.Itoa(pid) + "/fd")
+	if err != nil {
+		return false
+	}
+	return c.observe(sub, uint32(len(fds)))
+}
@@ -0,0 +1,62 @@
+package subprocess
+
+import (
+	"fmt"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/contester/runlib/win32"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// Update adds up handles of all processes in the job, or of the process itself without one, and tells if that's
+// over HandleLimit.
+func (c *handleCounter) Update(sub *Subprocess, d *PlatformData) bool {
+	if c == nil {
+		return false
+	}
+	var pids []uintptr
+	if d.hJob != syscall.InvalidHandle {
+		ids, err := win32.GetJobProcessIds(d.hJob)
+		if err != nil {
+			log.Errorf("GetJobProcessIds: %s", err)
+		}
+		pids = ids
+	}
+	if len(pids) == 0 {
+		n, err := win32.GetProcessHandleCount(d.hProcess)
+		if err != nil {
+			log.Debugf("GetProcessHandleCount: %s", err)
+			return false
+		}
+		return c.observe(sub, n)
+	}
+	var total uint32
+	for _, pid := range pids {
+		// Fails for processes that have just exited, their handles are closed anyway.
+		if n, err := win32.ProcessHandleCount(uint32(pid)); err == nil {
+			total += n
+		}
+	}
+	return c.observe(sub, total)
+}
+
+// Kernel object types which are usually named, as they appear in audit events.
+var namedObjectTypes = []string{"Event", "Mutant", "Semaphore", "Section", "Timer", "SymbolicLink"}
+
+// namedObjectOpens counts named kernel objects the process created or opened since the start (event 4656 in the
+// Security log). Windows only logs them with auditing of kernel objects enabled (auditpol /set
+// /subcategory:"Kernel Object" /success:enable /failure:enable) and the "Audit the access of global system objects"
+// policy, which gives named objects a default SACL. Unnamed objects are never logged.
+func namedObjectOpens(pid uint32, since time.Time) (int, error) {
+	types := make([]string, len(namedObjectTypes))
+	for i, t := range namedObjectTypes {
+		types[i] = fmt.Sprintf("Data[@Name='ObjectType']='%s'", t)
+	}
+	query := fmt.Sprintf("*[System[EventID=4656 and TimeCreated[@SystemTime>='%s']]"+
+		" and EventData[(%s) and Data[@Name='ProcessId']='%#x']]",
+		since.UTC().Format("2006-01-02T15:04:05.000Z"), strings.Join(types, " or "), pid)
+	return win32.CountEvents("Security", query)
+}
@@ -0,0 +1,138 @@
+package subprocess
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"sync/atomic"
+)
+
+// headTailWriter keeps the first and the last part of what's written to it, counted in bytes or, with lines set,
+// in lines. In lines, both parts are still bounded by MAX_MEM_OUTPUT bytes each.
+type headTailWriter struct {
+	lines     bool
+	headSize  int64
+	tailSize  int64
+	head      []byte
+	headLines int64
+	headDone  bool
+	tail      tailWriter
+	// Written after the head.
+	rest      int64
+	restLines int64
+}
+
+func newHeadTailWriter(headSize, tailSize int64, lines bool) *headTailWriter {
+	t := &headTailWriter{lines: lines, headSize: headSize, tailSize: tailSize, headDone: headSize <= 0}
+	bufSize := tailSize
+	if lines || bufSize > MAX_MEM_OUTPUT {
+		bufSize = MAX_MEM_OUTPUT
+	}
+	t.tail.buf = make([]byte, bufSize)
+	return t
+}
+
+func (t *headTailWriter) Write(p []byte) (int, error) {
+	n := len(p)
+	if !t.headDone {
+		take := int64(len(p))
+		if limit := t.headLimit() - int64(len(t.head)); take > limit {
+			take = limit
+		}
+		if t.lines {
+			for i, c := range p[:take] {
+				if c == '\n' {
+					if t.headLines++; t.headLines == t.headSize {
+						take = int64(i) + 1
+						break
+					}
+				}
+			}
+		}
+		t.head = append(t.head, p[:take]...)
+		p = p[take:]
+		t.headDone = int64(len(t.head)) == t.headLimit() || (t.lines && t.headLines == t.headSize)
+	}
+	if len(p) > 0 {
+		t.tail.Write(p)
+		t.rest += int64(len(p))
+		t.restLines += int64(bytes.Count(p, []byte{'\n'}))
+	}
+	return n, nil
+}
+
+func (t *headTailWriter) headLimit() int64 {
+	if t.lines || t.headSize > MAX_MEM_OUTPUT {
+		return MAX_MEM_OUTPUT
+	}
+	return t.headSize
+}
+
+// lastLines returns the end of b starting after its n-th line break from the end, not counting a final one.
+func lastLines(b []byte, n int64) []byte {
+	end := len(b)
+	if end > 0 && b[end-1] == '\n' {
+		end--
+	}
+	for i := end - 1; i >= 0; i-- {
+		if b[i] == '\n' {
+			if n--; n == 0 {
+				return b[i+1:]
+			}
+		}
+	}
+	if n <= 0 {
+		return nil
+	}
+	return b
+}
+
+// WriteTo writes the head, a marker line telling how much was skipped, if anything was, and the tail.
+func (t *headTailWriter) WriteTo(w io.Writer) (int64, error) {
+	var tail bytes.Buffer
+	t.tail.WriteTo(&tail)
+	shown := tail.Bytes()
+	if t.lines {
+		shown = lastLines(shown, t.tailSize)
+	} else if int64(len(shown)) > t.tailSize {
+		shown = shown[int64(len(shown))-t.tailSize:]
+	}
+
+	var out bytes.Buffer
+	out.Write(t.head)
+	if skipped := t.rest - int64(len(shown)); skipped > 0 {
+		if len(t.head) > 0 && t.head[len(t.head)-1] != '\n' {
+			out.WriteByte('\n')
+		}
+		skippedLines := t.restLines - int64(bytes.Count(shown, []byte{'\n'}))
+		fmt.Fprintf(&out, "[... %d bytes, %d lines skipped ...]\n", skipped, skippedLines)
+	}
+	out.Write(shown)
+	return out.WriteTo(w)
+}
+
+// SetupOutputMemoryHeadTail drains the whole output like SetupOutputMemoryTail, keeping its head and tail.
+func (d *SubprocessData) SetupOutputMemoryHeadTail(b *bytes.Buffer, headSize, tailSize int64, lines bool, size *atomic.Int64) (*os.File, error) {
+	reader, writer, e := os.Pipe()
+	if e != nil {
+		return nil, fmt.Errorf("SetupOutputMemoryHeadTail: os.Pipe: %w", e)
+	}
+
+	d.closeAfterStart = append(d.closeAfterStart, writer)
+
+	ht := newHeadTailWriter(headSize, tailSize, lines)
+	d.startAfterStart = append(d.startAfterStart, func() error {
+		_, err := io.Copy(ht, countingReader{reader, size})
+		reader.Close()
+		ht.WriteTo(b)
+		return err
+	})
+
+	d.cleanupIfFailed = append(d.cleanupIfFailed, func() {
+		io.Copy(ht, countingReader{reader, size})
+		reader.Close()
+		ht.WriteTo(b)
+	})
+	return writer, nil
+}
@@ -0,0 +1,60 @@
+package subprocess
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestHeadTailWriter(t *testing.T) {
+	cases := []struct {
+		headSize, tailSize int64
+		lines              bool
+		writes             []string
+		want               string
+	}{
+		{3, 3, false, []string{"abcde"}, "abcde"},
+		{3, 3, false, []string{"abcdefghij"}, "abc\n[... 4 bytes, 0 lines skipped ...]\nhij"},
+		{0, 3, false, []string{"abcdef"}, "[... 3 bytes, 0 lines skipped ...]\ndef"},
+		{2, 2, false, []string{"a", "bc", "de", "f"}, "ab\n[... 2 bytes, 0 lines skipped ...]\nef"},
+		{2, 3, false, []string{"a\n", "b\nc\nd\ne\n"}, "a\n[... 5 bytes, 2 lines skipped ...]\n\ne\n"},
+		{2, 2, true, []string{"a\nb\nc\n"}, "a\nb\nc\n"},
+		{1, 1, true, []string{"1\n2\n3\n4\n"}, "1\n[... 4 bytes, 2 lines skipped ...]\n4\n"},
+		{1, 1, true, []string{"1", "\n2\n", "3\n4\n"}, "1\n[... 4 bytes, 2 lines skipped ...]\n4\n"},
+		{1, 1, true, []string{"a\nb\nc"}, "a\n[... 2 bytes, 1 lines skipped ...]\nc"},
+		{0, 2, true, []string{"a\nb\nc\nd\n"}, "[... 4 bytes, 2 lines skipped ...]\nc\nd\n"},
+	}
+	for _, c := range cases {
+		w := newHeadTailWriter(c.headSize, c.tailSize, c.lines)
+		for _, s := range c.writes {
+			if n, err := w.Write([]byte(s)); n != len(s) || err != nil {
+				t.Fatalf("%q: Write(%q) = %d, %v", c.writes, s, n, err)
+			}
+		}
+		var b bytes.Buffer
+		w.WriteTo(&b)
+		if b.String() != c.want {
+			t.Errorf("head %d, tail %d, lines %v, %q: got %q, want %q", c.headSize, c.tailSize, c.lines, c.writes, b.String(), c.want)
+		}
+	}
+}
+
+func TestLastLines(t *testing.T) {
+	cases := []struct {
+		b    string
+		n    int64
+		want string
+	}{
+		{"a\nb\nc\n", 1, "c\n"},
+		{"a\nb\nc\n", 2, "b\nc\n"},
+		{"a\nb\nc", 2, "b\nc"},
+		{"a\nb\n", 5, "a\nb\n"},
+		{"\n\n\n", 1, "\n"},
+		{"a\n", 0, ""},
+		{"", 1, ""},
+	}
+	for _, c := range cases {
+		if got := string(lastLines([]byte(c.b), c.n)); got != c.want {
+			t.Errorf("lastLines(%q, %d) = %q, want %q", c.b, c.n, got, c.want)
+		}
+	}
+}
@@ -0,0 +1,97 @@
+package subprocess
+
+import (
+	"sync"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// HostLoad describes what else the host was doing during the run. Runs judged on a loaded host may deserve a
+// rejudge if their time is borderline.
+type HostLoad struct {
+	// Average utilization of all CPUs over the run, from 0 to 1, including this run itself.
+	CpuUtilization float64
+	// Most runs executing at once in this process during the run, including this one.
+	ConcurrentRuns uint32
+	// Rated CPU frequency, and the current one averaged over CPUs and over samples at the start and the end of the
+	// run, in MHz; 0 if unknown. Times from hosts running well below the rated frequency are suspect.
+	BaseFrequencyMhz    uint32
+	CurrentFrequencyMhz uint32
+}
+
+type runTracker struct {
+	maxRuns uint32
+}
+
+var activeRuns struct {
+	sync.Mutex
+	runs map[*runTracker]struct{}
+}
+
+// hostLoadMonitor is started with every run, so each one counts towards ConcurrentRuns of the others. If measure is
+// set, it also measures the host between its start and stop.
+type hostLoadMonitor struct {
+	tracker         runTracker
+	measure         bool
+	idle, total     uint64
+	haveStartCounts bool
+	startFrequency  uint32
+}
+
+func startHostLoadMonitor(measure bool) *hostLoadMonitor {
+	m := &hostLoadMonitor{measure: measure}
+	if measure {
+		var err error
+		if m.idle, m.total, err = hostCpuTimes(); err == nil {
+			m.haveStartCounts = true
+		} else {
+			log.Errorf("startHostLoadMonitor: %s", err)
+		}
+		if _, m.startFrequency, err = hostFrequency(); err != nil {
+			log.Debugf("startHostLoadMonitor: %s", err)
+		}
+	}
+
+	activeRuns.Lock()
+	if activeRuns.runs == nil {
+		activeRuns.runs = make(map[*runTracker]struct{})
+	}
+	activeRuns.runs[&m.tracker] = struct{}{}
+	n := uint32(len(activeRuns.runs))
+	for t := range activeRuns.runs {
+		if t.maxRuns < n {
+			t.maxRuns = n
+		}
+	}
+	activeRuns.Unlock()
+	return m
+}
+
+// Stop unregisters the run, and returns the load if it was measured.
+func (m *hostLoadMonitor) Stop() *HostLoad {
+	activeRuns.Lock()
+	delete(activeRuns.runs, &m.tracker)
+	result := &HostLoad{ConcurrentRuns: m.tracker.maxRuns}
+	activeRuns.Unlock()
+	if !m.measure {
+		return nil
+	}
+
+	if m.haveStartCounts {
+		idle, total, err := hostCpuTimes()
+		if err != nil {
+			log.Errorf("hostLoadMonitor.Stop: %s", err)
+		} else if total > m.total {
+			busy := (total - m.total) - (idle - m.idle)
+			result.CpuUtilization = float64(busy) / float64(total-m.total)
+		}
+	}
+	if base, current, err := hostFrequency(); err == nil {
+		result.BaseFrequencyMhz = base
+		result.CurrentFrequencyMhz = current
+		if m.startFrequency > 0 && current > 0 {
+			result.CurrentFrequencyMhz = (m.startFrequency + current) / 2
+		}
+	}
+	return result
+}
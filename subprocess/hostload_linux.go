@@ -0,0 +1,78 @@
+package subprocess
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// hostCpuTimes reads the aggregate "cpu" line of /proc/stat.
+func hostCpuTimes() (idle, total uint64, err error) {
+	data, err := os.ReadFile("/proc/stat")
+	if err != nil {
+		return 0, 0, err
+	}
+	return parseProcStat(string(data))
+}
+
+// parseProcStat sums the aggregate "cpu" line. Idle includes iowait.
+func parseProcStat(data string) (idle, total uint64, err error) {
+	line, _, _ := strings.Cut(data, "\n")
+	fields := strings.Fields(line)
+	if len(fields) < 5 || fields[0] != "cpu" {
+		return 0, 0, fmt.Errorf("unexpected /proc/stat line %q", line)
+	}
+	// Guest time is already counted in user.
+	if len(fields) > 9 {
+		fields = fields[:9]
+	}
+	for i, f := range fields[1:] {
+		v, err := strconv.ParseUint(f, 10, 64)
+		if err != nil {
+			return 0, 0, fmt.Errorf("parsing /proc/stat: %w", err)
+		}
+		total += v
+		if i == 3 || i == 4 {
+			idle += v
+		}
+	}
+	return idle, total, nil
+}
+
+func readKhz(name string) (uint64, bool) {
+	data, err := os.ReadFile(name)
+	if err != nil {
+		return 0, false
+	}
+	v, err := strconv.ParseUint(strings.TrimSpace(string(data)), 10, 64)
+	return v, err == nil
+}
+
+// hostFrequency reads cpufreq of each CPU. base_frequency is only there with intel_pstate, elsewhere the maximum
+// frequency is the closest there is.
+func hostFrequency() (base, current uint32, err error) {
+	dirs, _ := filepath.Glob("/sys/devices/system/cpu/cpu[0-9]*/cpufreq")
+	if len(dirs) == 0 {
+		return 0, 0, fmt.Errorf("no cpufreq in /sys/devices/system/cpu")
+	}
+	var sum, n uint64
+	for _, dir := range dirs {
+		b, ok := readKhz(filepath.Join(dir, "base_frequency"))
+		if !ok {
+			b, _ = readKhz(filepath.Join(dir, "cpuinfo_max_freq"))
+		}
+		if mhz := uint32(b / 1000); mhz > base {
+			base = mhz
+		}
+		if c, ok := readKhz(filepath.Join(dir, "scaling_cur_freq")); ok {
+			sum += c / 1000
+			n++
+		}
+	}
+	if n > 0 {
+		current = uint32(sum / n)
+	}
+	return base, current, nil
+}
@@ -0,0 +1,32 @@
+package subprocess
+
+import "testing"
+
+func TestParseProcStat(t *testing.T) {
+	cases := []struct {
+		data        string
+		idle, total uint64
+		fails       bool
+	}{
+		{"cpu  10 20 30 40 50 60 70 80 90 100\ncpu0 1 2 3 4 5 6 7 8 9 10\n", 90, 360, false},
+		{"cpu  10 20 30 40 50 60 70 80\n", 90, 360, false},
+		{"cpu  10 20 30 40\n", 40, 100, false},
+		{"cpu  10 20 30 40", 40, 100, false},
+		{"cpu  10 20 30\n", 0, 0, true},
+		{"cpu0 10 20 30 40 50\n", 0, 0, true},
+		{"cpu  10 20 x 40 50\n", 0, 0, true},
+		{"", 0, 0, true},
+	}
+	for _, c := range cases {
+		idle, total, err := parseProcStat(c.data)
+		if c.fails {
+			if err == nil {
+				t.Errorf("%q: got %d %d, want an error", c.data, idle, total)
+			}
+			continue
+		}
+		if err != nil || idle != c.idle || total != c.total {
+			t.Errorf("%q: got %d %d, %v, want %d %d", c.data, idle, total, err, c.idle, c.total)
+		}
+	}
+}
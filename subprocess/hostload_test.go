@@ -0,0 +1,18 @@
+package subprocess
+
+import "testing"
+
+func TestHostLoadConcurrentRuns(t *testing.T) {
+	measured := startHostLoadMonitor(true)
+	unmeasured := startHostLoadMonitor(false)
+	if load := unmeasured.Stop(); load != nil {
+		t.Errorf("unmeasured run: got %+v, want nil", load)
+	}
+	load := measured.Stop()
+	if load == nil {
+		t.Fatal("measured run: got nil")
+	}
+	if load.ConcurrentRuns != 2 {
+		t.Errorf("measured run: got %d concurrent runs, want 2", load.ConcurrentRuns)
+	}
+}
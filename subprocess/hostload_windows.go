@@ -0,0 +1,31 @@
+package subprocess
+
+import "github.com/contester/runlib/win32"
+
+func hostCpuTimes() (idle, total uint64, err error) {
+	idle, kernel, user, err := win32.GetSystemTimes()
+	if err != nil {
+		return 0, 0, err
+	}
+	return idle, kernel + user, nil
+}
+
+// hostFrequency returns the rated and the average current frequency of the processors. Windows updates CurrentMhz
+// only now and then, so it shows throttling more reliably than turbo.
+func hostFrequency() (base, current uint32, err error) {
+	info, err := win32.GetProcessorPowerInformation()
+	if err != nil {
+		return 0, 0, err
+	}
+	var sum uint64
+	for _, v := range info {
+		if v.MaxMhz > base {
+			base = v.MaxMhz
+		}
+		sum += uint64(v.CurrentMhz)
+	}
+	if len(info) > 0 {
+		current = uint32(sum / uint64(len(info)))
+	}
+	return base, current, nil
+}
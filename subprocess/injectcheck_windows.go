@@ -0,0 +1,58 @@
+package subprocess
+
+import (
+	"debug/pe"
+	"fmt"
+
+	"github.com/contester/runlib/win32"
+
+	log "github.com/sirupsen/logrus"
+)
+
+func machineName(machine uint16) string {
+	switch machine {
+	case pe.IMAGE_FILE_MACHINE_I386:
+		return "32-bit x86"
+	case pe.IMAGE_FILE_MACHINE_AMD64:
+		return "64-bit x64"
+	case pe.IMAGE_FILE_MACHINE_ARM64:
+		return "ARM64"
+	}
+	return fmt.Sprintf("machine 0x%04x", machine)
+}
+
+// checkInjectDlls fails before the process is created if an InjectDLL can't load into it: LoadLibraryW of a DLL
+// with other bitness just fails inside the child, without telling us why. DLLs we can't read are left to
+// LoadLibraryW.
+func checkInjectDlls(sub *Subprocess) error {
+	if len(sub.Options.InjectDLL) == 0 {
+		return nil
+	}
+	image := getImageName(sub)
+	binaryType, err := win32.GetBinaryType(image)
+	if err != nil {
+		return err
+	}
+	var want uint16
+	switch binaryType {
+	case win32.SCS_32BIT_BINARY:
+		want = pe.IMAGE_FILE_MACHINE_I386
+	case win32.SCS_64BIT_BINARY:
+		want = pe.IMAGE_FILE_MACHINE_AMD64
+	default:
+		return fmt.Errorf("%w: can't inject DLLs into %q, binary type %d", ErrUserError, image, binaryType)
+	}
+	for _, dll := range sub.Options.InjectDLL {
+		f, err := pe.Open(dll)
+		if err != nil {
+			log.Warningf("checkInjectDlls: %s", err)
+			continue
+		}
+		machine := f.FileHeader.Machine
+		f.Close()
+		if machine != want {
+			return fmt.Errorf("%w: inject DLL %q is %s, but %q is %s", ErrUserError, dll, machineName(machine), image, machineName(want))
+		}
+	}
+	return nil
+}
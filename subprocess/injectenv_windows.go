@@ -0,0 +1,85 @@
+package subprocess
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/contester/runlib/win32"
+)
+
+// Environment variables with this prefix are managed by us: they carry PlatformOptions.InjectEnv, and any other
+// variables with the prefix are dropped from the process environment, so they can't be confused with ours.
+const RESERVED_ENV_PREFIX = "RUNLIB_"
+
+// Injected DLLs get Subprocess.Locale in RUNLIB_LOCALE, and should make it the thread and user default locale of the
+// process (SetThreadLocale and friends), "C" being LOCALE_INVARIANT. Most Windows runtimes take the locale from
+// there, not from LANG.
+const SHIM_LOCALE_ENV = "LOCALE"
+
+// withoutReserved drops variables with RESERVED_ENV_PREFIX from env.
+func withoutReserved(env []string) []string {
+	result := make([]string, 0, len(env))
+	for _, v := range env {
+		if !strings.HasPrefix(strings.ToUpper(v), RESERVED_ENV_PREFIX) {
+			result = append(result, v)
+		}
+	}
+	return result
+}
+
+// childEnvironment adds InjectEnv, the locale and the nonce variable to the process environment. Since inherited
+// environment can't be extended, it's made explicit: the environment of the logged on user, or ours. Reserved
+// variables are dropped from any explicit environment. With nothing to add and no injected DLLs, which are what
+// reads reserved variables, ours is inherited as is.
+func (sub *Subprocess) childEnvironment() (win32.ProcessEnvironmentOptions, error) {
+	var inject map[string]string
+	if sub.Options != nil {
+		inject = sub.Options.InjectEnv
+	}
+	locale := sub.locale()
+	injectsDll := sub.Options != nil && len(sub.Options.InjectDLL) > 0
+	if locale != LOCALE_HOST && injectsDll {
+		extended := make(map[string]string, len(inject)+1)
+		for k, v := range inject {
+			extended[k] = v
+		}
+		extended[SHIM_LOCALE_ENV] = locale
+		inject = extended
+	}
+	if len(inject) == 0 && !injectsDll && (sub.Nonce == nil || sub.Nonce.EnvName == "") && locale == LOCALE_HOST {
+		if !sub.NoInheritEnvironment {
+			return win32.ProcessEnvironmentOptions{}, nil
+		}
+		return win32.ProcessEnvironmentOptions{NoInherit: true, Env: withoutReserved(sub.Environment)}, nil
+	}
+
+	var base []string
+	switch {
+	case sub.NoInheritEnvironment:
+		base = sub.Environment
+	case sub.Login != nil:
+		var err error
+		if base, err = win32.UserEnvironment(sub.Login.HUser); err != nil {
+			return win32.ProcessEnvironmentOptions{}, fmt.Errorf("childEnvironment(%q): %w", sub.Login.Username, err)
+		}
+	default:
+		base = os.Environ()
+	}
+
+	env := withoutReserved(base)
+	keys := make([]string, 0, len(inject))
+	for k := range inject {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		env = append(env, RESERVED_ENV_PREFIX+k+"="+inject[k])
+	}
+	if locale != LOCALE_HOST {
+		// For MSYS and Cygwin programs, and scripts which look at it.
+		env = withLocale(env, locale)
+	}
+	return win32.ProcessEnvironmentOptions{NoInherit: true, Env: sub.Nonce.environ(env)}, nil
+}
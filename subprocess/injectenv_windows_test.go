@@ -0,0 +1,37 @@
+package subprocess
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestChildEnvironment(t *testing.T) {
+	env := []string{"A=1", "RUNLIB_STATUS_FILE=x", "runlib_locale=y"}
+	cases := []struct {
+		name      string
+		sub       Subprocess
+		noInherit bool
+		want      []string
+	}{
+		{"inherited", Subprocess{Locale: LOCALE_HOST}, false, nil},
+		{"explicit", Subprocess{Locale: LOCALE_HOST, NoInheritEnvironment: true, Environment: env}, true,
+			[]string{"A=1"}},
+		{"inject", Subprocess{Locale: LOCALE_HOST, NoInheritEnvironment: true, Environment: env,
+			Options: &PlatformOptions{InjectEnv: map[string]string{"B": "2", "A": "3"}}}, true,
+			[]string{"A=1", "RUNLIB_A=3", "RUNLIB_B=2"}},
+		{"injected DLL", Subprocess{Locale: LOCALE_HOST, NoInheritEnvironment: true, Environment: env,
+			Options: &PlatformOptions{InjectDLL: []string{"shim.dll"}}}, true, []string{"A=1"}},
+		{"nonce", Subprocess{Locale: LOCALE_HOST, NoInheritEnvironment: true, Environment: env,
+			Nonce: &Nonce{Value: "n", EnvName: "NONCE"}}, true, []string{"A=1", "NONCE=n"}},
+	}
+	for _, c := range cases {
+		got, err := c.sub.childEnvironment()
+		if err != nil {
+			t.Errorf("%s: %s", c.name, err)
+			continue
+		}
+		if got.NoInherit != c.noInherit || !reflect.DeepEqual(got.Env, c.want) {
+			t.Errorf("%s: got %v %q, want %v %q", c.name, got.NoInherit, got.Env, c.noInherit, c.want)
+		}
+	}
+}
@@ -0,0 +1,57 @@
+package subprocess
+
+import (
+	"os"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// inputStall watches how much of the child's stdin is still unread, through a handle of our own to its read end.
+// The child has stalled when there's been unread input for Subprocess.InputStallTimeout and it never went down:
+// with an interactor waiting for an answer, that's a deadlock.
+type inputStall struct {
+	peek    *inputPeek
+	pending uint32
+	since   time.Time
+}
+
+// watchInput sets up the stall check and the input wait accounting on f, the stdin the child gets, if sub asks for
+// them and stdin comes from a pipe or a socket. Regular files always have unread data, so they aren't watched.
+func (d *SubprocessData) watchInput(w *Redirect, f *os.File) {
+	if (d.inputStallTimeout <= 0 && !d.excludeInputWait) || (w.Mode != REDIRECT_PIPE && w.Mode != REDIRECT_SOCKET) {
+		return
+	}
+	peek, err := newInputPeek(f)
+	if err != nil {
+		log.Warningf("watchInput: %s", err)
+		return
+	}
+	if d.inputStallTimeout > 0 {
+		d.stdInStall = &inputStall{peek: peek}
+	}
+	if d.excludeInputWait {
+		d.stdInWait = &inputWait{peek: peek}
+	}
+	// Our handle would keep the writer from seeing the child is gone.
+	d.afterExit = append(d.afterExit, peek.Close)
+	d.cleanupIfFailed = append(d.cleanupIfFailed, peek.Close)
+}
+
+// Update samples the unread input, and tells if the child has stalled, filling SubprocessResult.UnreadInput then.
+func (s *inputStall) Update(sub *Subprocess, result *SubprocessResult) bool {
+	if s == nil {
+		return false
+	}
+	n, err := s.peek.pending()
+	now := time.Now()
+	if err != nil || n == 0 || n < s.pending || s.since.IsZero() {
+		s.since = now
+	}
+	s.pending = n
+	if n == 0 || now.Sub(s.since) < sub.InputStallTimeout {
+		return false
+	}
+	result.UnreadInput = n
+	return true
+}
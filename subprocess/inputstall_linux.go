@@ -0,0 +1,33 @@
+package subprocess
+
+import (
+	"os"
+	"syscall"
+
+	"golang.org/x/sys/unix"
+)
+
+type inputPeek struct {
+	fd int
+}
+
+func newInputPeek(f *os.File) (*inputPeek, error) {
+	fd, err := syscall.Dup(int(f.Fd()))
+	if err != nil {
+		return nil, os.NewSyscallError("dup", err)
+	}
+	return &inputPeek{fd: fd}, nil
+}
+
+func (p *inputPeek) pending() (uint32, error) {
+	// TIOCINQ is FIONREAD, which works on pipes and sockets too.
+	n, err := unix.IoctlGetInt(p.fd, unix.TIOCINQ)
+	return uint32(n), err
+}
+
+func (p *inputPeek) Close() {
+	if p.fd >= 0 {
+		syscall.Close(p.fd)
+		p.fd = -1
+	}
+}
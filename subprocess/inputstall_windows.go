@@ -0,0 +1,32 @@
+package subprocess
+
+import (
+	"os"
+
+	"github.com/contester/runlib/win32"
+	"golang.org/x/sys/windows"
+)
+
+type inputPeek struct {
+	h windows.Handle
+}
+
+func newInputPeek(f *os.File) (*inputPeek, error) {
+	p := windows.CurrentProcess()
+	var h windows.Handle
+	if err := windows.DuplicateHandle(p, windows.Handle(f.Fd()), p, &h, 0, false, windows.DUPLICATE_SAME_ACCESS); err != nil {
+		return nil, os.NewSyscallError("DuplicateHandle", err)
+	}
+	return &inputPeek{h: h}, nil
+}
+
+func (p *inputPeek) pending() (uint32, error) {
+	return win32.PeekNamedPipeAvailable(p.h)
+}
+
+func (p *inputPeek) Close() {
+	if p.h != windows.InvalidHandle {
+		windows.CloseHandle(p.h)
+		p.h = windows.InvalidHandle
+	}
+}
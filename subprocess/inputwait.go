@@ -0,0 +1,31 @@
+package subprocess
+
+import "time"
+
+// inputWait adds up wall time the child spent waiting for input, see Subprocess.ExcludeInputWait. A TimeQuantum
+// counts if stdin had nothing unread at both its ends, and the child used no CPU time in it.
+type inputWait struct {
+	peek    *inputPeek
+	sampled bool
+	wall    time.Duration
+	cpu     time.Duration
+	empty   bool
+}
+
+func (w *inputWait) Update(result *SubprocessResult) {
+	if w == nil {
+		return
+	}
+	n, err := w.peek.pending()
+	empty := err == nil && n == 0
+	cpu := result.UserTime + result.KernelTime
+	if w.sampled && w.empty && empty && cpu == w.cpu && result.WallTime > w.wall {
+		result.InputWaitTime += result.WallTime - w.wall
+	}
+	w.sampled, w.wall, w.cpu, w.empty = true, result.WallTime, cpu, empty
+}
+
+// chargedWallTime is the wall time WallTimeLimit applies to.
+func (r *SubprocessResult) chargedWallTime() time.Duration {
+	return r.WallTime - r.InputWaitTime
+}
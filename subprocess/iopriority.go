@@ -0,0 +1,41 @@
+package subprocess
+
+import "fmt"
+
+// IoPriority of the process, Windows only. Lower ones keep a solution from starving the host's disk, but slow its
+// I/O down, and so add to its wall time: don't use them with tight wall time limits unless that's intended.
+type IoPriority uint32
+
+const (
+	// Keep the default, which is normal.
+	IO_PRIORITY_DEFAULT IoPriority = iota
+	IO_PRIORITY_NORMAL
+	IO_PRIORITY_LOW
+	IO_PRIORITY_VERY_LOW
+)
+
+var ioPriorityNames = map[IoPriority]string{
+	IO_PRIORITY_NORMAL:   "normal",
+	IO_PRIORITY_LOW:      "low",
+	IO_PRIORITY_VERY_LOW: "very-low",
+}
+
+func (p IoPriority) String() string {
+	if name, ok := ioPriorityNames[p]; ok {
+		return name
+	}
+	return "default"
+}
+
+// ParseIoPriority takes the names String returns.
+func ParseIoPriority(s string) (IoPriority, error) {
+	if s == "" || s == "default" {
+		return IO_PRIORITY_DEFAULT, nil
+	}
+	for p, name := range ioPriorityNames {
+		if name == s {
+			return p, nil
+		}
+	}
+	return IO_PRIORITY_DEFAULT, fmt.Errorf("unknown I/O priority %q, known are normal, low, very-low", s)
+}
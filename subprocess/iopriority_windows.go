@@ -0,0 +1,36 @@
+package subprocess
+
+import (
+	"github.com/contester/runlib/win32"
+
+	log "github.com/sirupsen/logrus"
+)
+
+var ioPriorityHints = map[IoPriority]uint32{
+	IO_PRIORITY_NORMAL:   win32.IoPriorityNormal,
+	IO_PRIORITY_LOW:      win32.IoPriorityLow,
+	IO_PRIORITY_VERY_LOW: win32.IoPriorityVeryLow,
+}
+
+// setIoPriority applies PlatformOptions.IoPriority, and reads it back for SubprocessResult.IoPriority. Like with
+// other priorities, failing to set it isn't fatal.
+func setIoPriority(d *SubprocessData, p IoPriority) {
+	hint, ok := ioPriorityHints[p]
+	if !ok {
+		return
+	}
+	if err := win32.SetProcessIoPriority(d.platformData.hProcess, hint); err != nil {
+		log.Warningf("setIoPriority: %s: %s", p, err)
+		return
+	}
+	applied, err := win32.GetProcessIoPriority(d.platformData.hProcess)
+	if err != nil {
+		log.Warningf("setIoPriority: %s", err)
+		return
+	}
+	for k, v := range ioPriorityHints {
+		if v == applied {
+			d.platformData.ioPriority = k
+		}
+	}
+}
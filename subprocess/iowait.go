@@ -0,0 +1,41 @@
+package subprocess
+
+import "time"
+
+// ioWaitEstimator splits wall time the process spent without using CPU into idle time and I/O wait. A sample where
+// CPU time didn't advance counts as idle if the process did no I/O operations in it. Waiting on a pipe, like reading
+// from an interactor, is I/O too.
+type ioWaitEstimator struct {
+	sampled bool
+	wall    time.Duration
+	cpu     time.Duration
+	ops     uint64
+	idle    time.Duration
+}
+
+func newIoWaitEstimator(sub *Subprocess) *ioWaitEstimator {
+	if !sub.MeasureIoWait {
+		return nil
+	}
+	return &ioWaitEstimator{}
+}
+
+func (e *ioWaitEstimator) update(wall, cpu time.Duration, ops uint64) {
+	if e.sampled && cpu == e.cpu && ops == e.ops && wall > e.wall {
+		e.idle += wall - e.wall
+	}
+	e.sampled, e.wall, e.cpu, e.ops = true, wall, cpu, ops
+}
+
+// setResult fills IoWaitEstimate as wall time minus CPU time minus idle time, or 0 if CPU time is larger, as with
+// several busy threads.
+func (e *ioWaitEstimator) setResult(result *SubprocessResult) {
+	if e == nil {
+		return
+	}
+	wait := result.WallTime - result.UserTime - result.KernelTime - e.idle
+	if wait < 0 {
+		wait = 0
+	}
+	result.IoWaitEstimate = wait
+}
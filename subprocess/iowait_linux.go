@@ -0,0 +1,27 @@
+package subprocess
+
+import (
+	"os"
+	"strconv"
+	"strings"
+)
+
+// Update uses read and write syscall counts from /proc/<pid>/io, so only I/O of the main process is seen.
+func (e *ioWaitEstimator) Update(pid int, result *SubprocessResult) {
+	if e == nil {
+		return
+	}
+	data, err := os.ReadFile("/proc/" + strconv.Itoa(pid) + "/io")
+	if err != nil {
+		return
+	}
+	var ops uint64
+	for _, line := range strings.Split(string(data), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) == 2 && (fields[0] == "syscr:" || fields[0] == "syscw:") {
+			v, _ := strconv.ParseUint(fields[1], 10, 64)
+			ops += v
+		}
+	}
+	e.update(result.WallTime, result.UserTime+result.KernelTime, ops)
+}
@@ -0,0 +1,73 @@
+package subprocess
+
+import (
+	"testing"
+	"time"
+)
+
+func TestIoWaitEstimator(t *testing.T) {
+	type sample struct {
+		wall, cpu time.Duration
+		ops       uint64
+	}
+	ms := time.Millisecond
+	cases := []struct {
+		samples []sample
+		idle    time.Duration
+	}{
+		{nil, 0},
+		{[]sample{{100 * ms, 0, 0}}, 0},
+		// Neither CPU time nor I/O operations advanced: idle.
+		{[]sample{{100 * ms, 10 * ms, 5}, {350 * ms, 10 * ms, 5}}, 250 * ms},
+		{[]sample{{100 * ms, 10 * ms, 5}, {200 * ms, 10 * ms, 5}, {300 * ms, 10 * ms, 5}}, 200 * ms},
+		// I/O operations without CPU time: waiting for I/O.
+		{[]sample{{100 * ms, 10 * ms, 5}, {200 * ms, 10 * ms, 6}}, 0},
+		// CPU time advanced: busy.
+		{[]sample{{100 * ms, 10 * ms, 5}, {200 * ms, 20 * ms, 5}}, 0},
+		{[]sample{{100 * ms, 10 * ms, 5}, {200 * ms, 20 * ms, 5}, {300 * ms, 20 * ms, 5}, {400 * ms, 20 * ms, 7}}, 100 * ms},
+		// Wall time not advancing adds nothing.
+		{[]sample{{100 * ms, 10 * ms, 5}, {100 * ms, 10 * ms, 5}}, 0},
+	}
+	for _, c := range cases {
+		e := newIoWaitEstimator(&Subprocess{MeasureIoWait: true})
+		for _, s := range c.samples {
+			e.update(s.wall, s.cpu, s.ops)
+		}
+		if e.idle != c.idle {
+			t.Errorf("%v: got idle %s, want %s", c.samples, e.idle, c.idle)
+		}
+	}
+}
+
+func TestIoWaitEstimatorResult(t *testing.T) {
+	ms := time.Millisecond
+	cases := []struct {
+		idle               time.Duration
+		wall, user, kernel time.Duration
+		want               time.Duration
+	}{
+		{0, 1000 * ms, 300 * ms, 100 * ms, 600 * ms},
+		{200 * ms, 1000 * ms, 300 * ms, 100 * ms, 400 * ms},
+		{600 * ms, 1000 * ms, 300 * ms, 100 * ms, 0},
+		// Several busy threads: CPU time over wall time.
+		{0, 1000 * ms, 1500 * ms, 100 * ms, 0},
+	}
+	for _, c := range cases {
+		e := &ioWaitEstimator{idle: c.idle}
+		r := SubprocessResult{TimeStats: TimeStats{WallTime: c.wall, UserTime: c.user, KernelTime: c.kernel}}
+		e.setResult(&r)
+		if r.IoWaitEstimate != c.want {
+			t.Errorf("idle %s, wall %s, cpu %s+%s: got %s, want %s", c.idle, c.wall, c.user, c.kernel,
+				r.IoWaitEstimate, c.want)
+		}
+	}
+	if newIoWaitEstimator(&Subprocess{}) != nil {
+		t.Error("estimator without MeasureIoWait")
+	}
+	var none *ioWaitEstimator
+	r := SubprocessResult{TimeStats: TimeStats{WallTime: time.Second}}
+	none.setResult(&r)
+	if r.IoWaitEstimate != 0 {
+		t.Errorf("nil estimator: got %s", r.IoWaitEstimate)
+	}
+}
@@ -0,0 +1,25 @@
+package subprocess
+
+import (
+	"syscall"
+
+	"github.com/contester/runlib/win32"
+)
+
+func (e *ioWaitEstimator) Update(d *PlatformData, result *SubprocessResult) {
+	if e == nil {
+		return
+	}
+	var counters *win32.IoCounters
+	var err error
+	if d.hJob != syscall.InvalidHandle {
+		counters, err = win32.GetJobObjectIoCounters(d.hJob)
+	} else {
+		counters, err = win32.GetProcessIoCounters(d.hProcess)
+	}
+	if err != nil {
+		return
+	}
+	e.update(result.WallTime, result.UserTime+result.KernelTime,
+		counters.ReadOperationCount+counters.WriteOperationCount+counters.OtherOperationCount)
+}
@@ -0,0 +1,131 @@
+package subprocess
+
+import (
+	"fmt"
+	"sync/atomic"
+	"syscall"
+
+	"github.com/contester/runlib/win32"
+	"golang.org/x/sys/windows"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// JobNotification is a message from the job object, see win32.JOB_OBJECT_MSG_*.
+type JobNotification struct {
+	Message uint32
+	// Process the message is about, 0 for job-wide ones.
+	Pid uint32
+}
+
+// jobMonitor reads notifications from the job completion port, instead of polling the job for them.
+type jobMonitor struct {
+	port    syscall.Handle
+	handler func(JobNotification)
+	done    chan struct{}
+
+	processLimitHit atomic.Bool
+
+	// Counted from notifications, for Subprocess.TotalProcessLimit; 0 is no limit.
+	job           syscall.Handle
+	totalLimit    uint32
+	created       atomic.Uint32
+	active        atomic.Int32
+	peakActive    atomic.Uint32
+	totalLimitHit atomic.Bool
+}
+
+func newJobMonitor(job syscall.Handle, totalLimit uint32, handler func(JobNotification)) (*jobMonitor, error) {
+	port, err := syscall.CreateIoCompletionPort(syscall.InvalidHandle, 0, 0, 1)
+	if err != nil {
+		return nil, fmt.Errorf("CreateIoCompletionPort: %w", err)
+	}
+	if err = win32.SetJobObjectAssociateCompletionPort(job, &win32.JobObjectAssociateCompletionPort{
+		CompletionKey:  uintptr(job),
+		CompletionPort: port,
+	}); err != nil {
+		syscall.CloseHandle(port)
+		return nil, err
+	}
+	m := &jobMonitor{
+		port:       port,
+		handler:    handler,
+		done:       make(chan struct{}),
+		job:        job,
+		totalLimit: totalLimit,
+	}
+	go m.run()
+	return m, nil
+}
+
+func (m *jobMonitor) run() {
+	defer close(m.done)
+	for {
+		msg, _, value, err := win32.GetQueuedCompletionStatus(m.port, syscall.INFINITE)
+		if err != nil {
+			// Port is closed.
+			return
+		}
+		n := JobNotification{Message: msg}
+		if msg != win32.JOB_OBJECT_MSG_END_OF_JOB_TIME && msg != win32.JOB_OBJECT_MSG_ACTIVE_PROCESS_LIMIT &&
+			msg != win32.JOB_OBJECT_MSG_ACTIVE_PROCESS_ZERO && msg != win32.JOB_OBJECT_MSG_JOB_MEMORY_LIMIT {
+			n.Pid = uint32(value)
+		}
+
+		switch msg {
+		case win32.JOB_OBJECT_MSG_ACTIVE_PROCESS_LIMIT:
+			m.processLimitHit.Store(true)
+		case win32.JOB_OBJECT_MSG_NEW_PROCESS:
+			m.processCreated()
+		case win32.JOB_OBJECT_MSG_EXIT_PROCESS, win32.JOB_OBJECT_MSG_ABNORMAL_EXIT_PROCESS:
+			m.active.Add(-1)
+		}
+		if m.handler != nil {
+			m.handler(n)
+		}
+	}
+}
+
+func (m *jobMonitor) processCreated() {
+	created := m.created.Add(1)
+	if active := uint32(m.active.Add(1)); active > m.peakActive.Load() {
+		// Only this goroutine writes it.
+		m.peakActive.Store(active)
+	}
+	if m.totalLimit > 0 && created > m.totalLimit && !m.totalLimitHit.Swap(true) {
+		// Don't wait for the monitoring loop: a process that forks in a loop can create many more in a time quantum.
+		if err := windows.TerminateJobObject(windows.Handle(m.job), 0); err != nil {
+			log.Errorf("Terminating job over the total process limit: %s", err)
+		}
+	}
+}
+
+// TotalProcessLimitHit reports if the job created more processes than the total limit.
+func (m *jobMonitor) TotalProcessLimitHit() bool {
+	return m != nil && m.totalLimitHit.Load()
+}
+
+// PeakActiveProcesses is the most processes seen running at once. Notifications may be dropped when the port
+// queue is full, so it's a lower bound.
+func (m *jobMonitor) PeakActiveProcesses() uint32 {
+	if m == nil {
+		return 0
+	}
+	return m.peakActive.Load()
+}
+
+// ProcessLimitHit reports if the job tried to exceed ActiveProcessLimit.
+func (m *jobMonitor) ProcessLimitHit() bool {
+	return m != nil && m.processLimitHit.Load()
+}
+
+// Close stops the monitor. Messages still queued are dropped, handler isn't called after Close returns.
+func (m *jobMonitor) Close() {
+	if m == nil {
+		return
+	}
+	if err := syscall.CloseHandle(m.port); err != nil {
+		log.Errorf("Closing job completion port: %s", err)
+	}
+	<-m.done
+}
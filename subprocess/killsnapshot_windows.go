@@ -0,0 +1,63 @@
+package subprocess
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+	"syscall"
+
+	"github.com/contester/runlib/win32"
+	"golang.org/x/sys/windows"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// takeKillSnapshot records what the process threads were doing. Errors are only logged: the process is about to
+// be killed anyway.
+func takeKillSnapshot(process syscall.Handle, dir string) *KillSnapshot {
+	pid, err := windows.GetProcessId(windows.Handle(process))
+	if err != nil {
+		log.Errorf("takeKillSnapshot: GetProcessId: %s", err)
+		return nil
+	}
+
+	var result KillSnapshot
+	if tids, err := win32.ListProcessThreads(pid); err == nil {
+		for _, tid := range tids {
+			result.Threads = append(result.Threads, snapshotThread(tid))
+		}
+	} else {
+		log.Errorf("takeKillSnapshot: %s", err)
+	}
+
+	name := filepath.Join(dir, "kill-"+strconv.FormatUint(uint64(pid), 10)+".dmp")
+	f, err := os.Create(name)
+	if err != nil {
+		log.Errorf("takeKillSnapshot: %s", err)
+		return &result
+	}
+	err = win32.MiniDumpWriteDump(process, pid, syscall.Handle(f.Fd()),
+		win32.MiniDumpNormal|win32.MiniDumpWithThreadInfo|win32.MiniDumpWithUnloadedModules)
+	f.Close()
+	if err != nil {
+		log.Errorf("takeKillSnapshot: %s", err)
+		os.Remove(name)
+		return &result
+	}
+	result.DumpFile = name
+	return &result
+}
+
+func snapshotThread(tid uint32) ThreadSnapshot {
+	result := ThreadSnapshot{ID: tid}
+	thread, err := windows.OpenThread(windows.THREAD_QUERY_LIMITED_INFORMATION, false, tid)
+	if err != nil {
+		return result
+	}
+	defer windows.CloseHandle(thread)
+	if _, _, kernel, user, err := win32.GetThreadTimes(syscall.Handle(thread)); err == nil {
+		result.KernelTime = filetimeToDuration(&kernel)
+		result.UserTime = filetimeToDuration(&user)
+	}
+	return result
+}
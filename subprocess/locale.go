@@ -0,0 +1,30 @@
+package subprocess
+
+import "strings"
+
+// Subprocess.Locale that leaves the locale environment as the host has it.
+const LOCALE_HOST = "host"
+
+// Subprocess.Locale if it's not set.
+const DEFAULT_LOCALE = "C"
+
+func (sub *Subprocess) locale() string {
+	if sub.Locale == "" {
+		return DEFAULT_LOCALE
+	}
+	return sub.Locale
+}
+
+// withLocale sets LANG and LC_ALL to locale, dropping other LC_ variables and LANGUAGE, which would override them
+// for some categories or messages.
+func withLocale(env []string, locale string) []string {
+	result := make([]string, 0, len(env)+2)
+	for _, v := range env {
+		name, _, _ := strings.Cut(v, "=")
+		if name == "LANG" || name == "LANGUAGE" || strings.HasPrefix(name, "LC_") {
+			continue
+		}
+		result = append(result, v)
+	}
+	return append(result, "LANG="+locale, "LC_ALL="+locale)
+}
@@ -0,0 +1,43 @@
+package subprocess
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/contester/runlib/win32"
+)
+
+// installManifest copies PlatformOptions.Manifest next to the executable, as its external manifest. The loader
+// builds the default activation context of the process from it, so the side-by-side assemblies it names, like a
+// particular MSVC runtime, are the ones bound whatever else the host has installed. An embedded manifest wins over
+// an external one (unless the host sets PreferExternalManifest), so such executables are refused instead of
+// quietly binding to what they embed.
+func installManifest(sub *Subprocess) error {
+	if sub.Options == nil || sub.Options.Manifest == "" {
+		return nil
+	}
+	if sub.Cmd.ApplicationName == "" {
+		return fmt.Errorf("Manifest needs ApplicationName: %w", ErrUserError)
+	}
+	// LoadLibraryEx searches for relative names.
+	name, err := filepath.Abs(sub.Cmd.ApplicationName)
+	if err != nil {
+		return fmt.Errorf("installManifest: %w", err)
+	}
+	embedded, err := win32.HasEmbeddedManifest(name)
+	if err != nil {
+		return fmt.Errorf("installManifest: %w", err)
+	}
+	if embedded {
+		return fmt.Errorf("%w: %q has an embedded manifest, which takes precedence over %q", ErrUserError, name, sub.Options.Manifest)
+	}
+	data, err := os.ReadFile(sub.Options.Manifest)
+	if err != nil {
+		return fmt.Errorf("installManifest: %w", err)
+	}
+	if err = os.WriteFile(name+".manifest", data, 0644); err != nil {
+		return fmt.Errorf("installManifest: %w", err)
+	}
+	return nil
+}
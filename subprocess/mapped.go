@@ -0,0 +1,179 @@
+package subprocess
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/contester/runlib/compare"
+	log "github.com/sirupsen/logrus"
+)
+
+// Used for REDIRECT_MAPPED when Redirect.MaxOutputSize isn't set.
+const MAX_MAPPED_OUTPUT = 256 * 1024 * 1024
+
+var errMappedFull = errors.New("mapped output is full")
+
+// mappedFile writes to a file through a memory mapping of its first len(data) bytes. Close unmaps it and cuts the
+// file to what was written.
+type mappedFile struct {
+	f     *os.File
+	data  []byte
+	n     int
+	unmap func() error
+}
+
+func createMappedFile(name string, size int64) (*mappedFile, error) {
+	f, err := os.OpenFile(name, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0666)
+	if err != nil {
+		return nil, err
+	}
+	if err = f.Truncate(size); err != nil {
+		f.Close()
+		return nil, err
+	}
+	data, unmap, err := mapFile(f, size)
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	return &mappedFile{f: f, data: data, unmap: unmap}, nil
+}
+
+func (m *mappedFile) Write(p []byte) (int, error) {
+	n := copy(m.data[m.n:], p)
+	m.n += n
+	if n < len(p) {
+		return n, errMappedFull
+	}
+	return n, nil
+}
+
+func (m *mappedFile) Close() error {
+	if m.f == nil {
+		return nil
+	}
+	err := m.unmap()
+	if terr := m.f.Truncate(int64(m.n)); err == nil {
+		err = terr
+	}
+	if cerr := m.f.Close(); err == nil {
+		err = cerr
+	}
+	m.f, m.data = nil, nil
+	return err
+}
+
+// contents reads back what was written, until Close.
+func (m *mappedFile) contents() (io.Reader, error) {
+	return bytes.NewReader(m.data[:m.n]), nil
+}
+
+// plainOutput is where SetupOutputMapped drains output to when the file can't be mapped, but the output still has
+// to be compared.
+type plainOutput struct {
+	*os.File
+}
+
+func (p plainOutput) contents() (io.Reader, error) {
+	if _, err := p.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+	return bufio.NewReader(p.File), nil
+}
+
+type mappedOutput interface {
+	io.WriteCloser
+	contents() (io.Reader, error)
+}
+
+// SetupOutputMapped drains output into filename through a memory mapping, which is cheaper than writing big outputs
+// through a file. Output is cut at maxOutputSize, like for REDIRECT_MEMORY. With answerNames, once the child is
+// done the output is compared with each of them straight from the mapping, as for REDIRECT_COMPARE, and the file
+// is kept. If the mapping can't be created, say for lack of address space, the child writes to the file as with
+// REDIRECT_FILE, or, with answerNames, output is copied to the file and read back from it for comparison.
+func (d *SubprocessData) SetupOutputMapped(filename string, maxOutputSize int64, answerNames []string, opts compare.Options, isStdErr bool) (*os.File, error) {
+	if len(answerNames) > 0 && isStdErr {
+		return nil, fmt.Errorf("%w: comparison is only supported for stdout", ErrUserError)
+	}
+	if maxOutputSize <= 0 {
+		maxOutputSize = MAX_MAPPED_OUTPUT
+	}
+	var out mappedOutput
+	m, err := createMappedFile(filename, maxOutputSize)
+	if err == nil {
+		out = m
+	} else if len(answerNames) == 0 {
+		log.Warningf("SetupOutputMapped: %s, writing %q as a file", err, filename)
+		return d.SetupFile(filename, false, maxOutputSize, isStdErr)
+	} else {
+		log.Warningf("SetupOutputMapped: %s, comparing %q as a file", err, filename)
+		f, e := os.OpenFile(filename, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0666)
+		if e != nil {
+			return nil, fmt.Errorf("SetupOutputMapped: %w", e)
+		}
+		out = plainOutput{f}
+	}
+
+	var answers []*os.File
+	closeAnswers := func() {
+		for _, f := range answers {
+			f.Close()
+		}
+	}
+	for _, name := range answerNames {
+		answer, e := os.Open(name)
+		if e != nil {
+			closeAnswers()
+			out.Close()
+			return nil, fmt.Errorf("SetupOutputMapped: %w", e)
+		}
+		answers = append(answers, answer)
+	}
+
+	reader, writer, e := os.Pipe()
+	if e != nil {
+		closeAnswers()
+		out.Close()
+		return nil, fmt.Errorf("SetupOutputMapped: os.Pipe: %w", e)
+	}
+
+	d.closeAfterStart = append(d.closeAfterStart, writer)
+
+	size := d.outputCounter(isStdErr)
+	d.startAfterStart = append(d.startAfterStart, func() error {
+		defer closeAnswers()
+		_, err := io.Copy(out, io.LimitReader(countingReader{reader, size}, maxOutputSize))
+		reader.Close()
+		if err == nil && len(answers) > 0 {
+			err = d.compareMapped(out, answers, opts)
+		}
+		if cerr := out.Close(); err == nil {
+			err = cerr
+		}
+		return err
+	})
+
+	d.cleanupIfFailed = append(d.cleanupIfFailed, func() {
+		reader.Close()
+		closeAnswers()
+		out.Close()
+	})
+	return writer, nil
+}
+
+func (d *SubprocessData) compareMapped(out mappedOutput, answerFiles []*os.File, opts compare.Options) error {
+	r, err := out.contents()
+	if err != nil {
+		return err
+	}
+	answers := make([]io.Reader, 0, len(answerFiles))
+	for _, f := range answerFiles {
+		answers = append(answers, bufio.NewReader(f))
+	}
+	d.comparison, err = compare.StreamsAny(r, answers, opts)
+	return err
+}
@@ -0,0 +1,55 @@
+package subprocess
+
+import (
+	"io"
+	"os"
+	"testing"
+)
+
+const (
+	allocateEnv  = "RUNLIB_TEST_ALLOCATE"
+	readStdinEnv = "RUNLIB_TEST_READ_STDIN"
+)
+
+// The test binary doubles as the child: with allocateEnv set it touches some memory and exits right away, with
+// readStdinEnv it reads its stdin to the end.
+func TestMain(m *testing.M) {
+	if os.Getenv(readStdinEnv) != "" {
+		io.Copy(io.Discard, os.Stdin)
+		os.Exit(0)
+	}
+	if os.Getenv(allocateEnv) != "" {
+		b := make([]byte, 64*1024*1024)
+		for i := range b {
+			b[i] = byte(i)
+		}
+		os.Exit(0)
+	}
+	os.Exit(m.Run())
+}
+
+func TestPeakMemoryAfterExit(t *testing.T) {
+	self, err := os.Executable()
+	if err != nil {
+		t.Fatal(err)
+	}
+	os.Setenv(allocateEnv, "1")
+	defer os.Unsetenv(allocateEnv)
+
+	sub := SubprocessCreate()
+	sub.Cmd = &CommandLine{ApplicationName: self}
+	sub.MemoryLimit = 1024 * 1024 * 1024
+	result, err := sub.Execute()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.ExitCode != 0 {
+		t.Fatalf("child exited with %d", result.ExitCode)
+	}
+	if result.PeakProcessMemory < 64*1024*1024 {
+		t.Errorf("PeakProcessMemory is %d, the child allocated 64M", result.PeakProcessMemory)
+	}
+	if result.PeakMemory < 64*1024*1024 {
+		t.Errorf("PeakMemory is %d, the child allocated 64M", result.PeakMemory)
+	}
+}
@@ -0,0 +1,61 @@
+package subprocess
+
+import (
+	"time"
+)
+
+// Upper bound on the number of samples kept per run, to keep result size sane for long runs with small interval.
+const MAX_MEMORY_SAMPLES = 4096
+
+// MemorySample is a single point of the memory time series, taken MemorySampleInterval apart.
+type MemorySample struct {
+	Offset     time.Duration // since process start
+	Commit     uint64
+	WorkingSet uint64
+}
+
+type memorySampler struct {
+	stop    chan struct{}
+	done    chan struct{}
+	samples []MemorySample
+}
+
+func startMemorySampler(interval time.Duration, sample func() (commit, workingSet uint64)) *memorySampler {
+	if interval <= 0 {
+		return nil
+	}
+	m := &memorySampler{
+		stop: make(chan struct{}),
+		done: make(chan struct{}),
+	}
+	go func() {
+		defer close(m.done)
+		start := time.Now()
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for len(m.samples) < MAX_MEMORY_SAMPLES {
+			commit, ws := sample()
+			m.samples = append(m.samples, MemorySample{
+				Offset:     time.Since(start),
+				Commit:     commit,
+				WorkingSet: ws,
+			})
+			select {
+			case <-m.stop:
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+	return m
+}
+
+// Stop must be called before the process handle is released.
+func (m *memorySampler) Stop() []MemorySample {
+	if m == nil {
+		return nil
+	}
+	close(m.stop)
+	<-m.done
+	return m.samples
+}
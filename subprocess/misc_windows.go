@@ -3,10 +3,12 @@ package subprocess
 import (
 	"fmt"
 	"runtime"
+	"sync"
 	"syscall"
 	"unsafe"
 
 	"github.com/contester/runlib/win32"
+	"golang.org/x/sys/windows"
 
 	log "github.com/sirupsen/logrus"
 )
@@ -83,3 +85,51 @@ func (s *LoginInfo) Prepare() error {
 	runtime.SetFinalizer(s, logout)
 	return nil
 }
+
+// grantFileAccess allows the login to read and execute the file, in addition to what its ACL already allows.
+func grantFileAccess(path string, login *LoginInfo) error {
+	tokenUser, err := windows.Token(login.HUser).GetTokenUser()
+	if err != nil {
+		return fmt.Errorf("GetTokenUser: %w", err)
+	}
+	return grantSidAccess(path, windows.SE_FILE_OBJECT, tokenUser.User.Sid, windows.GENERIC_READ|windows.GENERIC_EXECUTE, windows.NO_INHERITANCE)
+}
+
+func grantSidAccess(path string, objectType windows.SE_OBJECT_TYPE, sid *windows.SID, access windows.ACCESS_MASK, inheritance uint32) error {
+	sd, err := windows.GetNamedSecurityInfo(path, objectType, windows.DACL_SECURITY_INFORMATION)
+	if err != nil {
+		return fmt.Errorf("GetNamedSecurityInfo(%q): %w", path, err)
+	}
+	dacl, _, err := sd.DACL()
+	if err != nil {
+		return fmt.Errorf("DACL(%q): %w", path, err)
+	}
+	newDacl, err := windows.ACLFromEntries([]windows.EXPLICIT_ACCESS{{
+		AccessPermissions: access,
+		AccessMode:        windows.GRANT_ACCESS,
+		Inheritance:       inheritance,
+		Trustee: windows.TRUSTEE{
+			TrusteeForm:  windows.TRUSTEE_IS_SID,
+			TrusteeType:  windows.TRUSTEE_IS_UNKNOWN,
+			TrusteeValue: windows.TrusteeValueFromSID(sid),
+		},
+	}}, dacl)
+	if err != nil {
+		return fmt.Errorf("ACLFromEntries: %w", err)
+	}
+	if err = windows.SetNamedSecurityInfo(path, objectType, windows.DACL_SECURITY_INFORMATION, nil, nil, newDacl, nil); err != nil {
+		return fmt.Errorf("SetNamedSecurityInfo(%q): %w", path, err)
+	}
+	return nil
+}
+
+var crashReportingDisabled sync.Once
+
+// disableCrashReporting sets SEM_NOGPFAULTERRORBOX in our error mode, for processes started without a job to
+// inherit it: Windows Error Reporting, and so its dumps, is never invoked for their crashes. Processes with
+// Subprocess.CrashDumps get the default mode instead.
+func disableCrashReporting() {
+	crashReportingDisabled.Do(func() {
+		windows.SetErrorMode(windows.SetErrorMode(0) | windows.SEM_NOGPFAULTERRORBOX)
+	})
+}
@@ -0,0 +1,29 @@
+package subprocess
+
+import "strings"
+
+// moduleCounter collects distinct modules (DLLs, shared objects) seen mapped into the child. It's sampled every
+// TimeQuantum, so a module loaded and unloaded between samples is missed.
+type moduleCounter struct {
+	seen map[string]struct{}
+}
+
+func newModuleCounter(sub *Subprocess) *moduleCounter {
+	if !sub.CountModules && sub.ModuleLimit == 0 {
+		return nil
+	}
+	return &moduleCounter{seen: make(map[string]struct{})}
+}
+
+func (c *moduleCounter) add(path string) {
+	c.seen[strings.ToLower(path)] = struct{}{}
+}
+
+// setResult fills the module count, and flags the run if it went over the limit. The run isn't stopped for that.
+func (c *moduleCounter) setResult(sub *Subprocess, result *SubprocessResult) {
+	if c == nil {
+		return
+	}
+	result.ModulesLoadedCount = uint32(len(c.seen))
+	result.ModuleLimitExceeded = sub.ModuleLimit > 0 && result.ModulesLoadedCount > sub.ModuleLimit
+}
@@ -0,0 +1,28 @@
+package subprocess
+
+import (
+	"bufio"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// Update adds files mapped executable into the process, from /proc/<pid>/maps.
+func (c *moduleCounter) Update(pid int) {
+	if c == nil {
+		return
+	}
+	f, err := os.Open("/proc/" + strconv.Itoa(pid) + "/maps")
+	if err != nil {
+		return
+	}
+	defer f.Close()
+	s := bufio.NewScanner(f)
+	for s.Scan() {
+		// address perms offset dev inode path
+		fields := strings.Fields(s.Text())
+		if len(fields) >= 6 && strings.Contains(fields[1], "x") && strings.HasPrefix(fields[5], "/") {
+			c.add(fields[5])
+		}
+	}
+}
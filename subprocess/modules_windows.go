@@ -0,0 +1,42 @@
+package subprocess
+
+import (
+	"syscall"
+
+	"github.com/contester/runlib/win32"
+	"golang.org/x/sys/windows"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// Job notifications don't include module loads, and debugging the child to get LOAD_DLL_DEBUG_EVENT would change
+// its behavior, so modules of the processes in the job are listed from a snapshot instead.
+func (c *moduleCounter) Update(d *PlatformData) {
+	if c == nil {
+		return
+	}
+	pids := []uintptr{}
+	if d.hJob != syscall.InvalidHandle {
+		ids, err := win32.GetJobProcessIds(d.hJob)
+		if err != nil {
+			log.Errorf("GetJobProcessIds: %s", err)
+		}
+		pids = ids
+	}
+	if len(pids) == 0 {
+		if pid, err := windows.GetProcessId(windows.Handle(d.hProcess)); err == nil {
+			pids = append(pids, uintptr(pid))
+		}
+	}
+	for _, pid := range pids {
+		// Fails while the process is starting or exiting, next sample will catch up.
+		modules, err := win32.ListProcessModules(uint32(pid))
+		if err != nil {
+			log.Debugf("ListProcessModules(%d): %s", pid, err)
+			continue
+		}
+		for _, m := range modules {
+			c.add(m)
+		}
+	}
+}
@@ -0,0 +1,106 @@
+package subprocess
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+// Nonce is a per-run value given to the process, which it's expected to echo, so outputs computed in advance
+// don't pass. Checking is up to the caller: a missing nonce is only reported in SubprocessResult.NonceFound.
+type Nonce struct {
+	// Random hex if empty, the value used is in SubprocessResult.Nonce.
+	Value string
+	// Environment variable to pass it in.
+	EnvName string
+	// Feed it to stdin as the first line, before the input.
+	FirstLine bool
+	// Look for it in stdout.
+	Verify bool
+}
+
+func (n *Nonce) generate() error {
+	if n == nil || n.Value != "" {
+		return nil
+	}
+	var b [16]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return fmt.Errorf("generating nonce: %w", err)
+	}
+	n.Value = hex.EncodeToString(b[:])
+	return nil
+}
+
+// environ returns env with the nonce variable added, or replaced if env has it already.
+func (n *Nonce) environ(env []string) []string {
+	if n == nil || n.EnvName == "" {
+		return env
+	}
+	result := make([]string, 0, len(env)+1)
+	for _, v := range env {
+		if !strings.HasPrefix(v, n.EnvName+"=") {
+			result = append(result, v)
+		}
+	}
+	return append(result, n.EnvName+"="+n.Value)
+}
+
+// prefixInput puts a pipe between src and the child, feeding prefix first. src may be nil, then the child gets
+// only the prefix. Like with captureInput, the copier isn't waited for.
+func (d *SubprocessData) prefixInput(src *os.File, prefix []byte) (*os.File, error) {
+	reader, writer, e := os.Pipe()
+	if e != nil {
+		return nil, fmt.Errorf("prefixInput: os.Pipe: %w", e)
+	}
+
+	if n := len(d.closeAfterStart); n > 0 && d.closeAfterStart[n-1] == src {
+		d.closeAfterStart = d.closeAfterStart[:n-1]
+	}
+	d.closeAfterStart = append(d.closeAfterStart, reader)
+
+	go func() {
+		if _, err := writer.Write(prefix); err == nil && src != nil {
+			io.Copy(writer, src)
+		}
+		if src != nil {
+			src.Close()
+		}
+		writer.Close()
+	}()
+	return reader, nil
+}
+
+// nonceMatcher looks for the nonce in a stream, across read boundaries.
+type nonceMatcher struct {
+	nonce []byte
+	tail  []byte
+	found bool
+}
+
+func (m *nonceMatcher) Write(p []byte) (int, error) {
+	if m.found {
+		return len(p), nil
+	}
+	m.tail = append(m.tail, p...)
+	if bytes.Contains(m.tail, m.nonce) {
+		m.found = true
+		m.tail = nil
+	} else if keep := len(m.nonce) - 1; len(m.tail) > keep {
+		m.tail = append(m.tail[:0], m.tail[len(m.tail)-keep:]...)
+	}
+	return len(p), nil
+}
+
+// verifyNonce relays stdout through nonceMatcher. Output we don't own, like inherited console, isn't checked.
+func (d *SubprocessData) verifyNonce(dst *os.File) (*os.File, error) {
+	m := &nonceMatcher{nonce: []byte(d.nonce.Value)}
+	f, ok, err := d.relayOutput(dst, "verifyNonce", func(p []byte) { m.Write(p) })
+	if ok {
+		d.nonceFound = m
+	}
+	return f, err
+}
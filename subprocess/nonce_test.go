@@ -0,0 +1,66 @@
+package subprocess
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestNonceMatcher(t *testing.T) {
+	cases := []struct {
+		nonce  string
+		writes []string
+		found  bool
+	}{
+		{"abc", nil, false},
+		{"abc", []string{"xabcx"}, true},
+		{"abc", []string{"xab", "cx"}, true},
+		{"abc", []string{"a", "b", "c"}, true},
+		{"abc", []string{"xa", "", "bc"}, true},
+		{"abc", []string{"ab", "xc"}, false},
+		{"abc", []string{"ab", "ab", "ab"}, false},
+		{"abc", []string{"aab", "c"}, true},
+		{"abc", []string{"abab", "cc"}, true},
+		{"a", []string{"xyz", "a"}, true},
+		{"a", []string{"xyz", "b"}, false},
+		{"a", []string{"a"}, true},
+		{"abc", []string{"abc", "xyz"}, true},
+	}
+	for _, c := range cases {
+		m := nonceMatcher{nonce: []byte(c.nonce)}
+		for _, s := range c.writes {
+			if n, err := m.Write([]byte(s)); n != len(s) || err != nil {
+				t.Errorf("%q, %q: Write(%q) = %d, %v", c.nonce, c.writes, s, n, err)
+			}
+		}
+		if m.found != c.found {
+			t.Errorf("%q, %q: got %v, want %v", c.nonce, c.writes, m.found, c.found)
+		}
+		if len(m.tail) >= len(c.nonce) {
+			t.Errorf("%q, %q: kept %q", c.nonce, c.writes, m.tail)
+		}
+	}
+}
+
+func TestNonceEnviron(t *testing.T) {
+	cases := []struct {
+		nonce *Nonce
+		env   []string
+		want  []string
+	}{
+		{nil, []string{"A=1"}, []string{"A=1"}},
+		{&Nonce{Value: "n"}, []string{"A=1"}, []string{"A=1"}},
+		{&Nonce{Value: "n", EnvName: "NONCE"}, nil, []string{"NONCE=n"}},
+		{&Nonce{Value: "n", EnvName: "NONCE"}, []string{"A=1"}, []string{"A=1", "NONCE=n"}},
+		{&Nonce{Value: "n", EnvName: "NONCE"}, []string{"NONCE=old", "A=1"}, []string{"A=1", "NONCE=n"}},
+		{&Nonce{Value: "n", EnvName: "NONCE"}, []string{"NONCE_2=x", "NONCE="}, []string{"NONCE_2=x", "NONCE=n"}},
+	}
+	for _, c := range cases {
+		env := append([]string(nil), c.env...)
+		if got := c.nonce.environ(env); !reflect.DeepEqual(got, c.want) {
+			t.Errorf("%+v, %q: got %q, want %q", c.nonce, c.env, got, c.want)
+		}
+		if !reflect.DeepEqual(env, c.env) {
+			t.Errorf("%+v, %q: env changed to %q", c.nonce, c.env, env)
+		}
+	}
+}
@@ -0,0 +1,101 @@
+package subprocess
+
+import (
+	"syscall"
+
+	"github.com/contester/runlib/win32"
+	"golang.org/x/sys/windows"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// hardFaultCounter samples hard page faults of the processes in the job. There's no per-job counter for them, and
+// the system process list only has live processes, so the last value seen for each process is kept.
+type hardFaultCounter struct {
+	job    syscall.Handle
+	counts map[uintptr]uint32
+}
+
+func newHardFaultCounter(d *PlatformData) *hardFaultCounter {
+	c := &hardFaultCounter{job: d.hJob, counts: make(map[uintptr]uint32)}
+	if pid, err := windows.GetProcessId(windows.Handle(d.hProcess)); err == nil {
+		c.counts[uintptr(pid)] = 0
+	} else {
+		log.Errorf("GetProcessId: %s", err)
+	}
+	return c
+}
+
+func (c *hardFaultCounter) Update() {
+	if c == nil {
+		return
+	}
+	if c.job != syscall.InvalidHandle {
+		ids, err := win32.GetJobProcessIds(c.job)
+		if err != nil {
+			log.Errorf("GetJobProcessIds: %s", err)
+		}
+		for _, id := range ids {
+			if _, ok := c.counts[id]; !ok {
+				c.counts[id] = 0
+			}
+		}
+	}
+	if err := win32.GetHardFaultCounts(c.counts); err != nil {
+		log.Errorf("GetHardFaultCounts: %s", err)
+	}
+}
+
+func (c *hardFaultCounter) Total() uint64 {
+	var total uint64
+	if c != nil {
+		for _, v := range c.counts {
+			total += uint64(v)
+		}
+	}
+	return total
+}
+
+// trimDetector counts drops of the working set which aren't explained by freed memory, i.e. the OS took pages away
+// from the process. It's sampled, so several trims between samples count as one.
+type trimDetector struct {
+	process syscall.Handle
+	sampled bool
+	commit  uint64
+	ws      uint64
+	trims   uint32
+}
+
+// Drops smaller than this are noise.
+const minTrimSize = 1024 * 1024
+
+func (t *trimDetector) Update() {
+	if t == nil {
+		return
+	}
+	t.sample(sampleProcessMemory(t.process))
+}
+
+func (t *trimDetector) sample(commit, ws uint64) {
+	if ws == 0 {
+		return
+	}
+	if t.sampled && ws+minTrimSize < t.ws {
+		drop := t.ws - ws
+		var freed uint64
+		if commit < t.commit {
+			freed = t.commit - commit
+		}
+		if freed < drop/2 {
+			t.trims++
+		}
+	}
+	t.sampled, t.commit, t.ws = true, commit, ws
+}
+
+func (t *trimDetector) Trims() uint32 {
+	if t == nil {
+		return 0
+	}
+	return t.trims
+}
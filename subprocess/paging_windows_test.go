@@ -0,0 +1,42 @@
+package subprocess
+
+import "testing"
+
+func TestTrimDetector(t *testing.T) {
+	const mb = 1024 * 1024
+	type sample struct{ commit, ws uint64 }
+	cases := []struct {
+		samples []sample
+		trims   uint32
+	}{
+		{nil, 0},
+		{[]sample{{10 * mb, 10 * mb}}, 0},
+		{[]sample{{10 * mb, 10 * mb}, {10 * mb, 12 * mb}}, 0},
+		// Working set dropped, commit didn't: trimmed.
+		{[]sample{{10 * mb, 10 * mb}, {10 * mb, 4 * mb}}, 1},
+		// Dropped because memory was freed.
+		{[]sample{{10 * mb, 10 * mb}, {4 * mb, 4 * mb}}, 0},
+		// Less than half of the drop was freed.
+		{[]sample{{10 * mb, 10 * mb}, {8 * mb, 4 * mb}}, 1},
+		{[]sample{{10 * mb, 10 * mb}, {7 * mb, 4 * mb}}, 0},
+		// Too small to count.
+		{[]sample{{10 * mb, 10 * mb}, {10 * mb, 10*mb - mb/2}}, 0},
+		{[]sample{{10 * mb, 10 * mb}, {10 * mb, 4 * mb}, {10 * mb, 8 * mb}, {10 * mb, 2 * mb}}, 2},
+		// Failed samples are skipped.
+		{[]sample{{10 * mb, 10 * mb}, {0, 0}, {10 * mb, 4 * mb}}, 1},
+		{[]sample{{0, 0}, {10 * mb, 4 * mb}}, 0},
+	}
+	for _, c := range cases {
+		var d trimDetector
+		for _, s := range c.samples {
+			d.sample(s.commit, s.ws)
+		}
+		if got := d.Trims(); got != c.trims {
+			t.Errorf("%v: got %d trims, want %d", c.samples, got, c.trims)
+		}
+	}
+	var none *trimDetector
+	if none.Trims() != 0 {
+		t.Error("nil detector has trims")
+	}
+}
@@ -0,0 +1,86 @@
+package subprocess
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/contester/runlib/win32"
+	"golang.org/x/sys/windows"
+)
+
+// Kept by default: it's held by everyone, and without it path traversal checks make file access slow.
+const minimalPrivilege = "SeChangeNotifyPrivilege"
+
+// Needed for large pages, see PlatformOptions.AllowLargePages.
+const lockMemoryPrivilege = "SeLockMemoryPrivilege"
+
+// removePrivileges strips privileges from the token of the suspended process, see PlatformOptions.RemovePrivileges,
+// and returns the ones left.
+func removePrivileges(d *SubprocessData, sub *Subprocess) ([]string, error) {
+	explicit := len(sub.Options.RemovePrivileges) > 0
+	all := !explicit && sub.Login != nil && !sub.Options.KeepPrivileges
+	if !explicit && !all && sub.Options.AllowLargePages {
+		return nil, nil
+	}
+
+	var token windows.Token
+	if err := windows.OpenProcessToken(windows.Handle(d.platformData.hProcess), windows.TOKEN_QUERY|windows.TOKEN_ADJUST_PRIVILEGES, &token); err != nil {
+		return nil, fmt.Errorf("OpenProcessToken: %w", err)
+	}
+	defer token.Close()
+
+	var remove []windows.LUID
+	if explicit {
+		for _, name := range sub.Options.RemovePrivileges {
+			luid, err := win32.LookupPrivilegeValue(name)
+			if err != nil {
+				return nil, fmt.Errorf("%w: privilege %q: %w", ErrUserError, name, err)
+			}
+			remove = append(remove, luid)
+		}
+	} else if all {
+		held, err := win32.GetTokenPrivileges(token)
+		if err != nil {
+			return nil, err
+		}
+		for _, p := range held {
+			if name, err := win32.LookupPrivilegeName(p.Luid); err != nil || !strings.EqualFold(name, minimalPrivilege) {
+				remove = append(remove, p.Luid)
+			}
+		}
+	}
+	if !sub.Options.AllowLargePages && !all {
+		luid, err := win32.LookupPrivilegeValue(lockMemoryPrivilege)
+		if err != nil {
+			return nil, err
+		}
+		remove = append(remove, luid)
+	}
+	if err := win32.RemoveTokenPrivileges(token, remove); err != nil {
+		return nil, err
+	}
+
+	left, err := win32.GetTokenPrivileges(token)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]string, 0, len(left))
+	for _, p := range left {
+		name, err := win32.LookupPrivilegeName(p.Luid)
+		if err != nil {
+			name = fmt.Sprintf("%#x", uint64(p.Luid.HighPart)<<32|uint64(p.Luid.LowPart))
+		}
+		result = append(result, name)
+	}
+	return result, nil
+}
+
+// applyPrivileges is removePrivileges for CreateFrozen, failing per FEATURE_PRIVILEGES policy.
+func applyPrivileges(d *SubprocessData, sub *Subprocess) error {
+	privileges, err := removePrivileges(d, sub)
+	if err != nil {
+		return d.downgrade(sub, FEATURE_PRIVILEGES, FAIL_CLOSED, fmt.Errorf("removePrivileges: %w", err))
+	}
+	d.platformData.privileges = privileges
+	return nil
+}
@@ -0,0 +1,41 @@
+package subprocess
+
+// Output kept from the probe, enough for a loader error message.
+const probeOutputSize = 4096
+
+// probeStart runs the program as StartProbe asks, and returns the probe result if it couldn't start, nil if it
+// could. The probe gets no stdin, its output is only returned if it failed, and FileIO isn't staged or collected.
+func (sub *Subprocess) probeStart() (*SubprocessResult, error) {
+	probe := *sub
+	probe.StartProbe = 0
+	probe.TimeLimit, probe.KernelTimeLimit, probe.WallTimeLimit = 0, 0, sub.StartProbe
+	probe.MinExpectedTime = 0
+	probe.CheckIdleness = false
+	probe.KillGracePeriod = 0
+	probe.StdIn = &Redirect{Mode: REDIRECT_MEMORY}
+	probe.StdOut = &Redirect{Mode: REDIRECT_MEMORY, MaxOutputSize: probeOutputSize}
+	probe.StdErr = &Redirect{Mode: REDIRECT_MEMORY, MaxOutputSize: probeOutputSize}
+	probe.JoinStdOutErr = false
+	probe.FileIO, probe.StageFiles, probe.Nonce = nil, nil, nil
+	probe.AffinityUpdates = nil
+	probe.KillSnapshotDir = ""
+	probe.MeasureHostLoad = false
+
+	d, err := probe.CreateFrozen()
+	if err != nil {
+		return nil, d.launchFailed(err)
+	}
+	d.SetupRedirectionBuffers()
+	if err = d.Unfreeze(); err != nil {
+		probe.BottomHalf(d)
+		return nil, &LaunchError{Err: err}
+	}
+	result := probe.BottomHalf(d)
+	if result.SuccessCode != 0 || loaderFailure(result) == "" {
+		// Still running when the probe ended, or got past the loader some other way.
+		return nil, nil
+	}
+	result.SuccessCode |= EF_CANNOT_START
+	result.Reason = sub.reason(result)
+	return result, nil
+}
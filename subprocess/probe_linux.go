@@ -0,0 +1,24 @@
+package subprocess
+
+import (
+	"bytes"
+	"strings"
+)
+
+// The dynamic linker only has exit code 127, shared with shells not finding a command, so no code is an
+// EXIT_CODE_ENVIRONMENT_ERROR by default.
+var loaderFailures = map[uint32]string{}
+
+// loaderFailure explains why the process couldn't start, empty if it wasn't the dynamic linker that stopped it: it
+// exits with 127 and says so on stderr.
+func loaderFailure(r *SubprocessResult) string {
+	if r.ExitCode != 127 {
+		return ""
+	}
+	i := bytes.Index(r.Error, []byte("error while loading shared libraries"))
+	if i < 0 {
+		return ""
+	}
+	msg, _, _ := strings.Cut(string(r.Error[i:]), "\n")
+	return msg
+}
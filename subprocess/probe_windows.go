@@ -0,0 +1,22 @@
+package subprocess
+
+import "fmt"
+
+// NTSTATUS codes of the loader giving up on the image or its imports, before any of the program's code ran. They are
+// also EXIT_CODE_ENVIRONMENT_ERROR by default.
+var loaderFailures = map[uint32]string{
+	0xC0000135: "a DLL it needs is missing",
+	0xC0000138: "an imported ordinal is missing from a DLL",
+	0xC0000139: "an imported function is missing from a DLL",
+	0xC000007B: "it or one of its DLLs is for another architecture",
+	0xC0000142: "a DLL failed to initialize",
+	0xC0150002: "its side-by-side configuration is broken",
+}
+
+// loaderFailure explains why the process couldn't start, empty if it wasn't the loader that stopped it.
+func loaderFailure(r *SubprocessResult) string {
+	if msg, ok := loaderFailures[r.ExitCode]; ok {
+		return fmt.Sprintf("%s (exit code %#x)", msg, r.ExitCode)
+	}
+	return ""
+}
@@ -0,0 +1,54 @@
+package subprocess
+
+import (
+	"os"
+	"syscall"
+
+	"github.com/contester/runlib/win32"
+	"golang.org/x/sys/windows"
+)
+
+// attachPseudoConsole turns redirected stdin and stdout of si into a pseudo console, so the process sees a TTY.
+// Stderr goes to the same console.
+func (d *SubprocessData) attachPseudoConsole(si *syscall.StartupInfo, attrs *windows.ProcThreadAttributeListContainer) error {
+	in, out := si.StdInput, si.StdOutput
+	if si.Flags&syscall.STARTF_USESTDHANDLES == 0 || in == syscall.InvalidHandle {
+		f, err := os.Open(os.DevNull)
+		if err != nil {
+			return err
+		}
+		d.closeAfterStart = append(d.closeAfterStart, f)
+		in = syscall.Handle(f.Fd())
+	}
+	if si.Flags&syscall.STARTF_USESTDHANDLES == 0 || out == syscall.InvalidHandle {
+		f, err := os.OpenFile(os.DevNull, os.O_WRONLY, 0)
+		if err != nil {
+			return err
+		}
+		d.closeAfterStart = append(d.closeAfterStart, f)
+		out = syscall.Handle(f.Fd())
+	}
+
+	hpc, err := win32.CreatePseudoConsole(80, 25, in, out)
+	if err != nil {
+		return err
+	}
+	if err = win32.UpdateProcThreadAttributeValue(attrs.List(), win32.PROC_THREAD_ATTRIBUTE_PSEUDOCONSOLE, hpc); err != nil {
+		win32.ClosePseudoConsole(hpc)
+		return err
+	}
+	d.platformData.pseudoConsole = hpc
+
+	// The console owns the redirects now, the process must not inherit them.
+	si.Flags &^= syscall.STARTF_USESTDHANDLES
+	si.StdInput, si.StdOutput, si.StdErr = syscall.InvalidHandle, syscall.InvalidHandle, syscall.InvalidHandle
+	return nil
+}
+
+// closePseudoConsole ends console output, so readers of stdout get EOF. It must be called after the process exits.
+func (pd *PlatformData) closePseudoConsole() {
+	if pd.pseudoConsole != 0 {
+		win32.ClosePseudoConsole(pd.pseudoConsole)
+		pd.pseudoConsole = 0
+	}
+}
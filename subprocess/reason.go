@@ -0,0 +1,100 @@
+package subprocess
+
+import (
+	"fmt"
+	"time"
+)
+
+func seconds(d time.Duration) string {
+	return fmt.Sprintf("%.2fs", d.Seconds())
+}
+
+func outputLimit(r *Redirect) int64 {
+	if r != nil && r.MaxOutputSize > 0 {
+		return r.MaxOutputSize
+	}
+	return MAX_MEM_OUTPUT
+}
+
+// reason explains what made the run fail, with the measured values, for showing to contestants. Empty if the
+// process exited with 0 and no limit was hit. Checks go in the order verdicts are usually resolved, and only the
+// first one found is reported.
+func (sub *Subprocess) reason(r *SubprocessResult) string {
+	// Flags without _POST are set while the process runs, and it's killed right away.
+	terminated := func(flag uint64, msg string, args ...interface{}) string {
+		msg = fmt.Sprintf(msg, args...)
+		if r.SuccessCode&flag != 0 {
+			return "terminated: " + msg
+		}
+		return msg
+	}
+	c := r.SuccessCode
+	switch {
+	case c&EF_CANNOT_START != 0:
+		return "can't start on the judge: " + loaderFailure(r)
+	case r.OutputLimitExceeded:
+		return fmt.Sprintf("terminated: standard output exceeded %d bytes", outputLimit(sub.StdOut))
+	case r.ErrorLimitExceeded:
+		return fmt.Sprintf("terminated: standard error exceeded %d bytes", outputLimit(sub.StdErr))
+	case c&EF_REMOTE_DISCONNECTED != 0:
+		return "terminated: interactor disconnected"
+	case c&EF_JUDGE_STALLED != 0:
+		return "terminated: the judge stopped monitoring the run, it has to be retried"
+	case c&EF_INPUT_STALLED != 0:
+		return fmt.Sprintf("terminated: stopped reading its input, %d bytes unread for %s", r.UnreadInput, seconds(sub.InputStallTimeout))
+	case c&EF_INPUT_FAILED != 0:
+		return "standard input couldn't be read to the end, the run has to be retried"
+	case c&EF_ABORTED != 0:
+		return "terminated: the run was stopped"
+	case c&(EF_PROCESS_LIMIT_HIT|EF_PROCESS_LIMIT_HIT_POST) != 0:
+		return terminated(EF_PROCESS_LIMIT_HIT, "tried to create more than %d processes", sub.ProcessLimit)
+	case c&EF_TOTAL_PROCESS_LIMIT_HIT != 0:
+		return fmt.Sprintf("terminated: created more than %d processes in total", sub.TotalProcessLimit)
+	case c&EF_MULTITHREADED != 0:
+		return fmt.Sprintf("terminated: ran %d threads, only %d allowed", r.PeakThreadCount, sub.MaxThreads)
+	case c&EF_HANDLE_LIMIT_HIT != 0:
+		return fmt.Sprintf("terminated: had more than %d handles open", sub.HandleLimit)
+	case c&EF_DISTINCT_FILE_LIMIT_HIT != 0:
+		return fmt.Sprintf("terminated: opened %d distinct files, only %d allowed", r.DistinctFileCount, sub.DistinctFileLimit)
+	case c&EF_REGISTRY_ACCESS_DENIED != 0:
+		return "tried to access the registry"
+	case c&EF_INACTIVE != 0:
+		return fmt.Sprintf("terminated: idle, CPU time %s in wall time %s", seconds(r.UserTime+r.KernelTime), seconds(r.WallTime))
+	case c&(EF_WALL_TIME_LIMIT_HIT|EF_WALL_TIME_LIMIT_HIT_POST) != 0:
+		if r.InputWaitTime > 0 {
+			return terminated(EF_WALL_TIME_LIMIT_HIT, "wall time %s, %s of it waiting for input, exceeded limit %s", seconds(r.WallTime), seconds(r.InputWaitTime), seconds(sub.WallTimeLimit))
+		}
+		return terminated(EF_WALL_TIME_LIMIT_HIT, "wall time %s exceeded limit %s", seconds(r.WallTime), seconds(sub.WallTimeLimit))
+	case c&(EF_TIME_LIMIT_HIT|EF_TIME_LIMIT_HIT_POST) != 0:
+		return terminated(EF_TIME_LIMIT_HIT, "user CPU time %s exceeded limit %s", seconds(r.UserTime), seconds(sub.TimeLimit))
+	case c&(EF_KERNEL_TIME_LIMIT_HIT|EF_KERNEL_TIME_LIMIT_HIT_POST) != 0:
+		return terminated(EF_KERNEL_TIME_LIMIT_HIT, "kernel CPU time %s exceeded limit %s", seconds(r.KernelTime), seconds(sub.KernelTimeLimit))
+	case c&(EF_MEMORY_LIMIT_HIT|EF_MEMORY_LIMIT_HIT_POST) != 0:
+		return terminated(EF_MEMORY_LIMIT_HIT, "memory %d bytes exceeded limit %d bytes", r.PeakMemory, sub.MemoryLimit)
+	case c&(EF_VIRTUAL_MEMORY_LIMIT_HIT|EF_VIRTUAL_MEMORY_LIMIT_HIT_POST) != 0:
+		return terminated(EF_VIRTUAL_MEMORY_LIMIT_HIT, "address space %d bytes exceeded limit %d bytes", r.PeakVirtualMemory, sub.VirtualMemoryLimit)
+	case c&(EF_ARTIFACT_SIZE_LIMIT_HIT|EF_ARTIFACT_SIZE_LIMIT_HIT_POST) != 0:
+		return terminated(EF_ARTIFACT_SIZE_LIMIT_HIT, "files of %d bytes exceeded limit %d bytes", r.ArtifactSize, sub.ArtifactSizeLimit)
+	case c&EF_WALL_TO_CPU_RATIO_POST != 0:
+		return fmt.Sprintf("wall time %s is over %g times CPU time %s", seconds(r.WallTime), sub.MaxWallToCpuRatio, seconds(r.UserTime+r.KernelTime))
+	case c&EF_KILLED_BY_OTHER != 0:
+		return "killed by a signal"
+	case c&EF_STOPPED != 0:
+		return "terminated: stopped by a signal"
+	case c != 0:
+		return fmt.Sprintf("terminated (flags %#x)", c)
+	case r.EnvironmentError:
+		if msg := loaderFailure(r); msg != "" {
+			return "the judge failed to run it: " + msg
+		}
+		return fmt.Sprintf("the judge failed to run it (exit code %#x)", r.ExitCode)
+	case isExceptionExitCode(r.ExitCode):
+		return fmt.Sprintf("crashed with exit code %#x", r.ExitCode)
+	case r.ExitCode != 0:
+		if signed := r.SignedExitCode(); signed < 0 {
+			return fmt.Sprintf("exit code %d (%d unsigned)", signed, r.ExitCode)
+		}
+		return fmt.Sprintf("exit code %d", r.ExitCode)
+	}
+	return ""
+}
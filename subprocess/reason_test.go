@@ -0,0 +1,51 @@
+package subprocess
+
+import (
+	"testing"
+	"time"
+)
+
+func TestReason(t *testing.T) {
+	limits := Subprocess{
+		TimeLimit:         time.Second,
+		WallTimeLimit:     3 * time.Second,
+		MemoryLimit:       256,
+		TotalProcessLimit: 4,
+		MaxThreads:        1,
+		DistinctFileLimit: 10,
+		InputStallTimeout: 2 * time.Second,
+		StdOut:            &Redirect{MaxOutputSize: 100},
+	}
+	cases := []struct {
+		r    SubprocessResult
+		want string
+	}{
+		{SubprocessResult{}, ""},
+		{SubprocessResult{ExitCode: 3}, "exit code 3"},
+		{SubprocessResult{SuccessCode: EF_TIME_LIMIT_HIT, TimeStats: TimeStats{UserTime: 1500 * time.Millisecond}},
+			"terminated: user CPU time 1.50s exceeded limit 1.00s"},
+		{SubprocessResult{SuccessCode: EF_TIME_LIMIT_HIT_POST, TimeStats: TimeStats{UserTime: 1500 * time.Millisecond}},
+			"user CPU time 1.50s exceeded limit 1.00s"},
+		{SubprocessResult{SuccessCode: EF_MEMORY_LIMIT_HIT_POST, PeakMemory: 300}, "memory 300 bytes exceeded limit 256 bytes"},
+		{SubprocessResult{SuccessCode: EF_TIME_LIMIT_HIT | EF_MEMORY_LIMIT_HIT, TimeStats: TimeStats{UserTime: 2 * time.Second}, PeakMemory: 300},
+			"terminated: user CPU time 2.00s exceeded limit 1.00s"},
+		{SubprocessResult{SuccessCode: EF_WALL_TIME_LIMIT_HIT, TimeStats: TimeStats{WallTime: 4 * time.Second}},
+			"terminated: wall time 4.00s exceeded limit 3.00s"},
+		{SubprocessResult{SuccessCode: EF_WALL_TIME_LIMIT_HIT, TimeStats: TimeStats{WallTime: 4 * time.Second}, InputWaitTime: time.Second},
+			"terminated: wall time 4.00s, 1.00s of it waiting for input, exceeded limit 3.00s"},
+		{SubprocessResult{SuccessCode: EF_INACTIVE, TimeStats: TimeStats{UserTime: 100 * time.Millisecond, WallTime: 2 * time.Second}},
+			"terminated: idle, CPU time 0.10s in wall time 2.00s"},
+		{SubprocessResult{OutputLimitExceeded: true, SuccessCode: EF_STDOUT_OVERFLOW | EF_TIME_LIMIT_HIT},
+			"terminated: standard output exceeded 100 bytes"},
+		{SubprocessResult{SuccessCode: EF_INPUT_STALLED, UnreadInput: 42}, "terminated: stopped reading its input, 42 bytes unread for 2.00s"},
+		{SubprocessResult{SuccessCode: EF_TOTAL_PROCESS_LIMIT_HIT}, "terminated: created more than 4 processes in total"},
+		{SubprocessResult{SuccessCode: EF_MULTITHREADED, PeakThreadCount: 3}, "terminated: ran 3 threads, only 1 allowed"},
+		{SubprocessResult{SuccessCode: EF_DISTINCT_FILE_LIMIT_HIT, DistinctFileCount: 11}, "terminated: opened 11 distinct files, only 10 allowed"},
+		{SubprocessResult{SuccessCode: EF_ABORTED | EF_WALL_TIME_LIMIT_HIT}, "terminated: the run was stopped"},
+	}
+	for _, c := range cases {
+		if got := limits.reason(&c.r); got != c.want {
+			t.Errorf("flags %#x, exit code %d: got %q, want %q", c.r.SuccessCode, c.r.ExitCode, got, c.want)
+		}
+	}
+}
@@ -1,12 +1,18 @@
 package subprocess
 
 import (
+	"bufio"
 	"bytes"
+	"errors"
 	"fmt"
 	"io"
+	"net"
 	"os"
 	"sync"
+	"sync/atomic"
 	"time"
+
+	"github.com/contester/runlib/compare"
 )
 
 type Redirect struct {
@@ -14,12 +20,43 @@ type Redirect struct {
 	Filename string
 	Pipe     *os.File
 	Data     []byte
+	Conn     net.Conn
 
 	MaxOutputSize int64
+	// For stdin: keep a copy of up to CaptureSize bytes fed to the child in SubprocessResult.Input.
+	CaptureSize int64
+	// For stdin: after MaxInputSize bytes, close the child's stdin and the source, and set
+	// SubprocessResult.StdinLimitHit if the source had more. Bounds what an interactor can push to the solution.
+	MaxInputSize int64
+	// For stdout/stderr: record time and size of up to RecordChunks reads in SubprocessResult.OutputChunks or
+	// ErrorChunks.
+	RecordChunks int
+	// For REDIRECT_COMPARE, and REDIRECT_MAPPED with Answers.
+	CompareOptions compare.Options
+	// For REDIRECT_COMPARE: more acceptable answers besides Filename. Output matching any of them is equal, and
+	// Comparison.Answer tells which, with Filename being 1.
+	OtherAnswers []string
+	// For REDIRECT_MAPPED, stdout only: answers to compare the output with once the child is done, reading it from
+	// the mapping. Comparison.Answer tells which matched, counting from 1.
+	Answers []string
+	// For REDIRECT_CONCAT.
+	Sources []InputSource
+	// For REDIRECT_MEMORY_HEAD_TAIL, with MaxOutputSize being the size of the tail. Line counts are still bounded by
+	// MAX_MEM_OUTPUT bytes for each part.
+	HeadSize int64
+	InLines  bool
+}
+
+// InputSource is a part of a REDIRECT_CONCAT input: the file, if Filename is set, or Data.
+type InputSource struct {
+	Filename string
+	Data     []byte
 }
 
 const MAX_MEM_OUTPUT = 1024 * 1024
 
+const MAX_OUTPUT_CHUNKS = 65536
+
 type PipeResultRecorder interface {
 	Record(direction int, numBytes int64, err error)
 }
@@ -53,7 +90,47 @@ func (s *OrderedRecorder) GetEntries() []PipeRecordEntry {
 	return s.entries
 }
 
-func (d *SubprocessData) SetupOutputMemory(b *bytes.Buffer, maxOutputSize int64) (*os.File, error) {
+// countingReader adds up what's read through it, for SubprocessResult.OutputSize and ErrorSize.
+type countingReader struct {
+	r io.Reader
+	n *atomic.Int64
+}
+
+func (c countingReader) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	c.n.Add(int64(n))
+	return n, err
+}
+
+func (d *SubprocessData) outputCounter(isStdErr bool) *atomic.Int64 {
+	if isStdErr {
+		return &d.stdErrSize
+	}
+	return &d.stdOutSize
+}
+
+// outputSize is how much the child wrote to w: the file size for files, what we read otherwise. Pipes passed to
+// the child as is aren't read by us, so they're 0.
+func outputSize(w *Redirect, counted *atomic.Int64) int64 {
+	if w != nil && (w.Mode == REDIRECT_FILE || w.Mode == REDIRECT_MAPPED) {
+		if fi, err := os.Stat(w.Filename); err == nil {
+			return fi.Size()
+		}
+		return 0
+	}
+	return counted.Load()
+}
+
+// setOutputSizes fills SubprocessResult.OutputSize and ErrorSize, once the child has exited and the output is
+// drained.
+func (d *SubprocessData) setOutputSizes(sub *Subprocess, result *SubprocessResult) {
+	result.OutputSize = outputSize(sub.StdOut, &d.stdOutSize)
+	if !sub.JoinStdOutErr {
+		result.ErrorSize = outputSize(sub.StdErr, &d.stdErrSize)
+	}
+}
+
+func (d *SubprocessData) SetupOutputMemory(b *bytes.Buffer, maxOutputSize int64, size *atomic.Int64) (*os.File, error) {
 	reader, writer, e := os.Pipe()
 	if e != nil {
 		return nil, fmt.Errorf("SetupOutputMemory: os.Pipe: %w", e)
@@ -66,17 +143,134 @@ func (d *SubprocessData) SetupOutputMemory(b *bytes.Buffer, maxOutputSize int64)
 	}
 
 	d.startAfterStart = append(d.startAfterStart, func() error {
-		_, err := io.Copy(b, io.LimitReader(reader, maxOutputSize))
+		_, err := io.Copy(b, io.LimitReader(countingReader{reader, size}, maxOutputSize))
 		reader.Close()
 		return err
 	})
 
 	d.cleanupIfFailed = append(d.cleanupIfFailed, func() {
+		// Doesn't block: the writer and the child are gone by now.
+		io.Copy(b, io.LimitReader(countingReader{reader, size}, maxOutputSize))
 		reader.Close()
 	})
 	return writer, nil
 }
 
+// tailWriter keeps only the last len(buf) bytes written to it.
+type tailWriter struct {
+	buf  []byte
+	pos  int
+	full bool
+}
+
+func (t *tailWriter) Write(p []byte) (int, error) {
+	n := len(p)
+	if n >= len(t.buf) {
+		copy(t.buf, p[n-len(t.buf):])
+		t.pos, t.full = 0, true
+		return n, nil
+	}
+	end := t.pos + n
+	c := copy(t.buf[t.pos:], p)
+	copy(t.buf, p[c:])
+	if end >= len(t.buf) {
+		t.full = true
+	}
+	t.pos = end % len(t.buf)
+	return n, nil
+}
+
+func (t *tailWriter) WriteTo(w io.Writer) (int64, error) {
+	if !t.full {
+		n, err := w.Write(t.buf[:t.pos])
+		return int64(n), err
+	}
+	n1, err := w.Write(t.buf[t.pos:])
+	if err != nil {
+		return int64(n1), err
+	}
+	n2, err := w.Write(t.buf[:t.pos])
+	return int64(n1 + n2), err
+}
+
+// SetupOutputMemoryTail drains the whole output, so the child never blocks, but only keeps the tail.
+func (d *SubprocessData) SetupOutputMemoryTail(b *bytes.Buffer, tailSize int64, size *atomic.Int64) (*os.File, error) {
+	reader, writer, e := os.Pipe()
+	if e != nil {
+		return nil, fmt.Errorf("SetupOutputMemoryTail: os.Pipe: %w", e)
+	}
+
+	d.closeAfterStart = append(d.closeAfterStart, writer)
+
+	if tailSize <= 0 {
+		tailSize = MAX_MEM_OUTPUT
+	}
+
+	d.startAfterStart = append(d.startAfterStart, func() error {
+		tail := tailWriter{buf: make([]byte, tailSize)}
+		_, err := io.Copy(&tail, countingReader{reader, size})
+		reader.Close()
+		tail.WriteTo(b)
+		return err
+	})
+
+	d.cleanupIfFailed = append(d.cleanupIfFailed, func() {
+		tail := tailWriter{buf: make([]byte, tailSize)}
+		io.Copy(&tail, countingReader{reader, size})
+		reader.Close()
+		tail.WriteTo(b)
+	})
+	return writer, nil
+}
+
+// SetupOutputCompare feeds output to the comparator, against each of answerNames. After the first mismatch the
+// rest is discarded, but still read, so the child doesn't block on a full pipe.
+func (d *SubprocessData) SetupOutputCompare(answerNames []string, opts compare.Options, size *atomic.Int64) (*os.File, error) {
+	var files []*os.File
+	closeAnswers := func() {
+		for _, f := range files {
+			f.Close()
+		}
+	}
+	for _, name := range answerNames {
+		answer, e := os.Open(name)
+		if e != nil {
+			closeAnswers()
+			return nil, fmt.Errorf("SetupOutputCompare: %w", e)
+		}
+		files = append(files, answer)
+	}
+	reader, writer, e := os.Pipe()
+	if e != nil {
+		closeAnswers()
+		return nil, fmt.Errorf("SetupOutputCompare: os.Pipe: %w", e)
+	}
+
+	d.closeAfterStart = append(d.closeAfterStart, writer)
+
+	d.startAfterStart = append(d.startAfterStart, func() error {
+		defer closeAnswers()
+		defer reader.Close()
+		answers := make([]io.Reader, 0, len(files))
+		for _, f := range files {
+			answers = append(answers, bufio.NewReader(f))
+		}
+		r := bufio.NewReader(countingReader{reader, size})
+		var err error
+		d.comparison, err = compare.StreamsAny(r, answers, opts)
+		if _, derr := io.Copy(io.Discard, r); err == nil {
+			err = derr
+		}
+		return err
+	})
+
+	d.cleanupIfFailed = append(d.cleanupIfFailed, func() {
+		reader.Close()
+		closeAnswers()
+	})
+	return writer, nil
+}
+
 func (d *SubprocessData) SetupFile(filename string, read bool, maxOutputSize int64, isStdErr bool) (*os.File, error) {
 	writer, e := OpenFileForRedirect(filename, read)
 	if e != nil {
@@ -120,17 +314,84 @@ func (d *SubprocessData) SetupPipe(f *os.File) (*os.File, error) {
 }
 
 func (d *SubprocessData) SetupOutput(w *Redirect, b *bytes.Buffer, isStdErr bool) (*os.File, error) {
+	n := len(d.startAfterStart)
+	f, err := d.setupOutput(w, b, isStdErr)
+	if err != nil {
+		return f, err
+	}
+	if !isStdErr && len(d.startAfterStart) == n+1 {
+		// We drain stdout ourselves, so we can tell if it reached EOF.
+		d.stdOutTracked = true
+		drain := d.startAfterStart[n]
+		d.startAfterStart[n] = func() error {
+			err := drain()
+			if err == nil {
+				d.stdOutEOF.Store(true)
+				// The socket copier stops on errors too, but those are flagged as remote lost.
+				if w.Mode == REDIRECT_SOCKET && !d.remoteLost.Load() {
+					d.stdOutClosedAt.Store(time.Now().UnixNano())
+				}
+			}
+			return err
+		}
+	}
+	if f != nil && w.RecordChunks > 0 {
+		maxChunks := w.RecordChunks
+		if maxChunks > MAX_OUTPUT_CHUNKS {
+			maxChunks = MAX_OUTPUT_CHUNKS
+		}
+		var t *chunkTimeline
+		if f, t, err = d.recordChunks(f, maxChunks); err != nil {
+			return nil, err
+		}
+		if isStdErr {
+			d.stdErrChunks = t
+		} else {
+			d.stdOutChunks = t
+		}
+	}
+	if f != nil && !isStdErr && d.nonce != nil && d.nonce.Verify {
+		if f, err = d.verifyNonce(f); err != nil {
+			return nil, err
+		}
+	}
+	return f, nil
+}
+
+func (d *SubprocessData) setupOutput(w *Redirect, b *bytes.Buffer, isStdErr bool) (*os.File, error) {
 	if w == nil {
 		return WriterDefault()
 	}
 
 	switch w.Mode {
 	case REDIRECT_MEMORY:
-		return d.SetupOutputMemory(b, w.MaxOutputSize)
+		return d.SetupOutputMemory(b, w.MaxOutputSize, d.outputCounter(isStdErr))
+	case REDIRECT_MEMORY_TAIL:
+		return d.SetupOutputMemoryTail(b, w.MaxOutputSize, d.outputCounter(isStdErr))
+	case REDIRECT_MEMORY_HEAD_TAIL:
+		return d.SetupOutputMemoryHeadTail(b, w.HeadSize, w.MaxOutputSize, w.InLines, d.outputCounter(isStdErr))
+	case REDIRECT_COMPARE:
+		if isStdErr {
+			return nil, fmt.Errorf("%w: comparison is only supported for stdout", ErrUserError)
+		}
+		return d.SetupOutputCompare(append([]string{w.Filename}, w.OtherAnswers...), w.CompareOptions, d.outputCounter(isStdErr))
 	case REDIRECT_FILE:
 		return d.SetupFile(w.Filename, false, w.MaxOutputSize, isStdErr)
+	case REDIRECT_MAPPED:
+		return d.SetupOutputMapped(w.Filename, w.MaxOutputSize, w.Answers, w.CompareOptions, isStdErr)
 	case REDIRECT_PIPE:
+		if !isStdErr && d.relayStdOut {
+			return d.SetupOutputRelay(w.Pipe)
+		}
 		return d.SetupPipe(w.Pipe)
+	case REDIRECT_NAMED_PIPE:
+		f, err := openNamedPipe(w.Filename)
+		if err != nil {
+			return nil, err
+		}
+		return d.SetupPipe(f)
+	case REDIRECT_SOCKET:
+		return d.SetupOutputSocket(w.Conn, d.outputCounter(isStdErr))
 	}
 	return WriterDefault()
 }
@@ -174,7 +435,191 @@ func (d *SubprocessData) SetupInputRemote(r io.ReadCloser) (*os.File, error) {
 	return reader, nil
 }
 
+// sourceReader remembers the read error, so it can be told from the child closing its stdin.
+type sourceReader struct {
+	io.Reader
+	err error
+}
+
+func (r *sourceReader) Read(p []byte) (int, error) {
+	n, err := r.Reader.Read(p)
+	if err != nil && err != io.EOF {
+		r.err = err
+	}
+	return n, err
+}
+
+// SetupInputSources feeds the sources to the child one after another. Files are all opened here, so a missing one
+// fails the start with its number; if one fails to read later, the child's stdin is closed at that point and the
+// run gets EF_INPUT_FAILED.
+func (d *SubprocessData) SetupInputSources(sources []InputSource) (*os.File, error) {
+	var files []*os.File
+	closeFiles := func() {
+		for _, f := range files {
+			f.Close()
+		}
+	}
+	readers := make([]*sourceReader, len(sources))
+	for i, s := range sources {
+		if s.Filename == "" {
+			readers[i] = &sourceReader{Reader: bytes.NewReader(s.Data)}
+			continue
+		}
+		f, err := OpenFileForRedirect(s.Filename, true)
+		if err != nil {
+			closeFiles()
+			return nil, fmt.Errorf("stdin source %d: %w", i, err)
+		}
+		files = append(files, f)
+		readers[i] = &sourceReader{Reader: f}
+	}
+
+	reader, writer, e := os.Pipe()
+	if e != nil {
+		closeFiles()
+		return nil, fmt.Errorf("SetupInputSources: os.Pipe: %w", e)
+	}
+	d.closeAfterStart = append(d.closeAfterStart, reader)
+	d.startAfterStart = append(d.startAfterStart, func() error {
+		defer closeFiles()
+		defer writer.Close()
+		for i, r := range readers {
+			if _, err := io.Copy(writer, r); err != nil {
+				if r.err == nil {
+					// The child closed its stdin, it may not need the rest.
+					return nil
+				}
+				name := sources[i].Filename
+				if name == "" {
+					name = "data"
+				}
+				d.inputErr = fmt.Errorf("stdin source %d (%s): %w", i, name, r.err)
+				return d.inputErr
+			}
+		}
+		return nil
+	})
+	d.cleanupIfFailed = append(d.cleanupIfFailed, func() {
+		writer.Close()
+		closeFiles()
+	})
+	return reader, nil
+}
+
+// setInputError reports a failed REDIRECT_CONCAT source. Redirect goroutines must be done by then.
+func (d *SubprocessData) setInputError(result *SubprocessResult) {
+	if d.inputErr != nil {
+		result.SuccessCode |= EF_INPUT_FAILED
+		result.InputError = d.inputErr.Error()
+	}
+}
+
+// cappedBuffer keeps first max bytes written to it and silently drops the rest.
+type cappedBuffer struct {
+	mu  sync.Mutex
+	max int64
+	buf bytes.Buffer
+}
+
+func (b *cappedBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if left := b.max - int64(b.buf.Len()); left > 0 {
+		if int64(len(p)) > left {
+			b.buf.Write(p[:left])
+		} else {
+			b.buf.Write(p)
+		}
+	}
+	return len(p), nil
+}
+
+func (b *cappedBuffer) Bytes() []byte {
+	if b == nil {
+		return nil
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.buf.Len() == 0 {
+		return nil
+	}
+	return append([]byte(nil), b.buf.Bytes()...)
+}
+
+// captureInput puts a pipe between src and the child, copying everything that passes through into
+// d.stdInCapture. The copier isn't waited for: src may be a pipe that outlives the child. Data is captured before
+// it's passed on, so everything the child could read is in the capture by the time the child exits.
+func (d *SubprocessData) captureInput(src *os.File, maxSize int64) (*os.File, error) {
+	reader, writer, e := os.Pipe()
+	if e != nil {
+		return nil, fmt.Errorf("captureInput: os.Pipe: %w", e)
+	}
+
+	// src is read by us now, not by the child.
+	if n := len(d.closeAfterStart); n > 0 && d.closeAfterStart[n-1] == src {
+		d.closeAfterStart = d.closeAfterStart[:n-1]
+	}
+	d.closeAfterStart = append(d.closeAfterStart, reader)
+
+	d.stdInCapture = &cappedBuffer{max: maxSize}
+	go func() {
+		io.Copy(io.MultiWriter(d.stdInCapture, writer), src)
+		src.Close()
+		writer.Close()
+	}()
+	return reader, nil
+}
+
+// limitInput puts a pipe between src and the child, passing through at most maxSize bytes. Like with captureInput,
+// the copier isn't waited for.
+func (d *SubprocessData) limitInput(src *os.File, maxSize int64) (*os.File, error) {
+	reader, writer, e := os.Pipe()
+	if e != nil {
+		return nil, fmt.Errorf("limitInput: os.Pipe: %w", e)
+	}
+
+	if n := len(d.closeAfterStart); n > 0 && d.closeAfterStart[n-1] == src {
+		d.closeAfterStart = d.closeAfterStart[:n-1]
+	}
+	d.closeAfterStart = append(d.closeAfterStart, reader)
+
+	go func() {
+		if n, _ := io.CopyN(writer, src, maxSize); n == maxSize {
+			var extra [1]byte
+			if n, _ := src.Read(extra[:]); n > 0 {
+				d.stdInLimitHit.Store(true)
+			}
+		}
+		// Writer of src gets an error instead of blocking forever.
+		src.Close()
+		writer.Close()
+	}()
+	return reader, nil
+}
+
 func (d *SubprocessData) SetupInput(w *Redirect) (*os.File, error) {
+	f, err := d.setupInput(w)
+	if err == nil && d.nonce != nil && d.nonce.FirstLine {
+		f, err = d.prefixInput(f, []byte(d.nonce.Value+"\n"))
+	}
+	if err != nil || f == nil || w == nil {
+		return f, err
+	}
+	if w.MaxInputSize > 0 {
+		if f, err = d.limitInput(f, w.MaxInputSize); err != nil {
+			return nil, err
+		}
+	}
+	if w.CaptureSize > 0 {
+		if f, err = d.captureInput(f, w.CaptureSize); err != nil {
+			return nil, err
+		}
+	}
+	d.watchInput(w, f)
+	return f, nil
+}
+
+func (d *SubprocessData) setupInput(w *Redirect) (*os.File, error) {
 	if w == nil {
 		return ReaderDefault()
 	}
@@ -186,10 +631,86 @@ func (d *SubprocessData) SetupInput(w *Redirect) (*os.File, error) {
 		return d.SetupPipe(w.Pipe)
 	case REDIRECT_FILE:
 		return d.SetupFile(w.Filename, true, 0, false)
+	case REDIRECT_SOCKET:
+		return d.SetupInputSocket(w.Conn)
+	case REDIRECT_CONCAT:
+		return d.SetupInputSources(w.Sources)
 	}
 	return ReaderDefault()
 }
 
+func (d *SubprocessData) markRemoteLost(err error) {
+	if err == nil || err == io.EOF || errors.Is(err, os.ErrDeadlineExceeded) {
+		return
+	}
+	d.remoteLost.Store(true)
+}
+
+// SetupInputSocket feeds everything received from conn to the child's stdin. The connection is owned by the
+// caller; when the child exits, pending reads are interrupted with a deadline, but conn is not closed.
+func (d *SubprocessData) SetupInputSocket(conn net.Conn) (*os.File, error) {
+	if conn == nil {
+		return nil, fmt.Errorf("%w: socket redirect without connection", ErrUserError)
+	}
+	reader, writer, e := os.Pipe()
+	if e != nil {
+		return nil, fmt.Errorf("SetupInputSocket: os.Pipe: %w", e)
+	}
+
+	d.closeAfterStart = append(d.closeAfterStart, reader)
+	d.startAfterStart = append(d.startAfterStart, func() error {
+		defer writer.Close()
+		buf := make([]byte, 32*1024)
+		for {
+			n, rerr := conn.Read(buf)
+			if n > 0 {
+				if _, werr := writer.Write(buf[:n]); werr != nil {
+					// Child closed its stdin or exited, nothing to report.
+					return nil
+				}
+			}
+			if rerr != nil {
+				d.markRemoteLost(rerr)
+				return nil
+			}
+		}
+	})
+	d.afterExit = append(d.afterExit, func() {
+		conn.SetReadDeadline(time.Now())
+	})
+	d.cleanupIfFailed = append(d.cleanupIfFailed, func() {
+		writer.Close()
+	})
+	return reader, nil
+}
+
+// SetupOutputSocket sends the child's output to conn. When the child closes its end, the write side of conn is
+// shut down (if supported) so the remote side sees EOF.
+func (d *SubprocessData) SetupOutputSocket(conn net.Conn, size *atomic.Int64) (*os.File, error) {
+	if conn == nil {
+		return nil, fmt.Errorf("%w: socket redirect without connection", ErrUserError)
+	}
+	reader, writer, e := os.Pipe()
+	if e != nil {
+		return nil, fmt.Errorf("SetupOutputSocket: os.Pipe: %w", e)
+	}
+
+	d.closeAfterStart = append(d.closeAfterStart, writer)
+	d.startAfterStart = append(d.startAfterStart, func() error {
+		_, err := io.Copy(conn, countingReader{reader, size})
+		reader.Close()
+		d.markRemoteLost(err)
+		if cw, ok := conn.(interface{ CloseWrite() error }); ok {
+			cw.CloseWrite()
+		}
+		return nil
+	})
+	d.cleanupIfFailed = append(d.cleanupIfFailed, func() {
+		reader.Close()
+	})
+	return writer, nil
+}
+
 func recordingTee(w io.WriteCloser, r io.ReadCloser, t io.Writer, recorder func(int64, error)) {
 	defer r.Close()
 	defer w.Close()
@@ -1,7 +1,9 @@
 package subprocess
 
 import (
+	"fmt"
 	"os"
+	"syscall"
 )
 
 func OpenFileForRedirect(name string, read bool) (*os.File, error) {
@@ -11,6 +13,29 @@ func OpenFileForRedirect(name string, read bool) (*os.File, error) {
 	return os.Create(name)
 }
 
+// IsNamedPipe tells if name is an existing FIFO.
+func IsNamedPipe(name string) bool {
+	fi, err := os.Stat(name)
+	return err == nil && fi.Mode()&os.ModeNamedPipe != 0
+}
+
+// openNamedPipe opens a FIFO for writing. The reader must have it open already: opening doesn't wait for one.
+func openNamedPipe(name string) (*os.File, error) {
+	if !IsNamedPipe(name) {
+		return nil, fmt.Errorf("%w: %q is not a FIFO", ErrUserError, name)
+	}
+	f, err := os.OpenFile(name, os.O_WRONLY|syscall.O_NONBLOCK, 0)
+	if err != nil {
+		return nil, err
+	}
+	// The child expects blocking writes.
+	if err = syscall.SetNonblock(int(f.Fd()), false); err != nil {
+		f.Close()
+		return nil, os.NewSyscallError("fcntl", err)
+	}
+	return f, nil
+}
+
 func ReaderDefault() (*os.File, error) {
 	return os.Open("/dev/null")
 }
@@ -0,0 +1,63 @@
+package subprocess
+
+import (
+	"bytes"
+	"io"
+	"net"
+	"os"
+	"syscall"
+	"testing"
+)
+
+func TestTailWriter(t *testing.T) {
+	cases := []struct {
+		size   int
+		writes []string
+		want   string
+	}{
+		{4, nil, ""},
+		{4, []string{"ab"}, "ab"},
+		{4, []string{"ab", "cd"}, "abcd"},
+		{4, []string{"abc", "def"}, "cdef"},
+		{4, []string{"abcdefg"}, "defg"},
+		{4, []string{"ab", "abcdefgh", "xy"}, "ghxy"},
+		{3, []string{"a", "b", "c", "d"}, "bcd"},
+		{3, []string{"ab", "cd", "ef", "g"}, "efg"},
+	}
+	for _, c := range cases {
+		w := tailWriter{buf: make([]byte, c.size)}
+		for _, s := range c.writes {
+			if n, err := w.Write([]byte(s)); n != len(s) || err != nil {
+				t.Fatalf("%q: Write(%q) = %d, %v", c.writes, s, n, err)
+			}
+		}
+		var b bytes.Buffer
+		if n, err := w.WriteTo(&b); n != int64(b.Len()) || err != nil {
+			t.Errorf("%d, %q: WriteTo = %d, %v", c.size, c.writes, n, err)
+		}
+		if b.String() != c.want {
+			t.Errorf("%d, %q: got %q, want %q", c.size, c.writes, b.String(), c.want)
+		}
+	}
+}
+
+func TestMarkRemoteLost(t *testing.T) {
+	cases := []struct {
+		err  error
+		lost bool
+	}{
+		{nil, false},
+		{io.EOF, false},
+		{os.ErrDeadlineExceeded, false},
+		{&net.OpError{Op: "read", Net: "tcp", Err: os.ErrDeadlineExceeded}, false},
+		{&net.OpError{Op: "read", Net: "tcp", Err: syscall.ECONNRESET}, true},
+		{io.ErrClosedPipe, true},
+	}
+	for _, c := range cases {
+		var d SubprocessData
+		d.markRemoteLost(c.err)
+		if got := d.remoteLost.Load(); got != c.lost {
+			t.Errorf("%v: got %v, want %v", c.err, got, c.lost)
+		}
+	}
+}
@@ -3,6 +3,7 @@ package subprocess
 import (
 	"fmt"
 	"os"
+	"strings"
 	"syscall"
 
 	"github.com/contester/runlib/win32"
@@ -62,6 +63,29 @@ func OpenFileForRedirect(name string, read bool) (*os.File, error) {
 	return os.NewFile(uintptr(h), name), nil
 }
 
+// IsNamedPipe tells if name is in the pipe namespace, \\.\pipe\...
+func IsNamedPipe(name string) bool {
+	return strings.HasPrefix(strings.ToLower(name), `\\.\pipe\`)
+}
+
+// openNamedPipe connects to a pipe server as a client. The server must be waiting in ConnectNamedPipe with a free
+// instance, otherwise this fails instead of waiting.
+func openNamedPipe(name string) (*os.File, error) {
+	uname, err := syscall.UTF16PtrFromString(name)
+	if err != nil {
+		return nil, fmt.Errorf("invalid pipe name: %w", err)
+	}
+	h, e := syscall.CreateFile(uname, syscall.GENERIC_WRITE, 0, nil, syscall.OPEN_EXISTING, 0, 0)
+	if e != nil {
+		return nil, fmt.Errorf("CreateFile(%q): %w", name, os.NewSyscallError("CreateFile", e))
+	}
+	if t, _ := syscall.GetFileType(h); t != syscall.FILE_TYPE_PIPE {
+		syscall.CloseHandle(h)
+		return nil, fmt.Errorf("%w: %q is not a pipe", ErrUserError, name)
+	}
+	return os.NewFile(uintptr(h), name), nil
+}
+
 func ReaderDefault() (*os.File, error) {
 	return nil, nil
 }
@@ -0,0 +1,22 @@
+package subprocess
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/contester/runlib/win32"
+)
+
+// Keywords bit of audit failure events.
+const auditFailureKeyword uint64 = 0x10000000000000
+
+// registryDenials counts registry opens of the process refused since the start (event 4656 in the Security log).
+// Windows only logs them with failure auditing of registry access enabled (auditpol /set /subcategory:Registry
+// /failure:enable) and a SACL auditing failures on the keys, e.g. for ALL APPLICATION PACKAGES on HKLM and HKU.
+// Without that, nothing is found and runs aren't flagged.
+func registryDenials(pid uint32, since time.Time) (int, error) {
+	query := fmt.Sprintf("*[System[EventID=4656 and band(Keywords,%d) and TimeCreated[@SystemTime>='%s']]"+
+		" and EventData[Data[@Name='ObjectType']='Key' and Data[@Name='ProcessId']='%#x']]",
+		auditFailureKeyword, since.UTC().Format("2006-01-02T15:04:05.000Z"), pid)
+	return win32.CountEvents("Security", query)
+}
@@ -0,0 +1,31 @@
+package subprocess
+
+import "time"
+
+// schedDelayMeter adds up time the process was ready to run, but waiting for a CPU. See
+// Subprocess.MeasureSchedulerDelay.
+type schedDelayMeter struct {
+	// Linux: run queue wait of each thread seen, so that threads which exited still count.
+	threads map[int]time.Duration
+	// Windows: the estimate so far, and when threads were last sampled.
+	delay   time.Duration
+	sampled time.Time
+}
+
+func newSchedDelayMeter(sub *Subprocess) *schedDelayMeter {
+	if !sub.MeasureSchedulerDelay {
+		return nil
+	}
+	return &schedDelayMeter{threads: make(map[int]time.Duration)}
+}
+
+func (m *schedDelayMeter) setResult(result *SubprocessResult) {
+	if m == nil {
+		return
+	}
+	total := m.delay
+	for _, v := range m.threads {
+		total += v
+	}
+	result.SchedulerDelay = total
+}
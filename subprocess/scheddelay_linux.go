@@ -0,0 +1,39 @@
+package subprocess
+
+import (
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Update reads run queue wait of each thread from /proc/<pid>/task/<tid>/schedstat, kept by the kernel scheduler
+// itself. Threads started and finished between samples are missed.
+func (m *schedDelayMeter) Update(pid int) {
+	if m == nil {
+		return
+	}
+	dir := "/proc/" + strconv.Itoa(pid) + "/task/"
+	tasks, err := os.ReadDir(dir)
+	if err != nil {
+		return
+	}
+	for _, task := range tasks {
+		tid, err := strconv.Atoi(task.Name())
+		if err != nil {
+			continue
+		}
+		data, err := os.ReadFile(dir + task.Name() + "/schedstat")
+		if err != nil {
+			continue
+		}
+		// Time on CPU, time waiting on a run queue, both in ns, and number of timeslices.
+		fields := strings.Fields(string(data))
+		if len(fields) < 2 {
+			continue
+		}
+		if v, err := strconv.ParseUint(fields[1], 10, 64); err == nil {
+			m.threads[tid] = time.Duration(v)
+		}
+	}
+}
@@ -0,0 +1,32 @@
+package subprocess
+
+import (
+	"time"
+
+	"github.com/contester/runlib/win32"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// Update counts threads of the job which are ready, but not running, and assumes each of them waited for the whole
+// time since the previous sample. Windows keeps no total of this, so it's only an estimate, the rougher the longer
+// TimeQuantum is.
+func (m *schedDelayMeter) Update(d *PlatformData) {
+	if m == nil {
+		return
+	}
+	pids := processIds(d)
+	if len(pids) == 0 {
+		return
+	}
+	n, err := win32.CountReadyThreads(pids)
+	if err != nil {
+		log.Errorf("CountReadyThreads: %s", err)
+		return
+	}
+	now := time.Now()
+	if !m.sampled.IsZero() {
+		m.delay += now.Sub(m.sampled) * time.Duration(n)
+	}
+	m.sampled = now
+}
@@ -0,0 +1,92 @@
+package subprocess
+
+import (
+	"bufio"
+	"bytes"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// Injected DLLs get a path in RUNLIB_STATUS_FILE, and may write "key value" lines there. Keys read are:
+//
+//	last_error <n>   GetLastError() of the exiting thread, written as the process exits
+//	arg "<s>"        each argument as the C runtime parsed it (__wargv), in order
+//	env "<s>"        each NAME=value the process started with
+//
+// arg and env are written on attach, only if RUNLIB_REPORT_VIEW is set. Their values are UTF-8 in double quotes,
+// with C escapes for quotes, backslashes and control characters. The file is in the process directory, so the
+// process can write it too: it's a debugging and audit aid, never a verdict input.
+const (
+	SHIM_STATUS_ENV      = "STATUS_FILE"
+	SHIM_REPORT_VIEW_ENV = "REPORT_VIEW"
+	shimStatusName       = ".runlib-status"
+	shimStatusMaxLen     = 64 * 1024
+)
+
+// setupShimStatus passes the status file to injected DLLs, if PlatformOptions.ReportLastError or ReportChildView
+// asks for it.
+func (d *SubprocessData) setupShimStatus(sub *Subprocess) {
+	if sub.Options == nil || !(sub.Options.ReportLastError || sub.Options.ReportChildView) || len(sub.Options.InjectDLL) == 0 {
+		return
+	}
+	path := filepath.Join(sub.CurrentDirectory, shimStatusName)
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		log.Warningf("setupShimStatus: %s", err)
+		return
+	}
+	env := make(map[string]string, len(sub.Options.InjectEnv)+1)
+	for k, v := range sub.Options.InjectEnv {
+		env[k] = v
+	}
+	env[SHIM_STATUS_ENV] = path
+	if sub.Options.ReportChildView {
+		env[SHIM_REPORT_VIEW_ENV] = "1"
+	}
+	sub.Options.InjectEnv = env
+	d.platformData.shimStatus = path
+}
+
+func (pd *PlatformData) readShimStatus(result *SubprocessResult) {
+	if pd.shimStatus == "" {
+		return
+	}
+	data, err := os.ReadFile(pd.shimStatus)
+	os.Remove(pd.shimStatus)
+	if err != nil {
+		// Nothing was reported, e.g. the process was killed.
+		return
+	}
+	if len(data) > shimStatusMaxLen {
+		data = data[:shimStatusMaxLen]
+	}
+	s := bufio.NewScanner(bytes.NewReader(data))
+	s.Buffer(make([]byte, 0, 4096), shimStatusMaxLen)
+	for s.Scan() {
+		key, value, _ := strings.Cut(s.Text(), " ")
+		switch key {
+		case "last_error":
+			if v, err := strconv.ParseUint(strings.TrimSpace(value), 0, 32); err == nil {
+				result.ChildLastError = uint32(v)
+				result.ChildLastErrorReported = true
+			}
+		case "arg", "env":
+			v, err := strconv.Unquote(value)
+			if err != nil {
+				log.Warningf("readShimStatus: bad %s value %s", key, value)
+				continue
+			}
+			if result.ChildView == nil {
+				result.ChildView = &ChildView{}
+			}
+			if key == "arg" {
+				result.ChildView.Args = append(result.ChildView.Args, v)
+			} else {
+				result.ChildView.Environment = append(result.ChildView.Environment, v)
+			}
+		}
+	}
+}
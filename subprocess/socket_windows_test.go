@@ -0,0 +1,47 @@
+package subprocess
+
+import (
+	"net"
+	"os"
+	"testing"
+)
+
+// The remote end resets the connection while the child is still reading from it.
+func TestRemoteDisconnected(t *testing.T) {
+	self, err := os.Executable()
+	if err != nil {
+		t.Fatal(err)
+	}
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+	remote, err := net.Dial("tcp", l.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	conn, err := l.Accept()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	go func() {
+		remote.Write([]byte("partial input"))
+		remote.(*net.TCPConn).SetLinger(0)
+		remote.Close()
+	}()
+
+	os.Setenv(readStdinEnv, "1")
+	defer os.Unsetenv(readStdinEnv)
+	sub := SubprocessCreate()
+	sub.Cmd = &CommandLine{ApplicationName: self}
+	sub.StdIn = &Redirect{Mode: REDIRECT_SOCKET, Conn: conn}
+	result, err := sub.Execute()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.SuccessCode&EF_REMOTE_DISCONNECTED == 0 {
+		t.Errorf("SuccessCode is %#x, want EF_REMOTE_DISCONNECTED", result.SuccessCode)
+	}
+}
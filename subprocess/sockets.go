@@ -0,0 +1,27 @@
+package subprocess
+
+// socketCounter keeps the largest number of sockets seen open at once, sampled every TimeQuantum.
+type socketCounter struct {
+	peak uint32
+}
+
+func newSocketCounter(sub *Subprocess) *socketCounter {
+	if !sub.CountSockets && sub.SocketLimit == 0 {
+		return nil
+	}
+	return &socketCounter{}
+}
+
+func (c *socketCounter) observe(n uint32) {
+	if n > c.peak {
+		c.peak = n
+	}
+}
+
+func (c *socketCounter) setResult(sub *Subprocess, result *SubprocessResult) {
+	if c == nil {
+		return
+	}
+	result.PeakSocketCount = c.peak
+	result.SocketLimitExceeded = sub.SocketLimit > 0 && c.peak > sub.SocketLimit
+}
@@ -0,0 +1,26 @@
+package subprocess
+
+import (
+	"os"
+	"strconv"
+	"strings"
+)
+
+// Update counts file descriptors of the process which are sockets, Unix domain ones included.
+func (c *socketCounter) Update(pid int) {
+	if c == nil {
+		return
+	}
+	dir := "/proc/" + strconv.Itoa(pid) + "/fd/"
+	fds, err := os.ReadDir(dir)
+	if err != nil {
+		return
+	}
+	var n uint32
+	for _, fd := range fds {
+		if target, err := os.Readlink(dir + fd.Name()); err == nil && strings.HasPrefix(target, "socket:") {
+			n++
+		}
+	}
+	c.observe(n)
+}
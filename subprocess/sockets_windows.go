@@ -0,0 +1,51 @@
+package subprocess
+
+import (
+	"syscall"
+
+	"github.com/contester/runlib/win32"
+	"golang.org/x/sys/windows"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// processIds returns ids of processes in the job, or of the process itself without one.
+func processIds(d *PlatformData) []uintptr {
+	if d.hJob != syscall.InvalidHandle {
+		ids, err := win32.GetJobProcessIds(d.hJob)
+		if err != nil {
+			log.Errorf("GetJobProcessIds: %s", err)
+		}
+		if len(ids) > 0 {
+			return ids
+		}
+	}
+	pid, err := windows.GetProcessId(windows.Handle(d.hProcess))
+	if err != nil {
+		log.Debugf("GetProcessId: %s", err)
+		return nil
+	}
+	return []uintptr{uintptr(pid)}
+}
+
+// Update adds up sockets owned by processes of the job, or by the process itself without one. The TCP and UDP
+// tables are host-wide, so this walks every socket on the host.
+func (c *socketCounter) Update(d *PlatformData) {
+	if c == nil {
+		return
+	}
+	pids := processIds(d)
+	if len(pids) == 0 {
+		return
+	}
+	sockets, err := win32.SocketsByProcess()
+	if err != nil {
+		log.Errorf("SocketsByProcess: %s", err)
+		return
+	}
+	var total uint32
+	for _, pid := range pids {
+		total += sockets[uint32(pid)]
+	}
+	c.observe(total)
+}
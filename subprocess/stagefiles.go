@@ -0,0 +1,70 @@
+package subprocess
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// StagedFile is an auxiliary file put into CurrentDirectory before the start.
+type StagedFile struct {
+	// Relative to CurrentDirectory, can't point outside of it.
+	Name string
+	// Copied from Source if it's set, otherwise Data is written.
+	Source string
+	Data   []byte
+}
+
+func (f *StagedFile) write(dst string) error {
+	out, err := os.Create(dst)
+	if err != nil {
+		return err
+	}
+	if f.Source == "" {
+		_, err = out.Write(f.Data)
+	} else {
+		var in *os.File
+		if in, err = os.Open(f.Source); err == nil {
+			_, err = io.Copy(out, in)
+			in.Close()
+		}
+	}
+	if cerr := out.Close(); err == nil {
+		err = cerr
+	}
+	return err
+}
+
+// stageFiles writes StageFiles and makes them readable for Login. On error, files staged so far are removed.
+func (sub *Subprocess) stageFiles() error {
+	for i := range sub.StageFiles {
+		f := &sub.StageFiles[i]
+		if !filepath.IsLocal(f.Name) {
+			sub.removeStagedFiles(i)
+			return fmt.Errorf("%w: staged file name %q is outside current directory", ErrUserError, f.Name)
+		}
+		dst := filepath.Join(sub.CurrentDirectory, f.Name)
+		err := f.write(dst)
+		if err == nil && sub.Login != nil {
+			err = grantFileAccess(dst, sub.Login)
+		}
+		if err != nil {
+			sub.removeStagedFiles(i + 1)
+			return fmt.Errorf("stageFiles(%q): %w", f.Name, err)
+		}
+	}
+	return nil
+}
+
+// removeStagedFiles removes first n of StageFiles.
+func (sub *Subprocess) removeStagedFiles(n int) {
+	for _, f := range sub.StageFiles[:n] {
+		if err := os.Remove(filepath.Join(sub.CurrentDirectory, f.Name)); err != nil && !errors.Is(err, os.ErrNotExist) {
+			log.Errorf("Error removing staged file: %s", err)
+		}
+	}
+}
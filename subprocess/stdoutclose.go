@@ -0,0 +1,53 @@
+package subprocess
+
+import (
+	"io"
+	"os"
+	"time"
+)
+
+// StdOutClosePolicy is what happens when the process closes its stdout, talking to an interactor over a pipe or
+// socket, but keeps running.
+type StdOutClosePolicy int
+
+const (
+	// It's done communicating: it gets a TimeQuantum to exit by itself, then it's stopped, and the run ends as if
+	// it had exited with 0, unless a limit was hit before.
+	STDOUT_CLOSE_FINISH StdOutClosePolicy = iota
+	// Run it until it exits or hits a limit, as without an interactor.
+	STDOUT_CLOSE_CONTINUE
+)
+
+// SetupOutputRelay passes the child's stdout on to f through a pipe of our own, so we can tell when the child
+// closes it. Used for REDIRECT_PIPE, which otherwise goes straight to the other process.
+func (d *SubprocessData) SetupOutputRelay(f *os.File) (*os.File, error) {
+	reader, writer, e := os.Pipe()
+	if e != nil {
+		return nil, e
+	}
+	d.closeAfterStart = append(d.closeAfterStart, writer)
+	d.startAfterStart = append(d.startAfterStart, func() error {
+		defer f.Close()
+		defer reader.Close()
+		// A write error is the other side going away first.
+		if _, err := io.Copy(f, countingReader{reader, &d.stdOutSize}); err == nil {
+			d.stdOutClosedAt.Store(time.Now().UnixNano())
+		}
+		return nil
+	})
+	d.cleanupIfFailed = append(d.cleanupIfFailed, func() {
+		reader.Close()
+		f.Close()
+	})
+	return writer, nil
+}
+
+// finishedCommunicating tells if the process closed its stdout over a TimeQuantum ago and, with
+// STDOUT_CLOSE_FINISH, should be stopped now.
+func (d *SubprocessData) finishedCommunicating(sub *Subprocess) bool {
+	if sub.OnStdOutClose != STDOUT_CLOSE_FINISH {
+		return false
+	}
+	at := d.stdOutClosedAt.Load()
+	return at != 0 && time.Since(time.Unix(0, at)) >= sub.TimeQuantum
+}
@@ -5,27 +5,68 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"sync/atomic"
 	"time"
+
+	"github.com/contester/runlib/compare"
 )
 
 const (
-	EF_INACTIVE                   = (1 << 0)
-	EF_TIME_LIMIT_HIT             = (1 << 1)
-	EF_MEMORY_LIMIT_HIT           = (1 << 3)
-	EF_KILLED                     = (1 << 4)
-	EF_STDOUT_OVERFLOW            = (1 << 5)
-	EF_STDERR_OVERFLOW            = (1 << 6)
-	EF_STDPIPE_TIMEOUT            = (1 << 7)
-	EF_TIME_LIMIT_HIT_POST        = (1 << 8)
-	EF_MEMORY_LIMIT_HIT_POST      = (1 << 9)
-	EF_PROCESS_LIMIT_HIT          = (1 << 10)
-	EF_PROCESS_LIMIT_HIT_POST     = (1 << 11)
-	EF_STOPPED                    = (1 << 12)
-	EF_KILLED_BY_OTHER            = (1 << 13)
-	EF_KERNEL_TIME_LIMIT_HIT      = (1 << 15)
-	EF_KERNEL_TIME_LIMIT_HIT_POST = (1 << 16)
-	EF_WALL_TIME_LIMIT_HIT        = (1 << 2)
-	EF_WALL_TIME_LIMIT_HIT_POST   = (1 << 14)
+	EF_INACTIVE                      = (1 << 0)
+	EF_TIME_LIMIT_HIT                = (1 << 1)
+	EF_MEMORY_LIMIT_HIT              = (1 << 3)
+	EF_KILLED                        = (1 << 4)
+	EF_STDOUT_OVERFLOW               = (1 << 5)
+	EF_STDERR_OVERFLOW               = (1 << 6)
+	EF_STDPIPE_TIMEOUT               = (1 << 7)
+	EF_TIME_LIMIT_HIT_POST           = (1 << 8)
+	EF_MEMORY_LIMIT_HIT_POST         = (1 << 9)
+	EF_PROCESS_LIMIT_HIT             = (1 << 10)
+	EF_PROCESS_LIMIT_HIT_POST        = (1 << 11)
+	EF_STOPPED                       = (1 << 12)
+	EF_KILLED_BY_OTHER               = (1 << 13)
+	EF_KERNEL_TIME_LIMIT_HIT         = (1 << 15)
+	EF_KERNEL_TIME_LIMIT_HIT_POST    = (1 << 16)
+	EF_WALL_TIME_LIMIT_HIT           = (1 << 2)
+	EF_WALL_TIME_LIMIT_HIT_POST      = (1 << 14)
+	EF_REMOTE_DISCONNECTED           = (1 << 17)
+	EF_VIRTUAL_MEMORY_LIMIT_HIT      = (1 << 18)
+	EF_VIRTUAL_MEMORY_LIMIT_HIT_POST = (1 << 19)
+	EF_ARTIFACT_SIZE_LIMIT_HIT       = (1 << 20)
+	EF_ARTIFACT_SIZE_LIMIT_HIT_POST  = (1 << 21)
+	EF_ABORTED                       = (1 << 22)
+	// Audited registry access was denied, see PlatformOptions.DenyRegistry. Windows only.
+	EF_REGISTRY_ACCESS_DENIED = (1 << 23)
+	// The watchdog found the monitoring loop stuck and killed the process, see Subprocess.WatchdogTimeout. Limits
+	// may not have been checked for a while before that, so the run says nothing about the solution.
+	EF_JUDGE_STALLED = (1 << 24)
+	// More processes were created over the run than Subprocess.TotalProcessLimit. Windows only.
+	EF_TOTAL_PROCESS_LIMIT_HIT = (1 << 25)
+	// More handles were open at once than Subprocess.HandleLimit.
+	EF_HANDLE_LIMIT_HIT = (1 << 26)
+	// A REDIRECT_CONCAT source couldn't be read to the end, so the child got a truncated input. See
+	// SubprocessResult.InputError.
+	EF_INPUT_FAILED = (1 << 27)
+	// The process was seen with more threads than Subprocess.MaxThreads allows.
+	EF_MULTITHREADED = (1 << 28)
+	// The start probe found the program can't be loaded on this host, and it wasn't run. See Subprocess.StartProbe.
+	EF_CANNOT_START = (1 << 29)
+	// Stdin from a pipe or socket had data the process didn't read for Subprocess.InputStallTimeout.
+	EF_INPUT_STALLED = (1 << 30)
+	// The run ended over Subprocess.MaxWallToCpuRatio, and FailOnWallToCpuRatio is set.
+	EF_WALL_TO_CPU_RATIO_POST = (1 << 31)
+	// More distinct files were opened over the run than Subprocess.DistinctFileLimit.
+	EF_DISTINCT_FILE_LIMIT_HIT = (1 << 32)
+)
+
+// MemoryAccounting selects what PeakMemory means, and so what MemoryLimit is enforced against.
+type MemoryAccounting int
+
+const (
+	// Private commit of the job (or the process, without job).
+	MEMORY_ACCOUNTING_PRIVATE MemoryAccounting = iota
+	// Peak working set of the main process. It includes shared pages, such as mapped system libraries.
+	MEMORY_ACCOUNTING_WORKING_SET
 )
 
 type RedirectMode int
@@ -36,6 +77,25 @@ const (
 	REDIRECT_FILE
 	REDIRECT_PIPE
 	REDIRECT_REMOTE
+	REDIRECT_SOCKET
+	// Like REDIRECT_MEMORY, but keeps the last MaxOutputSize bytes instead of the first.
+	REDIRECT_MEMORY_TAIL
+	// stdout only: compare output with the answer in Filename as it's produced, without storing it.
+	REDIRECT_COMPARE
+	// stdout/stderr: connect the child to a pipe the caller serves at Filename (a \\.\pipe\ name on Windows, a
+	// FIFO on Linux). The pipe is opened when the process is created, so the caller must be listening (or have the
+	// FIFO open for reading) by then. Only the child holds the connection afterwards: it's closed when the child
+	// and its descendants exit, and writes block whenever the caller doesn't drain it, counting towards wall time.
+	// Output size limits don't apply.
+	REDIRECT_NAMED_PIPE
+	// stdin only: feed Sources to the child one after another, as one stream.
+	REDIRECT_CONCAT
+	// Like REDIRECT_MEMORY_TAIL, but also keeps the first HeadSize bytes (or lines, with InLines), with a line
+	// telling how much was skipped between them.
+	REDIRECT_MEMORY_HEAD_TAIL
+	// stdout/stderr: write output to Filename through a memory mapping of up to MaxOutputSize bytes, falling back
+	// to REDIRECT_FILE if it can't be mapped. With Redirect.Answers, the output is then compared from the mapping.
+	REDIRECT_MAPPED
 )
 
 func GetMicros(d time.Duration) uint64 {
@@ -51,19 +111,183 @@ type TimeStats struct {
 }
 
 type SubprocessResult struct {
-	SuccessCode uint32
+	SuccessCode uint64
 	ExitCode    uint32
 	TimeStats
-	PeakMemory     uint64
+	// What MemoryLimit is checked against, see MemoryAccounting.
+	PeakMemory uint64
+	// Peak commit of the main process alone, which undercounts solutions spawning workers.
+	PeakProcessMemory uint64
+	// Peak of total commit of all processes that ever ran in the job (cgroup on Linux), 0 without a job.
+	PeakJobMemory uint64
+	// Processes created over the run, the main one included, 0 without a job.
 	TotalProcesses uint64
+	// Most processes running in the job at once, if Subprocess.TotalProcessLimit is set. Windows only.
+	PeakActiveProcesses uint32
+	// Peak reserved address space of the main process, as opposed to PeakMemory which is commit.
+	PeakVirtualMemory uint64
+	// Total size of files in CurrentDirectory, if Subprocess.ArtifactSizeLimit is set.
+	ArtifactSize uint64
 
 	OutputLimitExceeded bool
 	ErrorLimitExceeded  bool
-
+	// Process ran without some of its isolation, as it couldn't be applied on this host and the feature fails open,
+	// see FailPolicy. DowngradedFeatures says which.
+	IsolationDowngraded bool
+	DowngradedFeatures  []SecurityFeature
+	// Privileges left in the process token after PlatformOptions.RemovePrivileges and AllowLargePages (Windows
+	// only), nil if it wasn't touched.
+	Privileges []string
+	// CPU rate cap which was actually applied, see Subprocess.CpuRatePercent.
+	CpuRatePercent uint32
+	// I/O priority the process got, read back after setting PlatformOptions.IoPriority; IO_PRIORITY_DEFAULT if it
+	// wasn't set.
+	IoPriority IoPriority
+
+	// Captured stdout and stderr. Their memory is pooled: see Release.
 	Output []byte
 	Error  []byte
+	// Backing Output and Error, for Release.
+	buffers []*bytes.Buffer
+	// Bytes the process wrote to stdout and stderr, for any redirect but a pipe given to the child as is. Unlike
+	// len(Output), not cut at MaxOutputSize, though a memory redirect stops reading there.
+	OutputSize, ErrorSize int64
+	// Captured stdin, see Redirect.CaptureSize.
+	Input []byte
+	// Stdin was cut at Redirect.MaxInputSize.
+	StdinLimitHit bool
+	// Which REDIRECT_CONCAT source failed and how, with EF_INPUT_FAILED.
+	InputError string
+	// Stdin bytes the process left unread, with EF_INPUT_STALLED.
+	UnreadInput uint32
+	// The process exited by itself with an EXIT_CODE_ENVIRONMENT_ERROR code: the host is to blame, not the solution.
+	EnvironmentError bool
+	// With Subprocess.RetryOnEnvironmentError: how many times the run was repeated, and Reason of the first attempt.
+	EnvironmentRetries    uint32
+	FirstEnvironmentError string
+	// With AffinityUpdates: ProcessAffinityMask, if set, then the updates which were applied, in order.
+	AffinityMasks []uint64
+	// See Subprocess.Nonce. NonceFound is only set if Verify was asked for and stdout was ours to read.
+	Nonce      string
+	NonceFound bool
+	// GetLastError() at exit, as reported by the injected DLL (Windows only), see PlatformOptions.ReportLastError.
+	ChildLastError         uint32
+	ChildLastErrorReported bool
+	// Arguments and environment the process saw, as reported by the injected DLL, see PlatformOptions.ReportChildView.
+	ChildView *ChildView
+	// Contents of FileIO output file.
+	FileOutput []byte
+	// Process exited by itself, without crash or kill, and its stdout (if drained by us) reached EOF. If not set,
+	// output buffered by the process may have been lost.
+	StdoutCleanlyClosed bool
+	// See Subprocess.MinExpectedTime.
+	FasterThanExpected bool
+	// See Subprocess.MaxWallToCpuRatio.
+	WallToCpuRatioExceeded bool
+	// Why the run failed, with the measured values and limits, like "terminated: user CPU time 1.02s exceeded
+	// limit 1.00s". Empty if it exited with 0 within limits.
+	Reason string
+	// The process exited by itself within Subprocess.KillGracePeriod after it was asked to stop.
+	ExitedInGrace bool
+	// The process closed stdout but kept running, and was stopped for it: see STDOUT_CLOSE_FINISH. Not a failure.
+	StdOutClosedEarly bool
+	// For runs that exited with 0 within limits: the limit they came closest to, like "time" or "memory", and the
+	// part of it used, from 0 to 1. A pass at 0.95 of the time limit is borderline.
+	TightestLimit      string
+	TightestLimitUsage float64
+
+	// See Redirect.RecordChunks.
+	OutputChunks, ErrorChunks []OutputChunk
+
+	// Set for REDIRECT_COMPARE and REDIRECT_MAPPED with answers, unless comparison itself failed.
+	Comparison *compare.Result
+
+	MemorySamples []MemorySample
+
+	// CPU cycles of the main process (Windows only).
+	CpuCycles uint64
+	// Package energy consumed during the run, from RAPL (Linux only). It's host-wide, so only an estimate.
+	EnergyMicrojoules uint64
+
+	// Hard page faults of the process (on Windows, of all processes seen in the job; faults of processes which
+	// started and exited between samples are missed), and their estimated cost in wall time, see HardFaultCost.
+	// High PagingTime means wall time was distorted by memory pressure, not necessarily by the solution itself.
+	HardFaults uint64
+	PagingTime time.Duration
+	// How many times the OS was seen trimming the working set of the main process (Windows only). If it did,
+	// working set peak underestimates memory use.
+	WorkingSetTrims uint32
+
+	// Distinct modules seen loaded by the process (on Windows, by all processes in the job), see
+	// Subprocess.CountModules. ModuleLimitExceeded only flags the run for review, it doesn't change SuccessCode.
+	ModulesLoadedCount  uint32
+	ModuleLimitExceeded bool
+
+	// Most threads seen running at once (on Windows, in all processes in the job), see Subprocess.CountThreads.
+	PeakThreadCount uint32
+	// Most handles (file descriptors on Linux) seen open at once, see Subprocess.CountHandles.
+	PeakHandleCount uint32
+	// Named kernel objects the process created or opened, from the audit log, see PlatformOptions.AuditNamedObjects.
+	// NamedObjectLimitExceeded only flags the run for review, it doesn't change SuccessCode.
+	NamedObjectCount         uint32
+	NamedObjectLimitExceeded bool
+	// Most sockets seen open at once, see Subprocess.CountSockets.
+	PeakSocketCount     uint32
+	SocketLimitExceeded bool
+	// Distinct files seen opened over the run, see Subprocess.CountDistinctFiles.
+	DistinctFileCount uint32
+
+	// Estimate of wall time spent waiting for I/O, see Subprocess.MeasureIoWait.
+	IoWaitEstimate time.Duration
+	// Wall time not counted against WallTimeLimit, see Subprocess.ExcludeInputWait.
+	InputWaitTime time.Duration
+	// See Subprocess.MeasureSchedulerDelay. Linux has it from the scheduler, summed over threads; on Windows it's
+	// estimated from ready threads seen every TimeQuantum.
+	SchedulerDelay time.Duration
+
+	// See Subprocess.MeasureHostLoad.
+	HostLoad *HostLoad
+
+	// Teardown timing: waiting for the killed process to exit, and closing process/job handles.
+	KillWaitTime time.Duration
+	CloseTime    time.Duration
+
+	// See Subprocess.KillSnapshotDir.
+	KillSnapshot *KillSnapshot
+}
+
+// ChildView is the command line and environment as the process itself parsed them.
+type ChildView struct {
+	// As the C runtime split the command line, argv[0] included.
+	Args        []string
+	Environment []string
 }
 
+// KillSnapshot is the process state just before it was killed. Threads which used a lot of time are likely
+// spinning; ones with no time were probably waiting for input or a lock.
+type KillSnapshot struct {
+	// Minidump with thread stacks, or empty if it couldn't be written.
+	DumpFile string
+	Threads  []ThreadSnapshot
+}
+
+type ThreadSnapshot struct {
+	ID                   uint32
+	UserTime, KernelTime time.Duration
+}
+
+// HardFaultCost is an assumed wall time cost of one hard page fault. Reading a page from SSD takes about 0.1ms, from
+// HDD about 10ms; the default is in between, so PagingTime is only good for spotting runs worth rejudging.
+var HardFaultCost = time.Millisecond
+
+func (r *SubprocessResult) setHardFaults(n uint64) {
+	r.HardFaults = n
+	r.PagingTime = time.Duration(n) * HardFaultCost
+}
+
+// Teardown taking longer than this is logged.
+const slowTeardownThreshold = time.Second
+
 type CommandLine struct {
 	ApplicationName, CommandLine string
 	Parameters                   []string
@@ -80,23 +304,152 @@ type Subprocess struct {
 	RestrictUi               bool
 	ProcessLimit             uint32
 	FailOnJobCreationFailure bool
+	// Limit on processes created over the whole run, the main one included, as opposed to ProcessLimit which is on
+	// processes running at once. Needs a job (Windows only), the job is terminated as soon as it's exceeded.
+	TotalProcessLimit uint32
+	// Hard cap of the job CPU usage, in percent of all CPUs (Windows 8+). The process runs slower, so wall time
+	// grows: don't combine with WallTimeLimit unless that's intended.
+	CpuRatePercent uint32
 
 	TimeLimit       time.Duration
 	KernelTimeLimit time.Duration
 	WallTimeLimit   time.Duration
-
-	CheckIdleness   bool
-	MemoryLimit     uint64
-	HardMemoryLimit uint64
+	// If the process finishes successfully using less user time than this, SubprocessResult.FasterThanExpected
+	// is set. It's a hint for review of possibly precomputed answers and never affects the run itself.
+	MinExpectedTime time.Duration
+	// Flag runs of a second or more with over this many times more wall time than CPU time, in
+	// SubprocessResult.WallToCpuRatioExceeded: mostly sleeping or waiting, as to stay under the time limit. It's a
+	// heuristic, as a program waiting on I/O or a loaded host looks the same, so by default it's only a flag for
+	// review; FailOnWallToCpuRatio fails the run with EF_WALL_TO_CPU_RATIO_POST.
+	MaxWallToCpuRatio    float64
+	FailOnWallToCpuRatio bool
+
+	CheckIdleness    bool
+	MemoryLimit      uint64
+	MemoryAccounting MemoryAccounting // Windows only
+	HardMemoryLimit  uint64
+	// Limit on reserved (not necessarily committed) address space of the main process.
+	VirtualMemoryLimit uint64
+	// Limit on total size of files in CurrentDirectory, checked every TimeQuantum. Meant for compilers, so
+	// pathological sources can't fill the disk with generated code.
+	ArtifactSizeLimit uint64
+	// On hitting a time, wall time or idleness limit, ask the process to stop (SIGTERM, or CTRL_BREAK on Windows)
+	// and give it this long to exit before killing it. Output it writes meanwhile is captured as usual, so partial
+	// output of a time limited run can still be scored.
+	KillGracePeriod time.Duration
+	// When the process closes a stdout going to a pipe or socket, as to an interactor, but doesn't exit. The
+	// default stops it shortly after, with SubprocessResult.StdOutClosedEarly.
+	OnStdOutClose StdOutClosePolicy
+	// For interactive runs, with stdin from a pipe or socket: stop the process with EF_INPUT_STALLED if input it
+	// hasn't read sits there this long, without any of it being read. Sampled every TimeQuantum, so it should be a
+	// few of them; a process busy computing before it reads the next query is stalled the same.
+	InputStallTimeout time.Duration
+	// For interactive runs, with stdin from a pipe or socket: don't count time the process spent waiting for the
+	// interactor against WallTimeLimit (and idleness). This changes what the wall time limit limits: WallTime is
+	// still the real wall time, but the limit applies to WallTime minus SubprocessResult.InputWaitTime. The wait is
+	// sampled every TimeQuantum, as quanta with no unread input and no CPU time used, so a solution which burns no
+	// CPU while input is there isn't credited. Off by default, and has no effect with other stdin.
+	ExcludeInputWait bool
+	// If set, kill the process when the monitoring loop hasn't made a pass for this long, and set
+	// EF_JUDGE_STALLED. Should be many times TimeQuantum.
+	WatchdogTimeout time.Duration
 	// TimeQuantum: how often to run checks/housekeeping on running process
 	// By default, 4 times per second.
 	TimeQuantum         time.Duration
 	ProcessAffinityMask uint64
+	// New affinity masks for the running process, checked every TimeQuantum. For sharing cores between concurrent
+	// runs; a Deterministic run moves to the last CPU of each new mask.
+	AffinityUpdates <-chan uint64
+	// Best effort at reproducible runs of multithreaded solutions: pin the process to a single CPU (the last one of
+	// ProcessAffinityMask, or of those available if it's 0) and, on Windows, disable dynamic priority boosts. Threads
+	// are still preempted by the scheduler, so it reduces run-to-run variance, but doesn't remove it.
+	Deterministic bool
+	// If set, record commit and working set every MemorySampleInterval into SubprocessResult.MemorySamples.
+	MemorySampleInterval time.Duration
+	// Windows only, used when the process runs without a job, whose accounting would make it unnecessary: check
+	// memory at least this many times during the first TimeQuantum, so short runs don't miss their peak.
+	MinMemoryChecks uint32
+	// Report CPU cycles and energy where the platform can measure them.
+	MeasureCycles bool
+	// Report hard page faults, SubprocessResult.PagingTime and WorkingSetTrims.
+	MeasurePaging bool
+	// Fill SubprocessResult.ModulesLoadedCount. An unusual number of modules may mean the solution is probing the
+	// environment. Setting ModuleLimit implies CountModules.
+	CountModules bool
+	ModuleLimit  uint32
+	// Fill SubprocessResult.PeakThreadCount. It's sampled, not tracked per thread, so short-lived threads may be
+	// missed and the peak underestimated.
+	CountThreads bool
+	// Policy for problems which must be solved in one thread: seeing more threads than this stops the run with
+	// EF_MULTITHREADED, a verdict of its own. Implies CountThreads, and like it, is sampled: a short burst of threads
+	// can go unnoticed. On Windows 10+ the loader starts its own worker threads in every process, so a
+	// single-threaded program may briefly have 4 of them; runtimes like Java or .NET have many more.
+	MaxThreads uint32
+	// Fill SubprocessResult.PeakHandleCount, sampled every TimeQuantum in all processes of the job (only the process
+	// itself on Linux). Going over HandleLimit stops the run with EF_HANDLE_LIMIT_HIT. Setting HandleLimit implies
+	// CountHandles.
+	CountHandles bool
+	HandleLimit  uint32
+	// Fill SubprocessResult.PeakSocketCount, sampled like handles: sockets in the TCP and UDP tables owned by
+	// processes of the job on Windows, socket descriptors of the process on Linux. Going over SocketLimit only flags
+	// the run for review, the run isn't stopped. Setting SocketLimit implies CountSockets.
+	CountSockets bool
+	SocketLimit  uint32
+	// Fill SubprocessResult.DistinctFileCount: files opened over the whole run, not just at once, to catch probing
+	// the file system by opening and closing many files. On Linux, open descriptors of the process are sampled
+	// every TimeQuantum, so files opened and closed in between are missed; on Windows, opens are read from the
+	// audit log every TimeQuantum, see fileOpens, and nothing is counted without file system auditing. Going over
+	// DistinctFileLimit stops the run with EF_DISTINCT_FILE_LIMIT_HIT. Setting DistinctFileLimit
+	// implies CountDistinctFiles.
+	CountDistinctFiles bool
+	DistinctFileLimit  uint32
+	// Fill SubprocessResult.IoWaitEstimate: wall time not explained by CPU use or idling. It's sampled every
+	// TimeQuantum, so short waits blend in with CPU use.
+	MeasureIoWait bool
+	// Fill SubprocessResult.SchedulerDelay: time the process was ready to run, but waiting for a CPU. A high one
+	// means the host was oversubscribed, and the run is a candidate for rejudging.
+	MeasureSchedulerDelay bool
+	// Fill SubprocessResult.HostLoad.
+	MeasureHostLoad bool
+	// Let the OS write a dump if the process crashes: a core file on Linux, a Windows Error Reporting dump (if the
+	// host sets up LocalDumps) on Windows. Off by default, as writing a big dump stalls the crash and fills the disk.
+	CrashDumps bool
+	// Debug only, as it's slow: if the process is killed for wall time or idleness, write its minidump into this
+	// directory first and fill SubprocessResult.KillSnapshot. Windows only.
+	KillSnapshotDir string
+	// Closing it kills the process with EF_ABORTED, for callers running several processes together.
+	Abort <-chan struct{}
+	Nonce *Nonce
+
+	// Locale of the process, like "C" (the default) or "en_US.UTF-8", so programs parsing numbers or dates behave
+	// the same whatever the host locale; LOCALE_HOST keeps it as is. Set in LANG and LC_ALL, and on Windows also
+	// passed to injected DLLs, see SHIM_LOCALE_ENV: without InjectDLL, Windows runtimes keep the user locale.
+	Locale string
+
+	// Before the run, start the program with no input and this wall time limit, to tell a binary the host can't
+	// load (a missing runtime DLL or shared library) without spending the time limit on it. If the loader fails,
+	// the probe result is returned with EF_CANNOT_START. Otherwise the probe is killed or exits, and the program
+	// is run again for real: it must not mind being started twice. StageFiles are there for the probe, FileIO
+	// files are not, and it may change anything else in CurrentDirectory.
+	StartProbe time.Duration
+	// Exit codes to classify differently from the defaults (the Windows loader failures are
+	// EXIT_CODE_ENVIRONMENT_ERROR), see SubprocessResult.EnvironmentError.
+	ExitCodeClasses map[uint32]ExitCodeClass
+	// Run again, up to this many times, a run which ends with SubprocessResult.EnvironmentError, like a DLL the
+	// antivirus held locked; see retryEnvironmentError. Only exit codes classified as the host's fault are retried,
+	// so solution errors aren't masked.
+	RetryOnEnvironmentError uint32
 
 	Cmd                   *CommandLine
 	Login                 *LoginInfo
 	StdIn, StdOut, StdErr *Redirect
 	JoinStdOutErr         bool
+	FileIO                *FileIO
+	StageFiles            []StagedFile
+	// Remove StageFiles after the run.
+	RemoveStagedFiles bool
+	// Hex SHA-256 the executable (Cmd.ApplicationName) must have, checked right before launch, see lockExecutable.
+	ExecutableSha256 string
 
 	Options *PlatformOptions
 }
@@ -134,11 +487,43 @@ type SubprocessData struct {
 	startAfterStart []func() error // buffer functions, launch after createFrozen
 	closeAfterStart []io.Closer    // close after createFrozen
 	cleanupIfFailed []func()
+	afterExit       []func() // run once the process is gone, before waiting for buffers
+
+	// Set by socket redirects when the remote side goes away.
+	remoteLost atomic.Bool
+
+	// If stdout is drained by us, whether it reached EOF.
+	stdOutTracked bool
+	stdOutEOF     atomic.Bool
 
 	outCheck, errCheck *outputRedirectCheck
 
-	stdOut bytes.Buffer
-	stdErr bytes.Buffer
+	stdOut       *bytes.Buffer
+	stdErr       *bytes.Buffer
+	stdInCapture *cappedBuffer
+	// See Redirect.MaxInputSize.
+	stdInLimitHit atomic.Bool
+	// Set by the REDIRECT_CONCAT copier before it finishes.
+	inputErr error
+	// Output we read from the child, see setOutputSizes.
+	stdOutSize, stdErrSize atomic.Int64
+	// See Subprocess.InputStallTimeout.
+	inputStallTimeout time.Duration
+	// See Subprocess.ExcludeInputWait.
+	excludeInputWait bool
+	stdInWait        *inputWait
+	stdInStall       *inputStall
+	// Relay REDIRECT_PIPE stdout, and when the child closed it, in UnixNano; see Subprocess.OnStdOutClose.
+	relayStdOut    bool
+	stdOutClosedAt atomic.Int64
+
+	nonce      *Nonce
+	nonceFound *nonceMatcher
+
+	runStart                   time.Time
+	stdOutChunks, stdErrChunks *chunkTimeline
+
+	comparison *compare.Result
 
 	platformData PlatformData
 }
@@ -150,6 +535,7 @@ func SubprocessCreate() *Subprocess {
 }
 
 func (d *SubprocessData) SetupRedirectionBuffers() {
+	d.runStart = time.Now()
 	d.bufferChan = make(chan error, len(d.startAfterStart))
 
 	for _, fn := range d.startAfterStart {
@@ -159,6 +545,48 @@ func (d *SubprocessData) SetupRedirectionBuffers() {
 	}
 }
 
+func (d *SubprocessData) stdOutReachedEOF() bool {
+	return !d.stdOutTracked || d.stdOutEOF.Load()
+}
+
+// runAfterExit unblocks redirect goroutines that would otherwise wait on a peer that doesn't know the process
+// has exited.
+func (d *SubprocessData) runAfterExit() {
+	for _, fn := range d.afterExit {
+		fn()
+	}
+}
+
+// launchFailed releases redirects of a process that never ran, keeping what a launcher or an injected DLL managed
+// to write into memory buffers. d may be nil.
+func (d *SubprocessData) launchFailed(err error) error {
+	if d == nil {
+		return &LaunchError{Err: err}
+	}
+	closeDescriptors(d.closeAfterStart)
+	// Reverse order, so relays between pipes are flushed before their destination is drained.
+	for i := len(d.cleanupIfFailed) - 1; i >= 0; i-- {
+		d.cleanupIfFailed[i]()
+	}
+	e := &LaunchError{Err: err}
+	if d.stdOut.Len() > 0 {
+		e.Stdout = d.stdOut.Bytes()
+	}
+	if d.stdErr.Len() > 0 {
+		e.Stderr = d.stdErr.Bytes()
+	}
+	return e
+}
+
+func (sub *Subprocess) aborted() bool {
+	select {
+	case <-sub.Abort:
+		return true
+	default:
+		return false
+	}
+}
+
 func closeDescriptors(closers []io.Closer) {
 	for _, fd := range closers {
 		fd.Close()
@@ -166,24 +594,80 @@ func closeDescriptors(closers []io.Closer) {
 }
 
 func (sub *Subprocess) Execute() (*SubprocessResult, error) {
+	result, err := sub.execute()
+	if sub.RetryOnEnvironmentError > 0 {
+		result, err = sub.retryEnvironmentError(result, err)
+	}
+	return result, err
+}
+
+func (sub *Subprocess) execute() (*SubprocessResult, error) {
 	// Locking of the OS thread is needed on linux, because PtraceDetach will not work if you do it from the
 	// different thread.
 	maybeLockOSThread()
 	defer maybeUnlockOSThread()
 
-	d, err := sub.CreateFrozen()
-	if err != nil {
-		if d != nil {
-			for _, v := range d.cleanupIfFailed {
-				v()
-			}
+	if err := sub.stageFiles(); err != nil {
+		return nil, err
+	}
+	if sub.RemoveStagedFiles {
+		defer sub.removeStagedFiles(len(sub.StageFiles))
+	}
+	if sub.StartProbe > 0 {
+		if result, err := sub.probeStart(); result != nil || err != nil {
+			return result, err
+		}
+	}
+	// After the probe, so what it could write in place of the output file isn't taken for it.
+	if sub.FileIO != nil {
+		if err := sub.FileIO.stage(sub.CurrentDirectory); err != nil {
+			return nil, err
 		}
+	}
+
+	if err := sub.Nonce.generate(); err != nil {
 		return nil, err
 	}
 
+	hostLoad := startHostLoadMonitor(sub.MeasureHostLoad)
+
+	d, err := sub.CreateFrozen()
+	if err != nil {
+		hostLoad.Stop()
+		return nil, d.launchFailed(err)
+	}
+
 	d.SetupRedirectionBuffers()
-	d.Unfreeze()
-	return sub.BottomHalf(d), nil
+	unfreezeErr := d.Unfreeze()
+	result := sub.BottomHalf(d)
+	result.HostLoad = hostLoad.Stop()
+	if sub.AffinityUpdates != nil && sub.ProcessAffinityMask != 0 {
+		first := sub.ProcessAffinityMask
+		if sub.Deterministic {
+			first = lastCpu(first)
+		}
+		result.AffinityMasks = append([]uint64{first}, result.AffinityMasks...)
+	}
+	if sub.Nonce != nil {
+		result.Nonce = sub.Nonce.Value
+		result.NonceFound = d.nonceFound != nil && d.nonceFound.found
+	}
+	if unfreezeErr != nil {
+		// On linux, exec failed in the child, so it exited without running the program.
+		return nil, &LaunchError{Err: unfreezeErr, Stdout: result.Output, Stderr: result.Error}
+	}
+	if sub.FileIO != nil {
+		if result.FileOutput, err = sub.FileIO.collect(sub.CurrentDirectory); err != nil {
+			return nil, err
+		}
+	}
+	result.EnvironmentError = result.SuccessCode == 0 && result.ExitCode != 0 &&
+		sub.exitCodeClass(result.ExitCode) == EXIT_CODE_ENVIRONMENT_ERROR
+	result.Reason = sub.reason(result)
+	if result.SuccessCode == 0 && result.ExitCode == 0 {
+		result.TightestLimit, result.TightestLimitUsage = sub.tightestLimit(result)
+	}
+	return result, nil
 }
 
 type runningState struct {
@@ -200,7 +684,7 @@ func (r *runningState) Update(sub *Subprocess, result *SubprocessResult) {
 		r.noTimeUsedCount = 0
 	}
 
-	if sub.CheckIdleness && (r.noTimeUsedCount >= 6) && (result.WallTime > sub.TimeLimit) {
+	if sub.CheckIdleness && (r.noTimeUsedCount >= 6) && (result.chargedWallTime() > sub.TimeLimit) {
 		result.SuccessCode |= EF_INACTIVE
 	}
 
@@ -212,7 +696,7 @@ func (r *runningState) Update(sub *Subprocess, result *SubprocessResult) {
 		result.SuccessCode |= EF_KERNEL_TIME_LIMIT_HIT
 	}
 
-	if (sub.WallTimeLimit > 0) && (result.WallTime > sub.WallTimeLimit) {
+	if (sub.WallTimeLimit > 0) && (result.chargedWallTime() > sub.WallTimeLimit) {
 		result.SuccessCode |= EF_WALL_TIME_LIMIT_HIT
 	}
 
@@ -221,6 +705,14 @@ func (r *runningState) Update(sub *Subprocess, result *SubprocessResult) {
 	if (sub.MemoryLimit > 0) && (result.PeakMemory > sub.MemoryLimit) {
 		result.SuccessCode |= EF_MEMORY_LIMIT_HIT
 	}
+
+	if (sub.VirtualMemoryLimit > 0) && (result.PeakVirtualMemory > sub.VirtualMemoryLimit) {
+		result.SuccessCode |= EF_VIRTUAL_MEMORY_LIMIT_HIT
+	}
+
+	if sub.ArtifactSizeLimit > 0 && sub.updateArtifactSize(result) > sub.ArtifactSizeLimit {
+		result.SuccessCode |= EF_ARTIFACT_SIZE_LIMIT_HIT
+	}
 }
 
 func (sub *Subprocess) SetPostLimits(result *SubprocessResult) {
@@ -235,4 +727,18 @@ func (sub *Subprocess) SetPostLimits(result *SubprocessResult) {
 	if (sub.KernelTimeLimit > 0) && (result.KernelTime > sub.KernelTimeLimit) {
 		result.SuccessCode |= EF_KERNEL_TIME_LIMIT_HIT_POST
 	}
+
+	if (sub.VirtualMemoryLimit > 0) && (result.PeakVirtualMemory > sub.VirtualMemoryLimit) {
+		result.SuccessCode |= EF_VIRTUAL_MEMORY_LIMIT_HIT_POST
+	}
+
+	if sub.ArtifactSizeLimit > 0 && sub.updateArtifactSize(result) > sub.ArtifactSizeLimit {
+		result.SuccessCode |= EF_ARTIFACT_SIZE_LIMIT_HIT_POST
+	}
+
+	if (sub.MinExpectedTime > 0) && (result.SuccessCode == 0) && (result.UserTime < sub.MinExpectedTime) {
+		result.FasterThanExpected = true
+	}
+
+	sub.checkWallToCpuRatio(result)
 }
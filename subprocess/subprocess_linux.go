@@ -1,14 +1,19 @@
 package subprocess
 
 import (
+	"bytes"
 	"fmt"
+	"os"
 	"os/user"
 	"runtime"
 	"strconv"
+	"strings"
+	"sync/atomic"
 	"syscall"
 	"time"
 
 	"github.com/contester/runlib/linux"
+	"golang.org/x/sys/unix"
 
 	log "github.com/sirupsen/logrus"
 )
@@ -29,6 +34,8 @@ type PlatformData struct {
 	Pid       int
 	params    *linux.CloneParams
 	startTime time.Time
+	// Set once we've sent SIGTERM, see Subprocess.KillGracePeriod.
+	terminating atomic.Bool
 }
 
 func NewLoginInfo(username, password string) (*LoginInfo, error) {
@@ -45,16 +52,29 @@ func NewLoginInfo(username, password string) (*LoginInfo, error) {
 	}, nil
 }
 
+// AppendArgs adds arguments to the command line. On linux, Parameters is the full argv.
+func (c *CommandLine) AppendArgs(args ...string) {
+	if len(c.Parameters) == 0 {
+		c.Parameters = []string{c.ApplicationName}
+	}
+	c.Parameters = append(c.Parameters, args...)
+	c.CommandLine = strings.Join(c.Parameters, " ")
+}
+
 func (d *SubprocessData) wAllRedirects(s *Subprocess, result *linux.StdHandles) error {
 	var err error
 
+	d.nonce = s.Nonce
+	d.relayStdOut = s.OnStdOutClose == STDOUT_CLOSE_FINISH
+	d.inputStallTimeout = s.InputStallTimeout
+	d.excludeInputWait = s.ExcludeInputWait
 	if result.StdIn, err = d.SetupInput(s.StdIn); err != nil {
 		return err
 	}
-	if result.StdOut, err = d.SetupOutput(s.StdOut, &d.stdOut); err != nil {
+	if result.StdOut, err = d.SetupOutput(s.StdOut, d.stdOut); err != nil {
 		return err
 	}
-	if result.StdErr, err = d.SetupOutput(s.StdErr, &d.stdErr); err != nil {
+	if result.StdErr, err = d.SetupOutput(s.StdErr, d.stdErr); err != nil {
 		return err
 	}
 	return nil
@@ -64,36 +84,84 @@ func (sub *Subprocess) CreateFrozen() (*SubprocessData, error) {
 	if sub.Cmd.ApplicationName == "" {
 		return nil, fmt.Errorf("Application name must be present")
 	}
-	d := &SubprocessData{}
+	d := &SubprocessData{stdOut: getOutputBuffer(), stdErr: getOutputBuffer()}
+	exe, err := sub.lockExecutable()
+	if err != nil {
+		return d, err
+	}
+	defer exe.Close()
 	var stdh linux.StdHandles
-	err := d.wAllRedirects(sub, &stdh)
+	err = d.wAllRedirects(sub, &stdh)
 	defer stdh.Close()
 	if err != nil {
-		return nil, err
+		return d, err
 	}
 	var uid int
 	if sub.Login != nil {
 		uid = sub.Login.Uid
 	}
+	env := sub.Environment
+	if (sub.Nonce != nil && sub.Nonce.EnvName != "") || sub.locale() != LOCALE_HOST {
+		if len(env) == 0 {
+			env = os.Environ()
+		}
+		env = sub.Nonce.environ(env)
+	}
+	if locale := sub.locale(); locale != LOCALE_HOST {
+		env = withLocale(env, locale)
+	}
 	d.platformData.params, err = linux.CreateCloneParams(
-		sub.Cmd.ApplicationName, sub.Cmd.Parameters, sub.Environment, sub.CurrentDirectory, uid, stdh)
+		sub.Cmd.ApplicationName, sub.Cmd.Parameters, env, sub.CurrentDirectory, uid, stdh)
 	if err != nil {
-		return nil, fmt.Errorf("CreateCloneParams(): %w", err)
+		return d, fmt.Errorf("CreateCloneParams(): %w", err)
 	}
 	syscall.ForkLock.Lock()
 	d.platformData.Pid, err = d.platformData.params.CloneFrozen()
 	closeDescriptors(d.closeAfterStart)
 	syscall.ForkLock.Unlock()
 	if err != nil {
-		return nil, fmt.Errorf("CloneFrozen(): %w", err)
+		return d, fmt.Errorf("CloneFrozen(): %w", err)
+	}
+	if !sub.CrashDumps {
+		// A core_pattern pipe still gets called, but systemd-coredump honors the limit as well.
+		if err := unix.Prlimit(d.platformData.Pid, unix.RLIMIT_CORE, &unix.Rlimit{}, nil); err != nil {
+			log.Warningf("CreateFrozen: prlimit(RLIMIT_CORE): %s", err)
+		}
 	}
 	err = SetupControlGroup(sub, d)
 	if err != nil {
-		return nil, fmt.Errorf("SetupControlGroup(): %w", err)
+		return d, fmt.Errorf("SetupControlGroup(): %w", err)
+	}
+	if sub.Deterministic {
+		pinToSingleCpu(d.platformData.Pid, sub.ProcessAffinityMask)
+	} else if sub.ProcessAffinityMask != 0 {
+		if err := d.platformData.setAffinity(sub.ProcessAffinityMask); err != nil {
+			log.Warningf("CreateFrozen: setAffinity(b%b): %s", sub.ProcessAffinityMask, err)
+		}
 	}
 	return d, nil
 }
 
+// pinToSingleCpu sets affinity of the frozen process to the last CPU of mask, or of those available to us if it's 0.
+func pinToSingleCpu(pid int, mask uint64) {
+	if mask == 0 {
+		var current unix.CPUSet
+		if err := unix.SchedGetaffinity(0, &current); err != nil {
+			log.Warningf("pinToSingleCpu: %s", err)
+			return
+		}
+		for cpu := 0; cpu < 64; cpu++ {
+			if current.IsSet(cpu) {
+				mask |= 1 << cpu
+			}
+		}
+	}
+	mask = lastCpu(mask)
+	if err := unix.SchedSetaffinity(pid, cpuSet(mask)); err != nil {
+		log.Warningf("pinToSingleCpu: %s", err)
+	}
+}
+
 func SetupControlGroup(s *Subprocess, d *SubprocessData) error {
 	cgname := strconv.Itoa(d.platformData.Pid)
 	s.Options.Cg.Setup(cgname, d.platformData.Pid)
@@ -107,13 +175,26 @@ func (d *SubprocessData) Unfreeze() error {
 
 type ChildWaitData struct {
 	ExitCode                       uint32
-	SuccessCode                    uint32
+	SuccessCode                    uint64
 	StopSignal                     uint32
 	KillSignal                     uint32
 	RusageCpuUser, RusageCpuKernel time.Duration
+	MajorFaults                    uint64
 }
 
-func ChildWaitingFunc(pid int, sig chan *ChildWaitData) {
+// Crashes are reported as EF_KILLED_BY_OTHER, with the signal.
+func isExceptionExitCode(code uint32) bool {
+	return false
+}
+
+// Exit statuses are a byte: return -1 is 255.
+func signedExitCode(code uint32) int32 {
+	return int32(int8(code))
+}
+
+// ChildWaitingFunc waits for the traced child. Any stop kills it, except for delivering SIGTERM once terminating is set:
+// that one is ours, and the child may handle it.
+func ChildWaitingFunc(pid int, terminating *atomic.Bool, sig chan *ChildWaitData) {
 	var status syscall.WaitStatus
 	var rusage syscall.Rusage
 	result := &ChildWaitData{}
@@ -127,13 +208,19 @@ func ChildWaitingFunc(pid int, sig chan *ChildWaitData) {
 			result.ExitCode = uint32(status.ExitStatus())
 			break
 		}
+		if status.Stopped() && status.StopSignal() == syscall.SIGTERM && terminating.Load() {
+			syscall.PtraceCont(pid, int(syscall.SIGTERM))
+			continue
+		}
 		if status.Stopped() {
 			result.SuccessCode |= EF_STOPPED
 			result.StopSignal = uint32(status.StopSignal())
 			syscall.Kill(pid, syscall.SIGKILL)
 		}
 		if status.Signaled() {
-			result.SuccessCode |= EF_KILLED_BY_OTHER
+			if status.Signal() != syscall.SIGTERM || !terminating.Load() {
+				result.SuccessCode |= EF_KILLED_BY_OTHER
+			}
 			result.KillSignal = uint32(status.Signal())
 			break
 		}
@@ -143,6 +230,7 @@ func ChildWaitingFunc(pid int, sig chan *ChildWaitData) {
 	}
 	result.RusageCpuUser = time.Nanosecond * time.Duration(rusage.Utime.Nano())
 	result.RusageCpuKernel = time.Nanosecond * time.Duration(rusage.Stime.Nano())
+	result.MajorFaults = uint64(rusage.Majflt)
 	sig <- result
 	close(sig)
 }
@@ -151,16 +239,57 @@ func UpdateRunningUsage(p *PlatformData, o *PlatformOptions, result *SubprocessR
 	result.WallTime = time.Since(p.startTime)
 	result.UserTime = time.Nanosecond * time.Duration(o.Cg.GetCpu(strconv.Itoa(p.Pid)))
 	result.PeakMemory = o.Cg.GetMemory(strconv.Itoa(p.Pid))
+	result.PeakJobMemory = result.PeakMemory
+}
+
+// Returns VmPeak from /proc/<pid>/status, or 0 if it can't be read.
+func getVmPeak(pid int) uint64 {
+	data, err := os.ReadFile("/proc/" + strconv.Itoa(pid) + "/status")
+	if err != nil {
+		return 0
+	}
+	for _, line := range strings.Split(string(data), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) >= 2 && fields[0] == "VmPeak:" {
+			v, err := strconv.ParseUint(fields[1], 10, 64)
+			if err != nil {
+				return 0
+			}
+			return v * 1024
+		}
+	}
+	return 0
 }
 
 func (sub *Subprocess) BottomHalf(d *SubprocessData) *SubprocessResult {
 	var result SubprocessResult
 
 	childChan := make(chan *ChildWaitData, 1)
-	go ChildWaitingFunc(d.platformData.Pid, childChan)
+	go ChildWaitingFunc(d.platformData.Pid, &d.platformData.terminating, childChan)
 	ticker := time.NewTicker(sub.TimeQuantum)
 	var finished *ChildWaitData
 	var runState runningState
+	cgname := strconv.Itoa(d.platformData.Pid)
+	modules := newModuleCounter(sub)
+	threads := newThreadCounter(sub)
+	handles := newHandleCounter(sub)
+	files := newDistinctFiles(sub)
+	sockets := newSocketCounter(sub)
+	ioWait := newIoWaitEstimator(sub)
+	schedDelay := newSchedDelayMeter(sub)
+	var energy *energyCounter
+	if sub.MeasureCycles {
+		energy = startEnergyCounter()
+	}
+	sampler := startMemorySampler(sub.MemorySampleInterval, func() (uint64, uint64) {
+		// cgroup memory usage is the best approximation we have for both.
+		m := sub.Options.Cg.GetMemory(cgname)
+		return m, m
+	})
+
+	dog := startWatchdog(sub.WatchdogTimeout, func() {
+		syscall.Kill(d.platformData.Pid, syscall.SIGKILL)
+	})
 
 W:
 	for result.SuccessCode == 0 {
@@ -168,23 +297,98 @@ W:
 		case finished = <-childChan:
 			break W
 		case _ = <-ticker.C:
+			dog.beat()
 			UpdateRunningUsage(&d.platformData, sub.Options, &result)
+			if sub.VirtualMemoryLimit > 0 {
+				if v := getVmPeak(d.platformData.Pid); v > result.PeakVirtualMemory {
+					result.PeakVirtualMemory = v
+				}
+			}
+			modules.Update(d.platformData.Pid)
+			threads.Update(d.platformData.Pid)
+			if threads.exceeded(sub) {
+				result.SuccessCode |= EF_MULTITHREADED
+			}
+			if handles.Update(sub, d.platformData.Pid) {
+				result.SuccessCode |= EF_HANDLE_LIMIT_HIT
+			}
+			if files.Update(sub, d.platformData.Pid) {
+				result.SuccessCode |= EF_DISTINCT_FILE_LIMIT_HIT
+				break W
+			}
+			sockets.Update(d.platformData.Pid)
+			sub.pollAffinity(&d.platformData, &result)
+			ioWait.Update(d.platformData.Pid, &result)
+			schedDelay.Update(d.platformData.Pid)
+			d.stdInWait.Update(&result)
 			runState.Update(sub, &result)
+			if d.remoteLost.Load() {
+				result.SuccessCode |= EF_REMOTE_DISCONNECTED
+			}
+			if d.stdInStall.Update(sub, &result) {
+				result.SuccessCode |= EF_INPUT_STALLED
+			}
+			if sub.aborted() {
+				result.SuccessCode |= EF_ABORTED
+			}
+			if result.SuccessCode == 0 && d.finishedCommunicating(sub) {
+				result.StdOutClosedEarly = true
+				break W
+			}
 		}
 	}
 	ticker.Stop()
+	if dog.Stop() {
+		result.SuccessCode |= EF_JUDGE_STALLED
+	}
+	result.MemorySamples = sampler.Stop()
+	result.EnergyMicrojoules = energy.Stop()
+	modules.setResult(sub, &result)
+	threads.setResult(sub, &result)
+	handles.setResult(&result)
+	files.setResult(&result)
+	sockets.setResult(sub, &result)
+	schedDelay.setResult(&result)
+	if finished == nil && sub.killGracefully(result.SuccessCode) {
+		d.platformData.terminating.Store(true)
+		syscall.Kill(d.platformData.Pid, syscall.SIGTERM)
+		select {
+		case finished = <-childChan:
+			result.ExitedInGrace = true
+		case <-time.After(sub.KillGracePeriod):
+		}
+	}
 	if finished == nil {
-		result.SuccessCode |= EF_KILLED
+		if !result.StdOutClosedEarly {
+			result.SuccessCode |= EF_KILLED
+		}
+		killStart := time.Now()
 		syscall.Kill(d.platformData.Pid, syscall.SIGKILL)
 		// Can block if process is unkillable.
 		finished = <-childChan
+		result.KillWaitTime = time.Since(killStart)
+		if result.StdOutClosedEarly {
+			// Ends like a normal exit, it's our kill.
+			finished.SuccessCode &^= EF_KILLED_BY_OTHER
+			finished.ExitCode = 0
+		}
 	}
 	UpdateRunningUsage(&d.platformData, sub.Options, &result)
+	closeStart := time.Now()
 	sub.Options.Cg.Remove(strconv.Itoa(d.platformData.Pid))
+	result.CloseTime = time.Since(closeStart)
+	if result.KillWaitTime+result.CloseTime >= slowTeardownThreshold {
+		log.Warningf("Slow teardown of %q: kill wait %s, close %s", sub.Cmd.ApplicationName, result.KillWaitTime, result.CloseTime)
+	}
 	result.ExitCode = finished.ExitCode
 	result.KernelTime = finished.RusageCpuKernel
+	ioWait.setResult(&result)
+	if sub.MeasurePaging {
+		result.setHardFaults(finished.MajorFaults)
+	}
 	result.SuccessCode |= finished.SuccessCode
 	sub.SetPostLimits(&result)
+	d.runAfterExit()
 
 	for _ = range d.startAfterStart {
 		err := <-d.bufferChan
@@ -192,6 +396,12 @@ W:
 			log.Error(err)
 		}
 	}
+	if d.remoteLost.Load() {
+		result.SuccessCode |= EF_REMOTE_DISCONNECTED
+	}
+	d.setInputError(&result)
+	d.setOutputSizes(sub, &result)
+	result.StdoutCleanlyClosed = result.SuccessCode == 0 && d.stdOutReachedEOF()
 
 	if d.stdOut.Len() > 0 {
 		result.Output = d.stdOut.Bytes()
@@ -199,6 +409,12 @@ W:
 	if d.stdErr.Len() > 0 {
 		result.Error = d.stdErr.Bytes()
 	}
+	result.buffers = []*bytes.Buffer{d.stdOut, d.stdErr}
+	result.Input = d.stdInCapture.Bytes()
+	result.StdinLimitHit = d.stdInLimitHit.Load()
+	result.OutputChunks = d.stdOutChunks.Chunks()
+	result.ErrorChunks = d.stdErrChunks.Chunks()
+	result.Comparison = d.comparison
 	return &result
 }
 
@@ -209,3 +425,7 @@ func maybeLockOSThread() {
 func maybeUnlockOSThread() {
 	runtime.UnlockOSThread()
 }
+
+func grantFileAccess(path string, login *LoginInfo) error {
+	return os.Chown(path, login.Uid, -1)
+}
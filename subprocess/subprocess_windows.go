@@ -2,14 +2,17 @@ package subprocess
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
 	"os"
+	"regexp"
 	"runtime"
 	"syscall"
 	"time"
 	"unsafe"
 
 	"github.com/contester/runlib/win32"
+	"golang.org/x/sys/windows"
 
 	log "github.com/sirupsen/logrus"
 )
@@ -24,11 +27,89 @@ type PlatformData struct {
 	hStdErr syscall.Handle
 
 	use32BitLoadLibrary bool
+	downgraded          []SecurityFeature
+	cpuRatePercent      uint32
+	ioPriority          IoPriority
+
+	jobMonitor *jobMonitor
+	threads    *threadCounter
+
+	pseudoConsole uintptr
+	privileges    []string
+	shimStatus    string
 }
 
 type PlatformOptions struct {
 	Environment PlatformEnvironment
 	InjectDLL   []string
+	// Configuration for injected DLLs, passed to the process as RESERVED_ENV_PREFIX+key=value, see childEnvironment.
+	InjectEnv map[string]string
+
+	// Extra directories to search for DLLs loaded at runtime, relative to CurrentDirectory.
+	DllDirectories []string
+	// Don't restrict DLL search order, see restrictDllSearch.
+	UnrestrictedDllSearch bool
+	// Application manifest to run the executable with, to pin side-by-side assemblies it binds to, see
+	// installManifest. It's left next to the executable, as <ApplicationName>.manifest, after the run.
+	Manifest string
+
+	// Process priority class, like windows.BELOW_NORMAL_PRIORITY_CLASS; 0 keeps the default.
+	// REALTIME_PRIORITY_CLASS needs SeIncreaseBasePriorityPrivilege, without it Windows quietly uses HIGH instead.
+	PriorityClass uint32
+	// Priority of the main thread relative to the class, like win32.THREAD_PRIORITY_ABOVE_NORMAL; other threads
+	// aren't affected. THREAD_PRIORITY_TIME_CRITICAL and THREAD_PRIORITY_IDLE map to 15 and 1 in all classes
+	// but realtime.
+	MainThreadPriority int32
+	// See IoPriority; applied to the whole process.
+	IoPriority IoPriority
+
+	// Called with each job notification, on the monitoring goroutine and in the order they arrive, after the
+	// library has recorded it; limits the library enforces act on recorded state at the next TimeQuantum tick.
+	// It must not block, as notifications are queued until it returns. Not called without a job.
+	JobNotificationHandler func(JobNotification)
+
+	// Run the process in a pseudo console (ConPTY), for programs behaving differently on a TTY. Stdin and stdout
+	// redirects feed and receive the console, stderr goes to stdout; output contains VT sequences. Needs Windows 10
+	// 1809, and doesn't work under Login on Windows 8+ (CreateProcessWithLogonW can't take it): runs fall back to
+	// plain redirects with a warning.
+	PseudoConsole bool
+
+	// Run the process in this AppContainer profile (Windows 8+), created on first use. The container sees only what
+	// is granted to its SID or to ALL APPLICATION PACKAGES; CurrentDirectory is granted full access before the start.
+	// Can't be used with Login.
+	AppContainer string
+	// Capabilities of the container, as SID strings or names from AppContainerCapabilities. None by default.
+	AppContainerCapabilities []string
+	// With AppContainer: run as a less privileged container (Windows 10 1703+), which doesn't get what's granted to
+	// ALL APPLICATION PACKAGES, so most of the registry is closed, while stdio, CurrentDirectory and system
+	// libraries still work. Keys in RegistryAllowlist, like `MACHINE\SOFTWARE\Microsoft\.NETFramework`, are opened
+	// for reading to the container; runtime directories outside the system ones need a file ACL the same way.
+	// Denied accesses are reported with EF_REGISTRY_ACCESS_DENIED if the host audits them, see registryDenials.
+	DenyRegistry      bool
+	RegistryAllowlist []string
+	// Fill SubprocessResult.NamedObjectCount after the run, and flag it above NamedObjectLimit. A burst of named
+	// events or mutexes is a sign of trying to exhaust the host rather than solve the problem. Needs the host to
+	// audit kernel objects, see namedObjectOpens.
+	AuditNamedObjects bool
+	NamedObjectLimit  uint32
+
+	// Privileges to remove from the process token before the start, like "SeShutdownPrivilege". If empty, a process
+	// under Login loses all but SeChangeNotifyPrivilege, unless KeepPrivileges is set; others keep what they have.
+	RemovePrivileges []string
+	KeepPrivileges   bool
+	// Keep SeLockMemoryPrivilege, which is removed regardless of the above otherwise. Large pages it allows are
+	// locked in physical memory: they are counted in commit, so private and job accounting still see them, but not
+	// in the working set, so MEMORY_ACCOUNTING_WORKING_SET misses them and they can't be trimmed under pressure.
+	AllowLargePages bool
+
+	// What to do when a SecurityFeature can't be applied on this host, by feature; see FailPolicy.
+	FailPolicies map[SecurityFeature]FailPolicy
+
+	// Ask injected DLLs to report GetLastError() at exit, see SHIM_STATUS_ENV. Does nothing without InjectDLL.
+	ReportLastError bool
+	// Ask injected DLLs to report arguments and environment as the process itself sees them, into
+	// SubprocessResult.ChildView, to catch quoting and encoding mistakes. Does nothing without InjectDLL.
+	ReportChildView bool
 }
 
 type LoginInfo struct {
@@ -45,6 +126,17 @@ func NewLoginInfo(username, password string) (*LoginInfo, error) {
 	return result, nil
 }
 
+// AppendArgs adds arguments to the command line, quoting them as needed.
+func (c *CommandLine) AppendArgs(args ...string) {
+	if c.CommandLine == "" && c.ApplicationName != "" {
+		c.CommandLine = syscall.EscapeArg(c.ApplicationName)
+	}
+	for _, v := range args {
+		c.CommandLine += " " + syscall.EscapeArg(v)
+		c.Parameters = append(c.Parameters, v)
+	}
+}
+
 // 1. setup; create redirects
 // 2. createFrozen
 // 3. setupOnFrozen; close redirects, extra memory; start reader/waiter threads; inject dll
@@ -70,16 +162,20 @@ func (d *SubprocessData) wInputRedirect(w *Redirect) (syscall.Handle, error) {
 func (d *SubprocessData) wAllRedirects(s *Subprocess, si *syscall.StartupInfo) error {
 	var err error
 
+	d.nonce = s.Nonce
+	d.relayStdOut = s.OnStdOutClose == STDOUT_CLOSE_FINISH
+	d.inputStallTimeout = s.InputStallTimeout
+	d.excludeInputWait = s.ExcludeInputWait
 	if si.StdInput, err = d.wInputRedirect(s.StdIn); err != nil {
 		return err
 	}
-	if si.StdOutput, err = d.wOutputRedirect(s.StdOut, &d.stdOut, false); err != nil {
+	if si.StdOutput, err = d.wOutputRedirect(s.StdOut, d.stdOut, false); err != nil {
 		return err
 	}
 	if s.JoinStdOutErr {
 		si.StdErr = si.StdOutput
 	} else {
-		if si.StdErr, err = d.wOutputRedirect(s.StdErr, &d.stdErr, true); err != nil {
+		if si.StdErr, err = d.wOutputRedirect(s.StdErr, d.stdErr, true); err != nil {
 			return err
 		}
 	}
@@ -126,13 +222,25 @@ func (d *PlatformData) terminateAndClose() (err error) {
 	if err = terminateProcessLoop(d.hProcess); err != nil {
 		return
 	}
+	d.jobMonitor.Close()
+	d.closePseudoConsole()
 	syscall.CloseHandle(d.hThread)
 	syscall.CloseHandle(d.hProcess)
 	return
 }
 
 func (sub *Subprocess) CreateFrozen() (*SubprocessData, error) {
-	var d SubprocessData
+	d := SubprocessData{stdOut: getOutputBuffer(), stdErr: getOutputBuffer()}
+
+	exe, err := sub.lockExecutable()
+	if err != nil {
+		return &d, err
+	}
+	defer exe.Close()
+
+	if err := installManifest(sub); err != nil {
+		return &d, err
+	}
 
 	si := syscall.StartupInfo{
 		Flags:      win32.STARTF_FORCEOFFFEEDBACK | syscall.STARTF_USESHOWWINDOW,
@@ -143,28 +251,97 @@ func (sub *Subprocess) CreateFrozen() (*SubprocessData, error) {
 
 	if sub.Options != nil && sub.Options.Environment != nil {
 		if err := d.initArchDependentData(sub); err != nil {
-			return nil, err
+			return &d, err
+		}
+		if err := checkInjectDlls(sub); err != nil {
+			return &d, err
 		}
 
 		if !useCreateProcessWithLogonW {
 			desktopName, err := sub.Options.Environment.GetDesktopName()
 			if err != nil {
-				return nil, err
+				if err = d.downgrade(sub, FEATURE_DESKTOP, FAIL_CLOSED, err); err != nil {
+					return &d, err
+				}
+			} else if desktopName != "" {
+				si.Desktop = syscall.StringToUTF16Ptr(desktopName)
+			} else if err = d.downgrade(sub, FEATURE_DESKTOP, FAIL_OPEN, errors.New("no isolation desktop on this host")); err != nil {
+				return &d, err
 			}
-
-			si.Desktop = syscall.StringToUTF16Ptr(desktopName)
 		}
 	}
 
-	e := d.wAllRedirects(sub, &si)
+	d.setupShimStatus(sub)
+	envOptions, e := sub.childEnvironment()
+	if e != nil {
+		return &d, e
+	}
+
+	e = d.wAllRedirects(sub, &si)
 	if e != nil {
-		return nil, e
+		return &d, e
+	}
+
+	psi := &si
+	// Own console and process group in all modes, so console control events of ours, like Ctrl+C in the console
+	// the service runs in, don't reach the child and turn into its verdict.
+	consoleFlags := uint32(win32.CREATE_NEW_CONSOLE)
+	var usePseudoConsole, useAppContainer bool
+	if sub.Options != nil {
+		if sub.Options.PseudoConsole {
+			switch {
+			case !win32.HasPseudoConsole():
+				log.Warningf("CreateFrozen: pseudo console isn't available, using plain redirects")
+			case sub.Login != nil && useCreateProcessWithLogonW:
+				log.Warningf("CreateFrozen: pseudo console isn't supported with login, using plain redirects")
+			default:
+				usePseudoConsole = true
+			}
+		}
+		if useAppContainer = sub.Options.AppContainer != ""; useAppContainer && sub.Login != nil {
+			return &d, fmt.Errorf("CreateFrozen: AppContainer can't be combined with login")
+		}
+		if sub.Options.DenyRegistry && !useAppContainer {
+			return &d, fmt.Errorf("%w: DenyRegistry needs AppContainer", ErrUserError)
+		}
+	}
+	if usePseudoConsole || useAppContainer {
+		attrs, err := windows.NewProcThreadAttributeList(3)
+		if err != nil {
+			return &d, fmt.Errorf("NewProcThreadAttributeList: %w", err)
+		}
+		// Only needed by CreateProcess.
+		defer attrs.Delete()
+		if usePseudoConsole {
+			if err = d.attachPseudoConsole(&si, attrs); err != nil {
+				return &d, fmt.Errorf("attachPseudoConsole: %w", err)
+			}
+			consoleFlags = 0
+		}
+		if useAppContainer {
+			sid, err := d.attachAppContainer(sub, attrs)
+			if err != nil {
+				d.platformData.closePseudoConsole()
+				return &d, fmt.Errorf("attachAppContainer(%q): %w", sub.Options.AppContainer, err)
+			}
+			defer windows.FreeSid(sid)
+		}
+		siex := &win32.StartupInfoEx{StartupInfo: si, AttributeList: attrs.List()}
+		siex.Cb = uint32(unsafe.Sizeof(*siex))
+		psi = &siex.StartupInfo
+		consoleFlags |= win32.EXTENDED_STARTUPINFO_PRESENT
+	}
+
+	if sub.CrashDumps {
+		consoleFlags |= windows.CREATE_DEFAULT_ERROR_MODE
+	} else {
+		disableCrashReporting()
 	}
 
 	var pi syscall.ProcessInformation
 
 	syscall.ForkLock.Lock()
-	wSetInherit(&si)
+	wSetInherit(psi)
 
 	if sub.Login != nil {
 		if useCreateProcessWithLogonW {
@@ -175,13 +352,10 @@ func (sub *Subprocess) CreateFrozen() (*SubprocessData, error) {
 				win32.LOGON_WITH_PROFILE,
 				sub.Cmd.ApplicationName,
 				sub.Cmd.CommandLine,
-				win32.CREATE_SUSPENDED|syscall.CREATE_UNICODE_ENVIRONMENT,
-				win32.ProcessEnvironmentOptions{
-					NoInherit: sub.NoInheritEnvironment,
-					Env:       sub.Environment,
-				},
+				win32.CREATE_NEW_PROCESS_GROUP|consoleFlags|win32.CREATE_SUSPENDED|syscall.CREATE_UNICODE_ENVIRONMENT,
+				envOptions,
 				sub.CurrentDirectory,
-				&si,
+				psi,
 				&pi)
 		} else {
 			e = win32.CreateProcessAsUser(
@@ -191,14 +365,11 @@ func (sub *Subprocess) CreateFrozen() (*SubprocessData, error) {
 				nil,
 				nil,
 				true,
-				win32.CREATE_NEW_PROCESS_GROUP|win32.CREATE_NEW_CONSOLE|win32.CREATE_SUSPENDED|
+				win32.CREATE_NEW_PROCESS_GROUP|consoleFlags|win32.CREATE_SUSPENDED|
 					syscall.CREATE_UNICODE_ENVIRONMENT|win32.CREATE_BREAKAWAY_FROM_JOB,
-				win32.ProcessEnvironmentOptions{
-					NoInherit: sub.NoInheritEnvironment,
-					Env:       sub.Environment,
-				},
+				envOptions,
 				sub.CurrentDirectory,
-				&si,
+				psi,
 				&pi)
 		}
 	} else {
@@ -208,14 +379,11 @@ func (sub *Subprocess) CreateFrozen() (*SubprocessData, error) {
 			nil,
 			nil,
 			true,
-			win32.CREATE_NEW_PROCESS_GROUP|win32.CREATE_NEW_CONSOLE|win32.CREATE_SUSPENDED|
+			win32.CREATE_NEW_PROCESS_GROUP|consoleFlags|win32.CREATE_SUSPENDED|
 				syscall.CREATE_UNICODE_ENVIRONMENT|win32.CREATE_BREAKAWAY_FROM_JOB,
-			win32.ProcessEnvironmentOptions{
-				NoInherit: sub.NoInheritEnvironment,
-				Env:       sub.Environment,
-			},
+			envOptions,
 			sub.CurrentDirectory,
-			&si,
+			psi,
 			&pi)
 	}
 
@@ -228,30 +396,63 @@ func (sub *Subprocess) CreateFrozen() (*SubprocessData, error) {
 		} else {
 			e = fmt.Errorf("CreateProcess(%q): %w", sub.Cmd.ApplicationName, e)
 		}
-		return nil, e
+		d.platformData.closePseudoConsole()
+		return &d, e
 	}
 
 	d.platformData.hProcess = pi.Process
 	d.platformData.hThread = pi.Thread
 	d.platformData.hJob = syscall.InvalidHandle
+	d.platformData.threads = newThreadCounter(sub)
 
-	for _, dll := range sub.Options.InjectDLL {
-		if e = InjectDll(&d, sub.Options.Environment, dll); e != nil {
-			break
+	if !sub.Options.UnrestrictedDllSearch {
+		if e = restrictDllSearch(&d, sub); e != nil {
+			def := FAIL_OPEN
+			if len(sub.Options.DllDirectories) != 0 {
+				def = FAIL_CLOSED
+			}
+			e = d.downgrade(sub, FEATURE_DLL_SEARCH, def, fmt.Errorf("restrictDllSearch: %w", e))
+		}
+	}
+
+	if e == nil {
+		e = applyPrivileges(&d, sub)
+	}
+
+	if e == nil {
+		for _, dll := range sub.Options.InjectDLL {
+			if e = InjectDll(&d, sub.Options.Environment, dll); e != nil {
+				break
+			}
 		}
 	}
 
 	if e != nil {
 		// Terminate process/thread here.
 		d.platformData.terminateAndClose()
-		return nil, e
+		return &d, e
+	}
+
+	setPriority(&d, sub.Options)
+
+	affinity := sub.ProcessAffinityMask
+	if sub.Deterministic {
+		if affinity == 0 {
+			if mask, _, err := win32.GetProcessAffinityMask(syscall.Handle(^uintptr(0))); err == nil {
+				affinity = mask
+			} else {
+				log.Warningf("CreateFrozen: %s", err)
+			}
+		}
+		affinity = lastCpu(affinity)
+		disablePriorityBoost(&d)
 	}
 
-	if sub.ProcessAffinityMask != 0 {
-		e = win32.SetProcessAffinityMask(d.platformData.hProcess, sub.ProcessAffinityMask)
+	if affinity != 0 {
+		e = win32.SetProcessAffinityMask(d.platformData.hProcess, affinity)
 		if e != nil {
 			d.platformData.terminateAndClose()
-			return nil, fmt.Errorf("SetProcessAffinityMask(b%b): %w", sub.ProcessAffinityMask, e)
+			return &d, fmt.Errorf("SetProcessAffinityMask(b%b): %w", affinity, e)
 		}
 	}
 
@@ -261,7 +462,7 @@ func (sub *Subprocess) CreateFrozen() (*SubprocessData, error) {
 			if sub.FailOnJobCreationFailure {
 				d.platformData.terminateAndClose()
 
-				return nil, fmt.Errorf("CreateJob: %w", e)
+				return &d, fmt.Errorf("CreateJob: %w", e)
 			}
 			log.Error("CreateFrozen/CreateJob: %s", e)
 		} else {
@@ -271,10 +472,12 @@ func (sub *Subprocess) CreateFrozen() (*SubprocessData, error) {
 					d.platformData.hJob, d.platformData.hProcess, d.platformData)
 				syscall.CloseHandle(d.platformData.hJob)
 				d.platformData.hJob = syscall.InvalidHandle
+				d.platformData.jobMonitor.Close()
+				d.platformData.jobMonitor = nil
 				if sub.FailOnJobCreationFailure {
 					d.platformData.terminateAndClose()
 
-					return nil, fmt.Errorf("AssignProcessToJobObject: %w", e)
+					return &d, fmt.Errorf("AssignProcessToJobObject: %w", e)
 				}
 			}
 		}
@@ -283,6 +486,33 @@ func (sub *Subprocess) CreateFrozen() (*SubprocessData, error) {
 	return &d, nil
 }
 
+// setPriority applies priorities while the process is suspended. Failures aren't fatal: the run is still valid,
+// just not as fast (or slow) as requested.
+func setPriority(d *SubprocessData, opts *PlatformOptions) {
+	if opts.PriorityClass != 0 {
+		if err := win32.SetPriorityClass(d.platformData.hProcess, opts.PriorityClass); err != nil {
+			log.Warningf("setPriority: priority class %#x: %s", opts.PriorityClass, err)
+		}
+	}
+	if opts.MainThreadPriority != win32.THREAD_PRIORITY_NORMAL {
+		if err := win32.SetThreadPriority(d.platformData.hThread, opts.MainThreadPriority); err != nil {
+			log.Warningf("setPriority: thread priority %d: %s", opts.MainThreadPriority, err)
+		}
+	}
+	setIoPriority(d, opts.IoPriority)
+}
+
+// disablePriorityBoost keeps priorities of the process threads fixed, so they aren't raised after waits.
+func disablePriorityBoost(d *SubprocessData) {
+	if err := win32.SetProcessPriorityBoost(d.platformData.hProcess, true); err != nil {
+		log.Warningf("disablePriorityBoost: %s", err)
+	}
+	// Thread created before the call keeps its own setting.
+	if err := win32.SetThreadPriorityBoost(d.platformData.hThread, true); err != nil {
+		log.Warningf("disablePriorityBoost: %s", err)
+	}
+}
+
 func CreateJob(s *Subprocess, d *SubprocessData) error {
 	var e error
 	d.platformData.hJob, e = win32.CreateJobObject(nil, nil)
@@ -310,13 +540,17 @@ func CreateJob(s *Subprocess, d *SubprocessData) error {
 
 	einfo := win32.JobObjectExtendedLimitInformation{
 		BasicLimitInformation: win32.JobObjectBasicLimitInformation{
-			LimitFlags: win32.JOB_OBJECT_LIMIT_DIE_ON_UNHANDLED_EXCEPTION | win32.JOB_OBJECT_LIMIT_KILL_ON_JOB_CLOSE,
+			LimitFlags: win32.JOB_OBJECT_LIMIT_KILL_ON_JOB_CLOSE,
 		},
 	}
+	if !s.CrashDumps {
+		// Same as SEM_NOGPFAULTERRORBOX in every process of the job.
+		einfo.BasicLimitInformation.LimitFlags |= win32.JOB_OBJECT_LIMIT_DIE_ON_UNHANDLED_EXCEPTION
+	}
 
 	var hardTimeLimit time.Duration
 	if s.TimeLimit > 0 {
-		hardTimeLimit = s.TimeLimit + time.Second
+		hardTimeLimit = s.TimeLimit + time.Second + s.KillGracePeriod
 	} else {
 		hardTimeLimit = s.WallTimeLimit
 	}
@@ -341,6 +575,18 @@ func CreateJob(s *Subprocess, d *SubprocessData) error {
 		einfo.BasicLimitInformation.LimitFlags |= win32.JOB_OBJECT_LIMIT_JOB_MEMORY | win32.JOB_OBJECT_LIMIT_PROCESS_MEMORY | win32.JOB_OBJECT_LIMIT_WORKINGSET
 	}
 
+	if s.CpuRatePercent > 0 && s.CpuRatePercent < 100 {
+		rinfo := win32.JobObjectCpuRateControlInformation{
+			ControlFlags: win32.JOB_OBJECT_CPU_RATE_CONTROL_ENABLE | win32.JOB_OBJECT_CPU_RATE_CONTROL_HARD_CAP,
+			CpuRate:      s.CpuRatePercent * 100,
+		}
+		if e = win32.SetJobObjectCpuRateControlInformation(d.platformData.hJob, &rinfo); e != nil {
+			log.Warningf("CreateJob: CPU rate control %d%%: %s", s.CpuRatePercent, e)
+		} else {
+			d.platformData.cpuRatePercent = s.CpuRatePercent
+		}
+	}
+
 	// If we don't create job then we need to set process affinity on the process handle after its creation.
 	// if s.ProcessAffinityMask != 0 {
 	//	einfo.BasicLimitInformation.Affinity = uintptr(s.ProcessAffinityMask)
@@ -352,9 +598,45 @@ func CreateJob(s *Subprocess, d *SubprocessData) error {
 		syscall.CloseHandle(d.platformData.hJob)
 		return fmt.Errorf("SetJobObjectExtendedLimitInformation: %w", e)
 	}
+
+	var handler func(JobNotification)
+	if s.Options != nil {
+		handler = s.Options.JobNotificationHandler
+	}
+	if threads := d.platformData.threads; threads != nil {
+		// A process joining the job is the one thread-related event we're told about, sample right then.
+		next := handler
+		handler = func(n JobNotification) {
+			if n.Message == win32.JOB_OBJECT_MSG_NEW_PROCESS {
+				threads.Update(&d.platformData)
+			}
+			if next != nil {
+				next(n)
+			}
+		}
+	}
+	if d.platformData.jobMonitor, e = newJobMonitor(d.platformData.hJob, s.TotalProcessLimit, handler); e != nil {
+		log.Warningf("CreateJob: job notifications unavailable: %s", e)
+	}
 	return nil
 }
 
+// totalProcessLimitHit checks the job monitor count, and the job accounting as well, since notifications can be lost.
+func (sub *Subprocess) totalProcessLimitHit(p *PlatformData, result *SubprocessResult) bool {
+	return sub.TotalProcessLimit > 0 &&
+		(p.jobMonitor.TotalProcessLimitHit() || result.TotalProcesses > uint64(sub.TotalProcessLimit))
+}
+
+var quoteSplitRegexp = regexp.MustCompile("'.+'|\".+\"|\\S+")
+
+func getImageName(sub *Subprocess) string {
+	if sub.Cmd.ApplicationName != "" {
+		return sub.Cmd.ApplicationName
+	}
+	m := quoteSplitRegexp.FindAllString(sub.Cmd.CommandLine, -1)
+	return m[0]
+}
+
 func InjectDll(d *SubprocessData, env PlatformEnvironment, dll string) error {
 	loadLibraryW, err := d.getLoadLibraryW(env)
 	if err != nil {
@@ -365,35 +647,52 @@ func InjectDll(d *SubprocessData, env PlatformEnvironment, dll string) error {
 	}
 
 	log.Debug("InjectDll: Injecting library %s with call to %d", dll, loadLibraryW)
-	name, err := syscall.UTF16FromString(dll)
+	remoteName, err := writeRemoteString(d.platformData.hProcess, dll)
+	if err != nil {
+		return err
+	}
+	defer win32.VirtualFreeEx(d.platformData.hProcess, remoteName, 0, win32.MEM_RELEASE)
+
+	_, err = callRemote(d.platformData.hProcess, loadLibraryW, remoteName)
+	return err
+}
+
+// writeRemoteString copies s as an UTF-16 string into the process memory. Caller must free the result.
+func writeRemoteString(process syscall.Handle, s string) (uintptr, error) {
+	name, err := syscall.UTF16FromString(s)
 	if err != nil {
-		return fmt.Errorf("%w: UTF16FromString(%q): %w", ErrUserError, dll, err)
+		return 0, fmt.Errorf("%w: UTF16FromString(%q): %w", ErrUserError, s, err)
 	}
-	nameLen := uint32((len(name) + 1) * 2)
-	remoteName, err := win32.VirtualAllocEx(d.platformData.hProcess, 0, nameLen, win32.MEM_COMMIT, win32.PAGE_READWRITE)
+	nameLen := uint32(len(name) * 2)
+	remoteName, err := win32.VirtualAllocEx(process, 0, nameLen, win32.MEM_COMMIT, win32.PAGE_READWRITE)
 	if err != nil {
-		return fmt.Errorf("VirtualAllocEx(%d): %w", nameLen, err)
+		return 0, fmt.Errorf("VirtualAllocEx(%d): %w", nameLen, err)
 	}
-	defer win32.VirtualFreeEx(d.platformData.hProcess, remoteName, 0, win32.MEM_RELEASE)
 
-	if _, err = win32.WriteProcessMemory(d.platformData.hProcess, remoteName, unsafe.Pointer(&name[0]), nameLen); err != nil {
-		return fmt.Errorf("WriteProcessMemory: %w", err)
+	if _, err = win32.WriteProcessMemory(process, remoteName, unsafe.Pointer(&name[0]), nameLen); err != nil {
+		win32.VirtualFreeEx(process, remoteName, 0, win32.MEM_RELEASE)
+		return 0, fmt.Errorf("WriteProcessMemory: %w", err)
 	}
 	runtime.KeepAlive(name)
-	thread, _, err := win32.CreateRemoteThread(d.platformData.hProcess, win32.MakeInheritSa(), 0, loadLibraryW, remoteName, 0)
+	return remoteName, nil
+}
+
+// callRemote runs fn(arg) in a new thread in the process and returns the thread exit code.
+func callRemote(process syscall.Handle, fn, arg uintptr) (uint32, error) {
+	thread, _, err := win32.CreateRemoteThread(process, win32.MakeInheritSa(), 0, fn, arg, 0)
 	if err != nil {
-		return fmt.Errorf("CreateRemoteThread: %w", err)
+		return 0, fmt.Errorf("CreateRemoteThread: %w", err)
 	}
 	defer syscall.CloseHandle(thread)
 	wr, err := syscall.WaitForSingleObject(thread, syscall.INFINITE)
 	if err != nil {
-		return fmt.Errorf("WaitForSingleObject: %w", os.NewSyscallError("WaitForSingleObject", err))
+		return 0, fmt.Errorf("WaitForSingleObject: %w", os.NewSyscallError("WaitForSingleObject", err))
 	}
 	if wr != syscall.WAIT_OBJECT_0 {
-		return fmt.Errorf("Unexpected wait result %v", wr)
+		return 0, fmt.Errorf("Unexpected wait result %v", wr)
 	}
 
-	return nil
+	return win32.GetExitCodeThread(thread)
 }
 
 func (d *SubprocessData) Unfreeze() error {
@@ -473,24 +772,69 @@ func GetProcessMemoryUsage(process syscall.Handle) uint64 {
 	return uint64(pmc.PrivateUsage)
 }
 
-func UpdateProcessMemory(pdata *PlatformData, result *SubprocessResult) {
-	var jinfo *win32.JobObjectExtendedLimitInformation
-	var err error
-
+// UpdateProcessMemory keeps the largest peaks seen. The job keeps its accounting after the process exits, while
+// the process counters can read as zero by then, so the job's are preferred.
+func UpdateProcessMemory(pdata *PlatformData, accounting MemoryAccounting, result *SubprocessResult) {
 	if pdata.hJob != syscall.InvalidHandle {
-		jinfo, err = win32.GetJobObjectExtendedLimitInformation(pdata.hJob)
-		if err != nil {
+		if jinfo, err := win32.GetJobObjectExtendedLimitInformation(pdata.hJob); err == nil {
+			if v := uint64(jinfo.PeakJobMemoryUsed); v > result.PeakJobMemory {
+				result.PeakJobMemory = v
+			}
+			if v := uint64(jinfo.PeakProcessMemoryUsed); v > result.PeakProcessMemory {
+				result.PeakProcessMemory = v
+			}
+		} else {
 			log.Error(err)
 		}
 	}
-	if jinfo != nil {
-		result.PeakMemory = uint64(jinfo.PeakJobMemoryUsed)
-	} else {
-		result.PeakMemory = uint64(GetProcessMemoryUsage(pdata.hProcess))
+	if v := GetProcessMemoryUsage(pdata.hProcess); v > result.PeakProcessMemory {
+		result.PeakProcessMemory = v
+	}
+
+	switch {
+	case accounting == MEMORY_ACCOUNTING_WORKING_SET:
+		if pmc, err := win32.GetProcessMemoryInfo(pdata.hProcess); err == nil {
+			result.PeakMemory = uint64(pmc.PeakWorkingSetSize)
+		} else {
+			log.Error(err)
+		}
+	case result.PeakJobMemory != 0:
+		result.PeakMemory = result.PeakJobMemory
+	default:
+		result.PeakMemory = result.PeakProcessMemory
+	}
+}
+
+func UpdateProcessVirtualMemory(pdata *PlatformData, result *SubprocessResult) {
+	vmc, err := win32.GetProcessVmCounters(pdata.hProcess)
+	if err != nil {
+		log.Error(err)
+		return
+	}
+	result.PeakVirtualMemory = uint64(vmc.PeakVirtualSize)
+}
+
+func sampleProcessMemory(process syscall.Handle) (commit, workingSet uint64) {
+	pmc, err := win32.GetProcessMemoryInfo(process)
+	if err != nil {
+		return 0, 0
 	}
+	return uint64(pmc.PrivateUsage), uint64(pmc.WorkingSetSize)
+}
+
+// Exit codes of processes killed by unhandled exceptions are NTSTATUS errors, like 0xC0000005.
+func isExceptionExitCode(code uint32) bool {
+	return code >= 0xC0000000
+}
+
+// Return -1 is 0xFFFFFFFF.
+func signedExitCode(code uint32) int32 {
+	return int32(code)
 }
 
-func loopTerminate(hProcess syscall.Handle) {
+// loopTerminate kills the process and waits for it to exit, returning time spent.
+func loopTerminate(hProcess syscall.Handle) time.Duration {
+	start := time.Now()
 	for {
 		if err := syscall.TerminateProcess(hProcess, 0); err != nil {
 			log.Errorf("Error terminating process %d: %s", hProcess, err)
@@ -502,6 +846,7 @@ func loopTerminate(hProcess syscall.Handle) {
 			break
 		}
 	}
+	return time.Since(start)
 }
 
 func (sub *Subprocess) BottomHalf(d *SubprocessData) *SubprocessResult {
@@ -510,12 +855,53 @@ func (sub *Subprocess) BottomHalf(d *SubprocessData) *SubprocessResult {
 	var result SubprocessResult
 	var waitResult uint32
 	waitResult = syscall.WAIT_TIMEOUT
+	started := time.Now()
 
 	var runState runningState
 	var err error
 
+	result.DowngradedFeatures = d.platformData.downgraded
+	result.IsolationDowngraded = len(result.DowngradedFeatures) > 0
+	result.Privileges = d.platformData.privileges
+	result.CpuRatePercent = d.platformData.cpuRatePercent
+	result.IoPriority = d.platformData.ioPriority
+
+	sampler := startMemorySampler(sub.MemorySampleInterval, func() (uint64, uint64) {
+		return sampleProcessMemory(hProcess)
+	})
+	var faults *hardFaultCounter
+	var trims *trimDetector
+	if sub.MeasurePaging {
+		faults = newHardFaultCounter(&d.platformData)
+		trims = &trimDetector{process: hProcess}
+	}
+	modules := newModuleCounter(sub)
+	handles := newHandleCounter(sub)
+	files := newDistinctFiles(sub)
+	sockets := newSocketCounter(sub)
+	ioWait := newIoWaitEstimator(sub)
+	schedDelay := newSchedDelayMeter(sub)
+
+	// The job keeps the peak on its own; without it, all we know is what we sampled.
+	var extraChecks uint32
+	if hJob == syscall.InvalidHandle {
+		extraChecks = sub.MinMemoryChecks
+	}
+	UpdateProcessMemory(&d.platformData, sub.MemoryAccounting, &result)
+
+	dog := startWatchdog(sub.WatchdogTimeout, func() {
+		if hJob != syscall.InvalidHandle {
+			windows.TerminateJobObject(windows.Handle(hJob), 0)
+		}
+		syscall.TerminateProcess(hProcess, 0)
+	})
 	for result.SuccessCode == 0 && waitResult == syscall.WAIT_TIMEOUT {
-		waitResult, err = syscall.WaitForSingleObject(hProcess, uint32(sub.TimeQuantum.Milliseconds()))
+		dog.beat()
+		wait := sub.TimeQuantum
+		if extraChecks > 0 {
+			wait = sub.TimeQuantum / time.Duration(sub.MinMemoryChecks)
+		}
+		waitResult, err = syscall.WaitForSingleObject(hProcess, uint32(wait.Milliseconds()))
 		if waitResult != syscall.WAIT_TIMEOUT {
 			break
 		}
@@ -526,11 +912,43 @@ func (sub *Subprocess) BottomHalf(d *SubprocessData) *SubprocessResult {
 		if err = UpdateProcessTimes(&d.platformData, &result, false); err != nil {
 			log.Errorf("Error getting process times: %s", err)
 		}
-		if sub.MemoryLimit > 0 {
-			UpdateProcessMemory(&d.platformData, &result)
+		if sub.MemoryLimit > 0 || extraChecks > 0 {
+			UpdateProcessMemory(&d.platformData, sub.MemoryAccounting, &result)
+		}
+		if extraChecks > 0 {
+			extraChecks--
+		}
+		if sub.VirtualMemoryLimit > 0 {
+			UpdateProcessVirtualMemory(&d.platformData, &result)
+		}
+
+		faults.Update()
+		trims.Update()
+		modules.Update(&d.platformData)
+		d.platformData.threads.Update(&d.platformData)
+		if d.platformData.threads.exceeded(sub) {
+			result.SuccessCode |= EF_MULTITHREADED
+		}
+		if handles.Update(sub, &d.platformData) {
+			result.SuccessCode |= EF_HANDLE_LIMIT_HIT
+		}
+		if files.Update(sub, hProcess, started) {
+			result.SuccessCode |= EF_DISTINCT_FILE_LIMIT_HIT
+			break
 		}
+		sockets.Update(&d.platformData)
+		ioWait.Update(&d.platformData, &result)
+		schedDelay.Update(&d.platformData)
+		sub.pollAffinity(&d.platformData, &result)
 
+		d.stdInWait.Update(&result)
 		runState.Update(sub, &result)
+		if d.platformData.jobMonitor.ProcessLimitHit() {
+			result.SuccessCode |= EF_PROCESS_LIMIT_HIT
+		}
+		if sub.totalProcessLimitHit(&d.platformData, &result) {
+			result.SuccessCode |= EF_TOTAL_PROCESS_LIMIT_HIT
+		}
 
 		if d.outCheck != nil {
 			err = d.outCheck.Check()
@@ -549,10 +967,33 @@ func (sub *Subprocess) BottomHalf(d *SubprocessData) *SubprocessResult {
 				break
 			}
 		}
+
+		if d.remoteLost.Load() {
+			result.SuccessCode |= EF_REMOTE_DISCONNECTED
+			break
+		}
+
+		if d.stdInStall.Update(sub, &result) {
+			result.SuccessCode |= EF_INPUT_STALLED
+			break
+		}
+
+		if sub.aborted() {
+			result.SuccessCode |= EF_ABORTED
+			break
+		}
+
+		if result.SuccessCode == 0 && d.finishedCommunicating(sub) {
+			result.StdOutClosedEarly = true
+			break
+		}
+	}
+	if dog.Stop() {
+		result.SuccessCode |= EF_JUDGE_STALLED
 	}
 
 	if err != nil {
-		loopTerminate(hProcess)
+		result.KillWaitTime = loopTerminate(hProcess)
 	} else {
 		switch waitResult {
 		case syscall.WAIT_OBJECT_0:
@@ -561,27 +1002,103 @@ func (sub *Subprocess) BottomHalf(d *SubprocessData) *SubprocessResult {
 			}
 
 		case syscall.WAIT_TIMEOUT:
-			loopTerminate(hProcess)
+			if sub.KillSnapshotDir != "" && result.SuccessCode&(EF_WALL_TIME_LIMIT_HIT|EF_INACTIVE) != 0 {
+				result.KillSnapshot = takeKillSnapshot(hProcess, sub.KillSnapshotDir)
+			}
+			if sub.killGracefully(result.SuccessCode) {
+				pid, _ := windows.GetProcessId(windows.Handle(hProcess))
+				if err := stopGracefully(pid); err != nil {
+					log.Warningf("Can't stop process %d gracefully: %s", pid, err)
+				} else if w, _ := syscall.WaitForSingleObject(hProcess, uint32(sub.KillGracePeriod.Milliseconds())); w == syscall.WAIT_OBJECT_0 {
+					result.ExitedInGrace = true
+					if err = syscall.GetExitCodeProcess(hProcess, &result.ExitCode); err != nil {
+						log.Errorf("Error getting exit code %d: %s", hProcess, err)
+					}
+					break
+				}
+			}
+			result.KillWaitTime = loopTerminate(hProcess)
 		default:
 			log.Errorf("Unexpected waitResult %d: %d", hProcess, waitResult)
 		}
 	}
 
+	result.MemorySamples = sampler.Stop()
 	UpdateProcessTimes(&d.platformData, &result, true)
-	UpdateProcessMemory(&d.platformData, &result)
-
+	// The job monitor may have terminated the job, so the loop above saw a normal exit.
+	if sub.totalProcessLimitHit(&d.platformData, &result) {
+		result.SuccessCode |= EF_TOTAL_PROCESS_LIMIT_HIT
+	}
+	if sub.TotalProcessLimit > 0 {
+		result.PeakActiveProcesses = d.platformData.jobMonitor.PeakActiveProcesses()
+	}
+	UpdateProcessMemory(&d.platformData, sub.MemoryAccounting, &result)
+	UpdateProcessVirtualMemory(&d.platformData, &result)
+	if sub.Options != nil && sub.Options.DenyRegistry {
+		pid, _ := windows.GetProcessId(windows.Handle(hProcess))
+		if n, err := registryDenials(pid, started); err != nil {
+			log.Errorf("Error reading registry audit events: %s", err)
+		} else if n > 0 {
+			result.SuccessCode |= EF_REGISTRY_ACCESS_DENIED
+		}
+	}
+	if sub.Options != nil && sub.Options.AuditNamedObjects {
+		pid, _ := windows.GetProcessId(windows.Handle(hProcess))
+		if n, err := namedObjectOpens(pid, started); err != nil {
+			log.Errorf("Error reading kernel object audit events: %s", err)
+		} else {
+			result.NamedObjectCount = uint32(n)
+			result.NamedObjectLimitExceeded = sub.Options.NamedObjectLimit > 0 && result.NamedObjectCount > sub.Options.NamedObjectLimit
+		}
+	}
+	if sub.MeasureCycles {
+		if result.CpuCycles, err = win32.QueryProcessCycleTime(hProcess); err != nil {
+			log.Errorf("Error getting cycle time: %s", err)
+		}
+	}
+	if faults != nil {
+		faults.Update()
+		result.setHardFaults(faults.Total())
+		result.WorkingSetTrims = trims.Trims()
+	}
+	modules.setResult(sub, &result)
+	d.platformData.threads.setResult(sub, &result)
+	handles.setResult(&result)
+	// Count opens logged since the last quantum; the process is gone, so going over the limit now doesn't flag it.
+	files.Update(sub, hProcess, started)
+	files.setResult(&result)
+	sockets.setResult(sub, &result)
+	ioWait.setResult(&result)
+	schedDelay.setResult(&result)
+
+	closeStart := time.Now()
 	syscall.CloseHandle(hProcess)
 	if hJob != syscall.InvalidHandle {
+		// Closing the job kills remaining processes in it.
 		syscall.CloseHandle(hJob)
 	}
+	d.platformData.jobMonitor.Close()
+	result.CloseTime = time.Since(closeStart)
+	if result.KillWaitTime+result.CloseTime >= slowTeardownThreshold {
+		log.Warningf("Slow teardown of %q: kill wait %s, close %s", sub.Cmd.ApplicationName, result.KillWaitTime, result.CloseTime)
+	}
 
 	sub.SetPostLimits(&result)
+	d.platformData.closePseudoConsole()
+	d.platformData.readShimStatus(&result)
+	d.runAfterExit()
 	for range d.startAfterStart {
 		err := <-d.bufferChan
 		if err != nil {
 			log.Error(err)
 		}
 	}
+	if d.remoteLost.Load() {
+		result.SuccessCode |= EF_REMOTE_DISCONNECTED
+	}
+	d.setInputError(&result)
+	d.setOutputSizes(sub, &result)
+	result.StdoutCleanlyClosed = result.SuccessCode == 0 && !isExceptionExitCode(result.ExitCode) && d.stdOutReachedEOF()
 
 	if d.stdOut.Len() > 0 {
 		result.Output = d.stdOut.Bytes()
@@ -589,6 +1106,12 @@ func (sub *Subprocess) BottomHalf(d *SubprocessData) *SubprocessResult {
 	if d.stdErr.Len() > 0 {
 		result.Error = d.stdErr.Bytes()
 	}
+	result.buffers = []*bytes.Buffer{d.stdOut, d.stdErr}
+	result.Input = d.stdInCapture.Bytes()
+	result.StdinLimitHit = d.stdInLimitHit.Load()
+	result.OutputChunks = d.stdOutChunks.Chunks()
+	result.ErrorChunks = d.stdErrChunks.Chunks()
+	result.Comparison = d.comparison
 
 	if d.errCheck != nil {
 		d.errCheck.Close()
@@ -5,6 +5,7 @@ type archDependentPlatformData struct{}
 func (s *SubprocessData) initArchDependentData(sub *Subprocess) error { return nil }
 
 type PlatformEnvironment interface {
+	// Empty name means no desktop isolation is available, and processes run on the default desktop.
 	GetDesktopName() (string, error)
 	GetLoadLibraryW() (uintptr, error)
 }
@@ -1,7 +1,7 @@
 package subprocess
 
 import (
-	"regexp"
+	"fmt"
 
 	"github.com/contester/runlib/win32"
 )
@@ -17,11 +17,18 @@ func (s *SubprocessData) initArchDependentData(sub *Subprocess) error {
 			return err
 		}
 		s.platformData.use32BitLoadLibrary = binaryType == win32.SCS_32BIT_BINARY
+		// Resolve it now, so a host that can't fails before the process is created.
+		if s.platformData.use32BitLoadLibrary {
+			if _, err = sub.Options.Environment.GetLoadLibraryW32(); err != nil {
+				return fmt.Errorf("%q is 32-bit: %w", getImageName(sub), err)
+			}
+		}
 	}
 	return nil
 }
 
 type PlatformEnvironment interface {
+	// Empty name means no desktop isolation is available, and processes run on the default desktop.
 	GetDesktopName() (string, error)
 	GetLoadLibraryW() (uintptr, error)
 	GetLoadLibraryW32() (uintptr, error)
@@ -33,13 +40,3 @@ func (s *SubprocessData) getLoadLibraryW(env PlatformEnvironment) (uintptr, erro
 	}
 	return env.GetLoadLibraryW()
 }
-
-var quoteSplitRegexp = regexp.MustCompile("'.+'|\".+\"|\\S+")
-
-func getImageName(sub *Subprocess) string {
-	if sub.Cmd.ApplicationName != "" {
-		return sub.Cmd.ApplicationName
-	}
-	m := quoteSplitRegexp.FindAllString(sub.Cmd.CommandLine, -1)
-	return m[0]
-}
@@ -0,0 +1,43 @@
+package subprocess
+
+import "sync/atomic"
+
+// threadCounter keeps the largest number of threads seen running at once. Neither platform tells us when a thread
+// is created, so it's sampled: every TimeQuantum, and on Windows also whenever a process joins the job. Threads
+// which start and exit between samples are missed, so the peak is a lower bound.
+type threadCounter struct {
+	peak atomic.Uint32
+}
+
+func newThreadCounter(sub *Subprocess) *threadCounter {
+	if !sub.CountThreads && sub.MaxThreads == 0 {
+		return nil
+	}
+	return &threadCounter{}
+}
+
+func (c *threadCounter) observe(n uint32) {
+	for {
+		peak := c.peak.Load()
+		if n <= peak || c.peak.CompareAndSwap(peak, n) {
+			return
+		}
+	}
+}
+
+// exceeded tells if the peak is over Subprocess.MaxThreads.
+func (c *threadCounter) exceeded(sub *Subprocess) bool {
+	return c != nil && sub.MaxThreads > 0 && c.peak.Load() > sub.MaxThreads
+}
+
+// setResult fills the peak count. On Windows a sample taken on a job notification may be over the limit after the
+// last check in the loop, so it's checked here again.
+func (c *threadCounter) setResult(sub *Subprocess, result *SubprocessResult) {
+	if c == nil {
+		return
+	}
+	result.PeakThreadCount = c.peak.Load()
+	if c.exceeded(sub) {
+		result.SuccessCode |= EF_MULTITHREADED
+	}
+}
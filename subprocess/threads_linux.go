@@ -0,0 +1,29 @@
+package subprocess
+
+import (
+	"bufio"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// Update reads the thread count of the process from /proc/<pid>/status.
+func (c *threadCounter) Update(pid int) {
+	if c == nil {
+		return
+	}
+	f, err := os.Open("/proc/" + strconv.Itoa(pid) + "/status")
+	if err != nil {
+		return
+	}
+	defer f.Close()
+	s := bufio.NewScanner(f)
+	for s.Scan() {
+		if v, ok := strings.CutPrefix(s.Text(), "Threads:"); ok {
+			if n, err := strconv.ParseUint(strings.TrimSpace(v), 10, 32); err == nil {
+				c.observe(uint32(n))
+			}
+			return
+		}
+	}
+}
@@ -0,0 +1,41 @@
+package subprocess
+
+import (
+	"syscall"
+
+	"github.com/contester/runlib/win32"
+	"golang.org/x/sys/windows"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// Update adds up threads of all processes in the job, or of the process itself without one. Job notifications
+// only cover processes, so a thread burst inside a running process is seen only if it lasts until a sample.
+func (c *threadCounter) Update(d *PlatformData) {
+	if c == nil {
+		return
+	}
+	var pids []uintptr
+	if d.hJob != syscall.InvalidHandle {
+		ids, err := win32.GetJobProcessIds(d.hJob)
+		if err != nil {
+			log.Errorf("GetJobProcessIds: %s", err)
+		}
+		pids = ids
+	}
+	if len(pids) == 0 {
+		if pid, err := windows.GetProcessId(windows.Handle(d.hProcess)); err == nil {
+			pids = append(pids, uintptr(pid))
+		}
+	}
+	counts, err := win32.ProcessThreadCounts()
+	if err != nil {
+		log.Errorf("ProcessThreadCounts: %s", err)
+		return
+	}
+	var total uint32
+	for _, pid := range pids {
+		total += counts[uint32(pid)]
+	}
+	c.observe(total)
+}
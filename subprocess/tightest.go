@@ -0,0 +1,20 @@
+package subprocess
+
+// tightestLimit finds the limit the run used the largest part of, for flagging borderline passes. Only limits that
+// were set count; returns "" if there were none, or nothing was used.
+func (sub *Subprocess) tightestLimit(r *SubprocessResult) (string, float64) {
+	var name string
+	var usage float64
+	check := func(limitName string, used, limit float64) {
+		if limit > 0 && used/limit > usage {
+			name, usage = limitName, used/limit
+		}
+	}
+	check("time", float64(r.UserTime), float64(sub.TimeLimit))
+	check("kernel_time", float64(r.KernelTime), float64(sub.KernelTimeLimit))
+	check("wall_time", float64(r.chargedWallTime()), float64(sub.WallTimeLimit))
+	check("memory", float64(r.PeakMemory), float64(sub.MemoryLimit))
+	check("virtual_memory", float64(r.PeakVirtualMemory), float64(sub.VirtualMemoryLimit))
+	check("artifact_size", float64(r.ArtifactSize), float64(sub.ArtifactSizeLimit))
+	return name, usage
+}
@@ -0,0 +1,42 @@
+package subprocess
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTightestLimit(t *testing.T) {
+	cases := []struct {
+		sub   Subprocess
+		r     SubprocessResult
+		name  string
+		usage float64
+	}{
+		{Subprocess{}, SubprocessResult{TimeStats: TimeStats{UserTime: time.Second}, PeakMemory: 100}, "", 0},
+		{Subprocess{TimeLimit: time.Second}, SubprocessResult{}, "", 0},
+		{Subprocess{TimeLimit: time.Second}, SubprocessResult{TimeStats: TimeStats{UserTime: 500 * time.Millisecond}},
+			"time", 0.5},
+		{Subprocess{TimeLimit: time.Second, MemoryLimit: 100},
+			SubprocessResult{TimeStats: TimeStats{UserTime: 500 * time.Millisecond}, PeakMemory: 90}, "memory", 0.9},
+		{Subprocess{TimeLimit: time.Second, WallTimeLimit: 2 * time.Second},
+			SubprocessResult{TimeStats: TimeStats{UserTime: 500 * time.Millisecond, WallTime: 1600 * time.Millisecond}},
+			"wall_time", 0.8},
+		// Waiting for input isn't charged, as elsewhere.
+		{Subprocess{TimeLimit: time.Second, WallTimeLimit: 2 * time.Second},
+			SubprocessResult{TimeStats: TimeStats{UserTime: 500 * time.Millisecond, WallTime: 1600 * time.Millisecond},
+				InputWaitTime: 1200 * time.Millisecond}, "time", 0.5},
+		{Subprocess{KernelTimeLimit: time.Second, VirtualMemoryLimit: 100},
+			SubprocessResult{TimeStats: TimeStats{KernelTime: 300 * time.Millisecond}, PeakVirtualMemory: 20},
+			"kernel_time", 0.3},
+		{Subprocess{ArtifactSizeLimit: 10}, SubprocessResult{ArtifactSize: 15}, "artifact_size", 1.5},
+		// Ties go to the first limit checked.
+		{Subprocess{TimeLimit: time.Second, MemoryLimit: 100},
+			SubprocessResult{TimeStats: TimeStats{UserTime: 500 * time.Millisecond}, PeakMemory: 50}, "time", 0.5},
+	}
+	for _, c := range cases {
+		name, usage := c.sub.tightestLimit(&c.r)
+		if name != c.name || usage != c.usage {
+			t.Errorf("%+v: got %q %g, want %q %g", c.r, name, usage, c.name, c.usage)
+		}
+	}
+}
@@ -0,0 +1,24 @@
+package subprocess
+
+import "time"
+
+// Runs shorter than this in wall time aren't judged by their wall to CPU ratio: process startup alone can make it
+// large.
+const minRatioWallTime = time.Second
+
+// checkWallToCpuRatio sets SubprocessResult.WallToCpuRatioExceeded, and with FailOnWallToCpuRatio also
+// EF_WALL_TO_CPU_RATIO_POST, if the run spent over MaxWallToCpuRatio times more wall time than CPU time.
+func (sub *Subprocess) checkWallToCpuRatio(result *SubprocessResult) {
+	wall := result.chargedWallTime()
+	if sub.MaxWallToCpuRatio <= 0 || wall < minRatioWallTime {
+		return
+	}
+	cpu := result.UserTime + result.KernelTime
+	if cpu > 0 && float64(wall)/float64(cpu) <= sub.MaxWallToCpuRatio {
+		return
+	}
+	result.WallToCpuRatioExceeded = true
+	if sub.FailOnWallToCpuRatio {
+		result.SuccessCode |= EF_WALL_TO_CPU_RATIO_POST
+	}
+}
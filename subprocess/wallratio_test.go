@@ -0,0 +1,37 @@
+package subprocess
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCheckWallToCpuRatio(t *testing.T) {
+	cases := []struct {
+		ratio     float64
+		fail      bool
+		wall, cpu time.Duration
+		inputWait time.Duration
+		exceeded  bool
+		code      uint64
+	}{
+		{0, true, 10 * time.Second, 0, 0, false, 0},
+		{2, true, 500 * time.Millisecond, 0, 0, false, 0},
+		{2, false, 2 * time.Second, time.Second, 0, false, 0},
+		{2, false, 3 * time.Second, time.Second, 0, true, 0},
+		{2, true, 3 * time.Second, time.Second, 0, true, EF_WALL_TO_CPU_RATIO_POST},
+		{2, true, 2 * time.Second, 0, 0, true, EF_WALL_TO_CPU_RATIO_POST},
+		// Waiting for input isn't charged: neither the ratio, nor the minimum wall time.
+		{2, true, 3 * time.Second, time.Second, time.Second, false, 0},
+		{2, true, 3 * time.Second, 0, 2500 * time.Millisecond, false, 0},
+	}
+	for _, c := range cases {
+		sub := Subprocess{MaxWallToCpuRatio: c.ratio, FailOnWallToCpuRatio: c.fail}
+		r := SubprocessResult{TimeStats: TimeStats{WallTime: c.wall, UserTime: c.cpu / 2, KernelTime: c.cpu / 2},
+			InputWaitTime: c.inputWait}
+		sub.checkWallToCpuRatio(&r)
+		if r.WallToCpuRatioExceeded != c.exceeded || r.SuccessCode != c.code {
+			t.Errorf("ratio %g, wall %s, cpu %s, input wait %s: got %v %#x, want %v %#x", c.ratio, c.wall, c.cpu,
+				c.inputWait, r.WallToCpuRatioExceeded, r.SuccessCode, c.exceeded, c.code)
+		}
+	}
+}
@@ -0,0 +1,60 @@
+package subprocess
+
+import (
+	"sync/atomic"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// watchdog guards the monitoring loop itself: the loop beats on every pass, and if it goes quiet for longer than
+// the timeout, the watchdog kills the process, so a stuck loop can't let the run go past its limits.
+type watchdog struct {
+	last    atomic.Int64
+	stalled atomic.Bool
+	stop    chan struct{}
+	done    chan struct{}
+}
+
+func startWatchdog(timeout time.Duration, kill func()) *watchdog {
+	if timeout <= 0 {
+		return nil
+	}
+	w := &watchdog{stop: make(chan struct{}), done: make(chan struct{})}
+	w.beat()
+	go func() {
+		defer close(w.done)
+		ticker := time.NewTicker(timeout / 4)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-w.stop:
+				return
+			case <-ticker.C:
+				if since := time.Since(time.Unix(0, w.last.Load())); since > timeout {
+					log.Errorf("Monitoring loop stalled for %s, killing the process", since)
+					w.stalled.Store(true)
+					kill()
+					return
+				}
+			}
+		}
+	}()
+	return w
+}
+
+func (w *watchdog) beat() {
+	if w != nil {
+		w.last.Store(time.Now().UnixNano())
+	}
+}
+
+// Stop waits for the watchdog to finish, so it won't kill anything after, and tells if it found the loop stalled.
+func (w *watchdog) Stop() bool {
+	if w == nil {
+		return false
+	}
+	close(w.stop)
+	<-w.done
+	return w.stalled.Load()
+}
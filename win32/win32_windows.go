@@ -1,15 +1,20 @@
 package win32
 
 import (
+	"errors"
 	"os"
 	"runtime"
 	"syscall"
 	"unsafe"
+
+	"golang.org/x/sys/windows"
 )
 
 var (
 	advapi32 = syscall.NewLazyDLL("advapi32.dll")
+	dbghelp  = syscall.NewLazyDLL("dbghelp.dll")
 	kernel32 = syscall.NewLazyDLL("kernel32.dll")
+	ntdll    = syscall.NewLazyDLL("ntdll.dll")
 	psapi    = syscall.NewLazyDLL("psapi.dll")
 	userenv  = syscall.NewLazyDLL("userenv.dll")
 	user32   = syscall.NewLazyDLL("user32.dll")
@@ -19,8 +24,11 @@ var (
 	procResumeThread              = kernel32.NewProc("ResumeThread")
 	procGetProcessMemoryInfo      = psapi.NewProc("GetProcessMemoryInfo")
 	procLogonUserW                = advapi32.NewProc("LogonUserW")
+	procLookupPrivilegeNameW      = advapi32.NewProc("LookupPrivilegeNameW")
 	procLoadUserProfileW          = userenv.NewProc("LoadUserProfileW")
 	procUnloadUserProfile         = userenv.NewProc("UnloadUserProfile")
+	procCreateAppContainerProfile = userenv.NewProc("CreateAppContainerProfile")
+	procDeriveAppContainerSid     = userenv.NewProc("DeriveAppContainerSidFromAppContainerName")
 	procGetProcessWindowStation   = user32.NewProc("GetProcessWindowStation")
 	procGetThreadDesktop          = user32.NewProc("GetThreadDesktop")
 	procCreateWindowStationW      = user32.NewProc("CreateWindowStationW")
@@ -43,6 +51,23 @@ var (
 	procVerifyVersionInfoW        = kernel32.NewProc("VerifyVersionInfoW")
 	procVerSetConditionMask       = kernel32.NewProc("VerSetConditionMask")
 	procGetBinaryTypeW            = kernel32.NewProc("GetBinaryTypeW")
+	procGetExitCodeThread         = kernel32.NewProc("GetExitCodeThread")
+	procQueryProcessCycleTime     = kernel32.NewProc("QueryProcessCycleTime")
+	procSetThreadPriority         = kernel32.NewProc("SetThreadPriority")
+	procSetThreadPriorityBoost    = kernel32.NewProc("SetThreadPriorityBoost")
+	procGetThreadTimes            = kernel32.NewProc("GetThreadTimes")
+	procMiniDumpWriteDump         = dbghelp.NewProc("MiniDumpWriteDump")
+	procGetQueuedCompletionStatus = kernel32.NewProc("GetQueuedCompletionStatus")
+	procGetSystemTimes            = kernel32.NewProc("GetSystemTimes")
+	procGlobalMemoryStatusEx      = kernel32.NewProc("GlobalMemoryStatusEx")
+	procGetProcessIoCounters      = kernel32.NewProc("GetProcessIoCounters")
+	procGetProcessHandleCount     = kernel32.NewProc("GetProcessHandleCount")
+	procPeekNamedPipe             = kernel32.NewProc("PeekNamedPipe")
+	procCreatePseudoConsole       = kernel32.NewProc("CreatePseudoConsole")
+	procClosePseudoConsole        = kernel32.NewProc("ClosePseudoConsole")
+	procUpdateProcThreadAttribute = kernel32.NewProc("UpdateProcThreadAttribute")
+
+	procNtQueryInformationProcess = ntdll.NewProc("NtQueryInformationProcess")
 )
 
 const (
@@ -131,6 +156,21 @@ type ProcessMemoryCountersEx struct {
 	PrivateUsage               uintptr // SIZE_T
 }
 
+// VM_COUNTERS, as returned by NtQueryInformationProcess(ProcessVmCounters).
+type VmCounters struct {
+	PeakVirtualSize            uintptr // SIZE_T
+	VirtualSize                uintptr // SIZE_T
+	PageFaultCount             uint32  // ULONG
+	PeakWorkingSetSize         uintptr // SIZE_T
+	WorkingSetSize             uintptr // SIZE_T
+	QuotaPeakPagedPoolUsage    uintptr // SIZE_T
+	QuotaPagedPoolUsage        uintptr // SIZE_T
+	QuotaPeakNonPagedPoolUsage uintptr // SIZE_T
+	QuotaNonPagedPoolUsage     uintptr // SIZE_T
+	PagefileUsage              uintptr // SIZE_T
+	PeakPagefileUsage          uintptr // SIZE_T
+}
+
 type ProfileInfo struct {
 	Size        uint32
 	Flags       uint32
@@ -387,6 +427,30 @@ func GetProcessMemoryInfo(process syscall.Handle) (pmc *ProcessMemoryCountersEx,
 	return pmc, nil
 }
 
+const ProcessVmCounters = 3
+
+func NtQueryInformationProcess(process syscall.Handle, infoclass uint32, info unsafe.Pointer, length uint32) (uint32, error) {
+	var nLength uint32
+	r1, _, _ := procNtQueryInformationProcess.Call(
+		uintptr(process),
+		uintptr(infoclass),
+		uintptr(info),
+		uintptr(length),
+		uintptr(unsafe.Pointer(&nLength)))
+	if r1 != 0 {
+		return nLength, os.NewSyscallError("NtQueryInformationProcess", windows.NTStatus(r1))
+	}
+	return nLength, nil
+}
+
+func GetProcessVmCounters(process syscall.Handle) (*VmCounters, error) {
+	var vmc VmCounters
+	if _, err := NtQueryInformationProcess(process, ProcessVmCounters, unsafe.Pointer(&vmc), uint32(unsafe.Sizeof(vmc))); err != nil {
+		return nil, err
+	}
+	return &vmc, nil
+}
+
 func LogonUser(username, domain, password string, logonType uint32, logonProvider uint32) (token syscall.Handle, err error) {
 	pUsername, err := syscall.UTF16PtrFromString(username)
 	if err != nil {
@@ -615,6 +679,49 @@ func GetJobObjectBasicAccountingInformation(job syscall.Handle) (*JobObjectBasic
 	return &jinfo, nil
 }
 
+type jobObjectBasicAndIoAccountingInformation struct {
+	BasicInfo JobObjectBasicAccountingInformation
+	IoInfo    IoCounters
+}
+
+// GetJobObjectIoCounters returns I/O counters for all processes ever in the job.
+func GetJobObjectIoCounters(job syscall.Handle) (*IoCounters, error) {
+	var jinfo jobObjectBasicAndIoAccountingInformation
+	_, err := QueryInformationJobObject(job, 8, unsafe.Pointer(&jinfo), uint32(unsafe.Sizeof(jinfo)))
+	if err != nil {
+		return nil, err
+	}
+	return &jinfo.IoInfo, nil
+}
+
+func GetProcessIoCounters(process syscall.Handle) (*IoCounters, error) {
+	var result IoCounters
+	r1, _, e1 := procGetProcessIoCounters.Call(uintptr(process), uintptr(unsafe.Pointer(&result)))
+	if int(r1) == 0 {
+		return nil, os.NewSyscallError("GetProcessIoCounters", e1)
+	}
+	return &result, nil
+}
+
+func GetProcessHandleCount(process syscall.Handle) (uint32, error) {
+	var result uint32
+	r1, _, e1 := procGetProcessHandleCount.Call(uintptr(process), uintptr(unsafe.Pointer(&result)))
+	if int(r1) == 0 {
+		return 0, os.NewSyscallError("GetProcessHandleCount", e1)
+	}
+	return result, nil
+}
+
+// ProcessHandleCount opens the process just to get its handle count.
+func ProcessHandleCount(pid uint32) (uint32, error) {
+	h, err := windows.OpenProcess(windows.PROCESS_QUERY_LIMITED_INFORMATION, false, pid)
+	if err != nil {
+		return 0, os.NewSyscallError("OpenProcess", err)
+	}
+	defer windows.CloseHandle(h)
+	return GetProcessHandleCount(syscall.Handle(h))
+}
+
 type JobObjectBasicLimitInformation struct {
 	PerProcessUserTimeLimit uint64  // LARGE_INTEGER
 	PerJobUserTimeLimit     uint64  // LARGE_INTEGER
@@ -663,6 +770,159 @@ func SetJobObjectBasicUiRestrictions(job syscall.Handle, info *JobObjectBasicUiR
 	return err
 }
 
+type JobObjectCpuRateControlInformation struct {
+	ControlFlags uint32
+	CpuRate      uint32 // Union with Weight and MinRate/MaxRate.
+}
+
+const (
+	JOB_OBJECT_CPU_RATE_CONTROL_ENABLE   = 0x1
+	JOB_OBJECT_CPU_RATE_CONTROL_HARD_CAP = 0x4
+)
+
+func SetJobObjectCpuRateControlInformation(job syscall.Handle, info *JobObjectCpuRateControlInformation) error {
+	err := SetInformationJobObject(job, 15, unsafe.Pointer(info), uint32(unsafe.Sizeof(*info)))
+	runtime.KeepAlive(info)
+	return err
+}
+
+// GetJobProcessIds returns ids of processes currently in the job.
+func GetJobProcessIds(job syscall.Handle) ([]uintptr, error) {
+	for n := 64; ; n *= 2 {
+		// JOBOBJECT_BASIC_PROCESS_ID_LIST: two DWORDs, then ULONG_PTR array.
+		buf := make([]uintptr, n+2)
+		if _, err := QueryInformationJobObject(job, 3, unsafe.Pointer(&buf[0]), uint32(len(buf))*uint32(unsafe.Sizeof(buf[0]))); err != nil {
+			if errors.Is(err, syscall.ERROR_MORE_DATA) {
+				continue
+			}
+			return nil, err
+		}
+		header := (*[2]uint32)(unsafe.Pointer(&buf[0]))
+		ids := buf[8/unsafe.Sizeof(buf[0]):]
+		return ids[:header[1]], nil
+	}
+}
+
+// walkSystemProcesses calls f for each entry of the system process list.
+func walkSystemProcesses(f func(p *windows.SYSTEM_PROCESS_INFORMATION)) error {
+	for size := uint32(256 * 1024); ; {
+		buf := make([]uint64, size/8)
+		var ret uint32
+		err := windows.NtQuerySystemInformation(windows.SystemProcessInformation, unsafe.Pointer(&buf[0]), size, &ret)
+		if err == windows.STATUS_INFO_LENGTH_MISMATCH {
+			size = (ret + 64*1024) &^ 7
+			continue
+		}
+		if err != nil {
+			return os.NewSyscallError("NtQuerySystemInformation", err)
+		}
+		for off := uintptr(0); ; {
+			p := (*windows.SYSTEM_PROCESS_INFORMATION)(unsafe.Add(unsafe.Pointer(&buf[0]), off))
+			f(p)
+			if p.NextEntryOffset == 0 {
+				return nil
+			}
+			off += uintptr(p.NextEntryOffset)
+		}
+	}
+}
+
+// GetHardFaultCounts looks processes from counts up in the system process list, and sets their hard page fault counts.
+// Processes which already exited are left as is.
+func GetHardFaultCounts(counts map[uintptr]uint32) error {
+	return walkSystemProcesses(func(p *windows.SYSTEM_PROCESS_INFORMATION) {
+		if _, ok := counts[p.UniqueProcessID]; ok {
+			counts[p.UniqueProcessID] = p.HardFaultCount
+		}
+	})
+}
+
+// SYSTEM_THREAD_INFORMATION, an array of which follows each SYSTEM_PROCESS_INFORMATION.
+type systemThreadInformation struct {
+	KernelTime      int64
+	UserTime        int64
+	CreateTime      int64
+	WaitTime        uint32
+	StartAddress    uintptr
+	UniqueProcess   uintptr
+	UniqueThread    uintptr
+	Priority        int32
+	BasePriority    int32
+	ContextSwitches uint32
+	ThreadState     uint32
+	WaitReason      uint32
+}
+
+// The C struct is 8-aligned for its LARGE_INTEGERs on 386 too.
+const systemThreadInformationSize = (unsafe.Sizeof(systemThreadInformation{}) + 7) &^ 7
+
+// KTHREAD_STATE values of threads waiting for a CPU.
+const (
+	threadStateReady         = 1
+	threadStateDeferredReady = 7
+)
+
+// CountReadyThreads returns how many threads of the processes are ready to run, but not running for lack of a CPU.
+func CountReadyThreads(pids []uintptr) (uint32, error) {
+	var n uint32
+	err := walkSystemProcesses(func(p *windows.SYSTEM_PROCESS_INFORMATION) {
+		found := false
+		for _, pid := range pids {
+			found = found || pid == p.UniqueProcessID
+		}
+		if !found {
+			return
+		}
+		threads := unsafe.Add(unsafe.Pointer(p), unsafe.Sizeof(*p))
+		for i := uintptr(0); i < uintptr(p.NumberOfThreads); i++ {
+			t := (*systemThreadInformation)(unsafe.Add(threads, i*systemThreadInformationSize))
+			if t.ThreadState == threadStateReady || t.ThreadState == threadStateDeferredReady {
+				n++
+			}
+		}
+	})
+	return n, err
+}
+
+type JobObjectAssociateCompletionPort struct {
+	CompletionKey  uintptr
+	CompletionPort syscall.Handle
+}
+
+func SetJobObjectAssociateCompletionPort(job syscall.Handle, info *JobObjectAssociateCompletionPort) error {
+	err := SetInformationJobObject(job, 7, unsafe.Pointer(info), uint32(unsafe.Sizeof(*info)))
+	runtime.KeepAlive(info)
+	return err
+}
+
+// Messages posted to the job completion port, as the number of bytes transferred.
+const (
+	JOB_OBJECT_MSG_END_OF_JOB_TIME       = 1
+	JOB_OBJECT_MSG_END_OF_PROCESS_TIME   = 2
+	JOB_OBJECT_MSG_ACTIVE_PROCESS_LIMIT  = 3
+	JOB_OBJECT_MSG_ACTIVE_PROCESS_ZERO   = 4
+	JOB_OBJECT_MSG_NEW_PROCESS           = 6
+	JOB_OBJECT_MSG_EXIT_PROCESS          = 7
+	JOB_OBJECT_MSG_ABNORMAL_EXIT_PROCESS = 8
+	JOB_OBJECT_MSG_PROCESS_MEMORY_LIMIT  = 9
+	JOB_OBJECT_MSG_JOB_MEMORY_LIMIT      = 10
+)
+
+// GetQueuedCompletionStatus returns raw values of a completion packet. Unlike the syscall package version, it doesn't
+// treat the overlapped value as a pointer, since job notifications store process id there.
+func GetQueuedCompletionStatus(port syscall.Handle, timeout uint32) (qty uint32, key, overlapped uintptr, err error) {
+	r1, _, e1 := procGetQueuedCompletionStatus.Call(
+		uintptr(port),
+		uintptr(unsafe.Pointer(&qty)),
+		uintptr(unsafe.Pointer(&key)),
+		uintptr(unsafe.Pointer(&overlapped)),
+		uintptr(timeout))
+	if int(r1) == 0 {
+		return qty, key, overlapped, os.NewSyscallError("GetQueuedCompletionStatus", e1)
+	}
+	return qty, key, overlapped, nil
+}
+
 func SetJobObjectExtendedLimitInformation(job syscall.Handle, info *JobObjectExtendedLimitInformation) error {
 	err := SetInformationJobObject(job, 9, unsafe.Pointer(info), uint32(unsafe.Sizeof(*info)))
 	runtime.KeepAlive(info)
@@ -761,6 +1021,16 @@ func VirtualFreeEx(process syscall.Handle, addr uintptr, size, freeType uint32)
 	return nil
 }
 
+// PeekNamedPipeAvailable returns how many bytes can be read from the pipe without blocking.
+func PeekNamedPipeAvailable(pipe windows.Handle) (uint32, error) {
+	var avail uint32
+	r1, _, e1 := procPeekNamedPipe.Call(uintptr(pipe), 0, 0, 0, uintptr(unsafe.Pointer(&avail)), 0)
+	if int(r1) == 0 {
+		return 0, os.NewSyscallError("PeekNamedPipe", e1)
+	}
+	return avail, nil
+}
+
 func SetInheritHandle(h syscall.Handle, inherit bool) error {
 	var v uint32
 	if inherit {
@@ -793,6 +1063,27 @@ func GetProcessAffinityMask(process syscall.Handle) (processMask, systemMask uin
 	return processMask, systemMask, nil
 }
 
+type MemoryStatusEx struct {
+	Length               uint32
+	MemoryLoad           uint32
+	TotalPhys            uint64
+	AvailPhys            uint64
+	TotalPageFile        uint64
+	AvailPageFile        uint64
+	TotalVirtual         uint64
+	AvailVirtual         uint64
+	AvailExtendedVirtual uint64
+}
+
+func GlobalMemoryStatusEx() (*MemoryStatusEx, error) {
+	result := &MemoryStatusEx{Length: uint32(unsafe.Sizeof(MemoryStatusEx{}))}
+	r1, _, e1 := procGlobalMemoryStatusEx.Call(uintptr(unsafe.Pointer(result)))
+	if int(r1) == 0 {
+		return nil, os.NewSyscallError("GlobalMemoryStatusEx", e1)
+	}
+	return result, nil
+}
+
 const (
 	SCS_32BIT_BINARY = 0
 	SCS_64BIT_BINARY = 6
@@ -817,3 +1108,403 @@ func GetBinaryType(applicationName string) (uint64, error) {
 	}
 	return result, nil
 }
+
+func GetExitCodeThread(thread syscall.Handle) (uint32, error) {
+	var code uint32
+	r1, _, e1 := procGetExitCodeThread.Call(uintptr(thread), uintptr(unsafe.Pointer(&code)))
+	if int(r1) == 0 {
+		return 0, os.NewSyscallError("GetExitCodeThread", e1)
+	}
+	return code, nil
+}
+
+func IsWow64Process(process syscall.Handle) (bool, error) {
+	var result bool
+	if err := windows.IsWow64Process(windows.Handle(process), &result); err != nil {
+		return false, os.NewSyscallError("IsWow64Process", err)
+	}
+	return result, nil
+}
+
+// GetKernel32ProcAddress returns address of kernel32 export in this process. It's valid in
+// any other process with the same bitness, so it can be used as a remote thread start address.
+func GetKernel32ProcAddress(name string) (uintptr, error) {
+	p := kernel32.NewProc(name)
+	if err := p.Find(); err != nil {
+		return 0, err
+	}
+	return p.Addr(), nil
+}
+
+const LOAD_LIBRARY_SEARCH_DEFAULT_DIRS = 0x00001000
+
+func QueryProcessCycleTime(process syscall.Handle) (uint64, error) {
+	var cycles uint64
+	r1, _, e1 := procQueryProcessCycleTime.Call(uintptr(process), uintptr(unsafe.Pointer(&cycles)))
+	if int(r1) == 0 {
+		return 0, os.NewSyscallError("QueryProcessCycleTime", e1)
+	}
+	return cycles, nil
+}
+
+const (
+	THREAD_PRIORITY_IDLE          = -15
+	THREAD_PRIORITY_LOWEST        = -2
+	THREAD_PRIORITY_BELOW_NORMAL  = -1
+	THREAD_PRIORITY_NORMAL        = 0
+	THREAD_PRIORITY_ABOVE_NORMAL  = 1
+	THREAD_PRIORITY_HIGHEST       = 2
+	THREAD_PRIORITY_TIME_CRITICAL = 15
+)
+
+func SetThreadPriority(thread syscall.Handle, priority int32) error {
+	r1, _, e1 := procSetThreadPriority.Call(uintptr(thread), uintptr(priority))
+	if int(r1) == 0 {
+		return os.NewSyscallError("SetThreadPriority", e1)
+	}
+	return nil
+}
+
+func SetThreadPriorityBoost(thread syscall.Handle, disable bool) error {
+	var v uintptr
+	if disable {
+		v = 1
+	}
+	r1, _, e1 := procSetThreadPriorityBoost.Call(uintptr(thread), v)
+	if int(r1) == 0 {
+		return os.NewSyscallError("SetThreadPriorityBoost", e1)
+	}
+	return nil
+}
+
+func SetProcessPriorityBoost(process syscall.Handle, disable bool) error {
+	if err := windows.SetProcessPriorityBoost(windows.Handle(process), disable); err != nil {
+		return os.NewSyscallError("SetProcessPriorityBoost", err)
+	}
+	return nil
+}
+
+// HasEmbeddedManifest tells if the executable has the manifest resource the loader would use for its process.
+func HasEmbeddedManifest(name string) (bool, error) {
+	module, err := windows.LoadLibraryEx(name, 0, windows.LOAD_LIBRARY_AS_DATAFILE|windows.LOAD_LIBRARY_AS_IMAGE_RESOURCE)
+	if err != nil {
+		return false, os.NewSyscallError("LoadLibraryEx", err)
+	}
+	defer windows.FreeLibrary(module)
+	_, err = windows.FindResource(module, windows.CREATEPROCESS_MANIFEST_RESOURCE_ID, windows.RT_MANIFEST)
+	switch err {
+	case nil:
+		return true, nil
+	case windows.ERROR_RESOURCE_TYPE_NOT_FOUND, windows.ERROR_RESOURCE_NAME_NOT_FOUND, windows.ERROR_RESOURCE_DATA_NOT_FOUND:
+		return false, nil
+	}
+	return false, os.NewSyscallError("FindResource", err)
+}
+
+// IO_PRIORITY_HINT values. Higher ones need SeIncreaseBasePriorityPrivilege.
+const (
+	IoPriorityVeryLow = 0
+	IoPriorityLow     = 1
+	IoPriorityNormal  = 2
+)
+
+func SetProcessIoPriority(process syscall.Handle, hint uint32) error {
+	if err := windows.NtSetInformationProcess(windows.Handle(process), windows.ProcessIoPriority, unsafe.Pointer(&hint), uint32(unsafe.Sizeof(hint))); err != nil {
+		return os.NewSyscallError("NtSetInformationProcess", err)
+	}
+	return nil
+}
+
+func GetProcessIoPriority(process syscall.Handle) (uint32, error) {
+	var hint uint32
+	if _, err := NtQueryInformationProcess(process, windows.ProcessIoPriority, unsafe.Pointer(&hint), uint32(unsafe.Sizeof(hint))); err != nil {
+		return 0, err
+	}
+	return hint, nil
+}
+
+func SetPriorityClass(process syscall.Handle, priorityClass uint32) error {
+	if err := windows.SetPriorityClass(windows.Handle(process), priorityClass); err != nil {
+		return os.NewSyscallError("SetPriorityClass", err)
+	}
+	return nil
+}
+
+// UserEnvironment returns default environment of the user, as if the user logged on.
+func UserEnvironment(token syscall.Handle) ([]string, error) {
+	env, err := windows.Token(token).Environ(false)
+	if err != nil {
+		return nil, os.NewSyscallError("CreateEnvironmentBlock", err)
+	}
+	return env, nil
+}
+
+// GetSystemTimes returns idle, kernel and user time summed over all CPUs, in 100ns units. Kernel time includes idle.
+func GetSystemTimes() (idle, kernel, user uint64, err error) {
+	var i, k, u syscall.Filetime
+	r1, _, e1 := procGetSystemTimes.Call(
+		uintptr(unsafe.Pointer(&i)),
+		uintptr(unsafe.Pointer(&k)),
+		uintptr(unsafe.Pointer(&u)))
+	if int(r1) == 0 {
+		return 0, 0, 0, os.NewSyscallError("GetSystemTimes", e1)
+	}
+	ft := func(f syscall.Filetime) uint64 { return uint64(f.HighDateTime)<<32 | uint64(f.LowDateTime) }
+	return ft(i), ft(k), ft(u), nil
+}
+
+func GetThreadTimes(thread syscall.Handle) (creation, exit, kernel, user syscall.Filetime, err error) {
+	r1, _, e1 := procGetThreadTimes.Call(
+		uintptr(thread),
+		uintptr(unsafe.Pointer(&creation)),
+		uintptr(unsafe.Pointer(&exit)),
+		uintptr(unsafe.Pointer(&kernel)),
+		uintptr(unsafe.Pointer(&user)))
+	if int(r1) == 0 {
+		err = os.NewSyscallError("GetThreadTimes", e1)
+	}
+	return
+}
+
+// ListProcessThreads returns ids of all threads of the process.
+func ListProcessThreads(pid uint32) ([]uint32, error) {
+	snapshot, err := windows.CreateToolhelp32Snapshot(windows.TH32CS_SNAPTHREAD, 0)
+	if err != nil {
+		return nil, os.NewSyscallError("CreateToolhelp32Snapshot", err)
+	}
+	defer windows.CloseHandle(snapshot)
+
+	var result []uint32
+	entry := windows.ThreadEntry32{Size: uint32(unsafe.Sizeof(windows.ThreadEntry32{}))}
+	for err = windows.Thread32First(snapshot, &entry); err == nil; err = windows.Thread32Next(snapshot, &entry) {
+		if entry.OwnerProcessID == pid {
+			result = append(result, entry.ThreadID)
+		}
+	}
+	if err != windows.ERROR_NO_MORE_FILES {
+		return nil, os.NewSyscallError("Thread32Next", err)
+	}
+	return result, nil
+}
+
+// ProcessThreadCounts returns the number of threads of every process in the system, by process id.
+func ProcessThreadCounts() (map[uint32]uint32, error) {
+	snapshot, err := windows.CreateToolhelp32Snapshot(windows.TH32CS_SNAPPROCESS, 0)
+	if err != nil {
+		return nil, os.NewSyscallError("CreateToolhelp32Snapshot", err)
+	}
+	defer windows.CloseHandle(snapshot)
+
+	result := make(map[uint32]uint32)
+	entry := windows.ProcessEntry32{Size: uint32(unsafe.Sizeof(windows.ProcessEntry32{}))}
+	for err = windows.Process32First(snapshot, &entry); err == nil; err = windows.Process32Next(snapshot, &entry) {
+		result[entry.ProcessID] = entry.Threads
+	}
+	if err != windows.ERROR_NO_MORE_FILES {
+		return nil, os.NewSyscallError("Process32Next", err)
+	}
+	return result, nil
+}
+
+// ListProcessModules returns paths of modules loaded into the process, both native and WOW64 ones.
+func ListProcessModules(pid uint32) ([]string, error) {
+	snapshot, err := windows.CreateToolhelp32Snapshot(windows.TH32CS_SNAPMODULE|windows.TH32CS_SNAPMODULE32, pid)
+	if err != nil {
+		return nil, os.NewSyscallError("CreateToolhelp32Snapshot", err)
+	}
+	defer windows.CloseHandle(snapshot)
+
+	var result []string
+	entry := windows.ModuleEntry32{Size: uint32(unsafe.Sizeof(windows.ModuleEntry32{}))}
+	for err = windows.Module32First(snapshot, &entry); err == nil; err = windows.Module32Next(snapshot, &entry) {
+		result = append(result, windows.UTF16ToString(entry.ExePath[:]))
+	}
+	if err != windows.ERROR_NO_MORE_FILES {
+		return nil, os.NewSyscallError("Module32Next", err)
+	}
+	return result, nil
+}
+
+const (
+	MiniDumpNormal              = 0
+	MiniDumpWithThreadInfo      = 0x1000
+	MiniDumpWithUnloadedModules = 0x20
+)
+
+func MiniDumpWriteDump(process syscall.Handle, pid uint32, file syscall.Handle, dumpType uint32) error {
+	r1, _, e1 := procMiniDumpWriteDump.Call(
+		uintptr(process),
+		uintptr(pid),
+		uintptr(file),
+		uintptr(dumpType),
+		0, 0, 0)
+	if int(r1) == 0 {
+		return os.NewSyscallError("MiniDumpWriteDump", e1)
+	}
+	return nil
+}
+
+const (
+	PROC_THREAD_ATTRIBUTE_PSEUDOCONSOLE         = 0x00020016
+	PROC_THREAD_ATTRIBUTE_SECURITY_CAPABILITIES = 0x00020009
+	EXTENDED_STARTUPINFO_PRESENT                = 0x00080000
+
+	PROC_THREAD_ATTRIBUTE_ALL_APPLICATION_PACKAGES_POLICY = 0x0002000F
+	PROCESS_CREATION_ALL_APPLICATION_PACKAGES_OPT_OUT     = 0x01
+)
+
+type StartupInfoEx struct {
+	syscall.StartupInfo
+	AttributeList *windows.ProcThreadAttributeList
+}
+
+// UpdateProcThreadAttributeValue sets an attribute whose value is a handle, not a pointer to data.
+func UpdateProcThreadAttributeValue(list *windows.ProcThreadAttributeList, attribute, value uintptr) error {
+	r1, _, e1 := procUpdateProcThreadAttribute.Call(
+		uintptr(unsafe.Pointer(list)),
+		0,
+		attribute,
+		value,
+		unsafe.Sizeof(value),
+		0,
+		0)
+	if int(r1) == 0 {
+		return os.NewSyscallError("UpdateProcThreadAttribute", e1)
+	}
+	return nil
+}
+
+// HasPseudoConsole reports if ConPTY is available, which is Windows 10 1809 or later.
+func HasPseudoConsole() bool {
+	return procCreatePseudoConsole.Find() == nil
+}
+
+// CreatePseudoConsole returns HPCON reading input from in and writing output to out. Both handles are duplicated,
+// so the caller can close them.
+func CreatePseudoConsole(cols, rows int16, in, out syscall.Handle) (uintptr, error) {
+	var hpc uintptr
+	size := uintptr(uint16(cols)) | uintptr(uint16(rows))<<16
+	r1, _, _ := procCreatePseudoConsole.Call(
+		size,
+		uintptr(in),
+		uintptr(out),
+		0,
+		uintptr(unsafe.Pointer(&hpc)))
+	if r1 != 0 {
+		return 0, os.NewSyscallError("CreatePseudoConsole", windows.Errno(r1))
+	}
+	return hpc, nil
+}
+
+func ClosePseudoConsole(hpc uintptr) {
+	procClosePseudoConsole.Call(hpc)
+}
+
+// HRESULT_FROM_WIN32(ERROR_ALREADY_EXISTS)
+const hresultAlreadyExists = 0x800700B7
+
+type SecurityCapabilities struct {
+	AppContainerSid *windows.SID
+	Capabilities    *windows.SIDAndAttributes
+	CapabilityCount uint32
+	Reserved        uint32
+}
+
+// CreateAppContainerProfile creates the AppContainer profile, or finds the existing one, and returns its SID.
+// Capabilities are given to the process at its start, not stored in the profile. The SID is freed with FreeSid.
+func CreateAppContainerProfile(name string) (*windows.SID, error) {
+	if err := procCreateAppContainerProfile.Find(); err != nil {
+		return nil, err
+	}
+	pname, err := syscall.UTF16PtrFromString(name)
+	if err != nil {
+		return nil, err
+	}
+	var sid *windows.SID
+	r1, _, _ := procCreateAppContainerProfile.Call(
+		uintptr(unsafe.Pointer(pname)),
+		uintptr(unsafe.Pointer(pname)),
+		uintptr(unsafe.Pointer(pname)),
+		0,
+		0,
+		uintptr(unsafe.Pointer(&sid)))
+	if r1 == hresultAlreadyExists {
+		r1, _, _ = procDeriveAppContainerSid.Call(uintptr(unsafe.Pointer(pname)), uintptr(unsafe.Pointer(&sid)))
+		if r1 != 0 {
+			return nil, os.NewSyscallError("DeriveAppContainerSidFromAppContainerName", windows.Errno(r1))
+		}
+		return sid, nil
+	}
+	if r1 != 0 {
+		return nil, os.NewSyscallError("CreateAppContainerProfile", windows.Errno(r1))
+	}
+	return sid, nil
+}
+
+// GetTokenPrivileges returns privileges present in the token, enabled or not.
+func GetTokenPrivileges(token windows.Token) ([]windows.LUIDAndAttributes, error) {
+	var size uint32
+	windows.GetTokenInformation(token, windows.TokenPrivileges, nil, 0, &size)
+	if size == 0 {
+		size = 4
+	}
+	for {
+		buf := make([]byte, size)
+		err := windows.GetTokenInformation(token, windows.TokenPrivileges, &buf[0], size, &size)
+		if err == windows.ERROR_INSUFFICIENT_BUFFER {
+			continue
+		}
+		if err != nil {
+			return nil, os.NewSyscallError("GetTokenInformation", err)
+		}
+		tp := (*windows.Tokenprivileges)(unsafe.Pointer(&buf[0]))
+		return append([]windows.LUIDAndAttributes(nil), unsafe.Slice(&tp.Privileges[0], tp.PrivilegeCount)...), nil
+	}
+}
+
+func LookupPrivilegeName(luid windows.LUID) (string, error) {
+	buf := make([]uint16, 64)
+	for {
+		size := uint32(len(buf))
+		r1, _, e1 := procLookupPrivilegeNameW.Call(0, uintptr(unsafe.Pointer(&luid)), uintptr(unsafe.Pointer(&buf[0])), uintptr(unsafe.Pointer(&size)))
+		if int(r1) != 0 {
+			return syscall.UTF16ToString(buf[:size]), nil
+		}
+		if e1 != windows.ERROR_INSUFFICIENT_BUFFER || int(size) <= len(buf) {
+			return "", os.NewSyscallError("LookupPrivilegeName", e1)
+		}
+		buf = make([]uint16, size)
+	}
+}
+
+func LookupPrivilegeValue(name string) (windows.LUID, error) {
+	var luid windows.LUID
+	pname, err := syscall.UTF16PtrFromString(name)
+	if err != nil {
+		return luid, err
+	}
+	if err = windows.LookupPrivilegeValue(nil, pname, &luid); err != nil {
+		return luid, os.NewSyscallError("LookupPrivilegeValue", err)
+	}
+	return luid, nil
+}
+
+// RemoveTokenPrivileges removes privileges from the token for good, they can't be enabled again.
+func RemoveTokenPrivileges(token windows.Token, luids []windows.LUID) error {
+	if len(luids) == 0 {
+		return nil
+	}
+	entry := unsafe.Sizeof(windows.LUIDAndAttributes{})
+	buf := make([]byte, unsafe.Offsetof(windows.Tokenprivileges{}.Privileges)+entry*uintptr(len(luids)))
+	tp := (*windows.Tokenprivileges)(unsafe.Pointer(&buf[0]))
+	tp.PrivilegeCount = uint32(len(luids))
+	privileges := unsafe.Slice(&tp.Privileges[0], len(luids))
+	for i, v := range luids {
+		privileges[i] = windows.LUIDAndAttributes{Luid: v, Attributes: windows.SE_PRIVILEGE_REMOVED}
+	}
+	// Succeeds even if some privileges weren't removed, so callers should check what is left.
+	if err := windows.AdjustTokenPrivileges(token, false, tp, 0, nil, nil); err != nil {
+		return os.NewSyscallError("AdjustTokenPrivileges", err)
+	}
+	return nil
+}
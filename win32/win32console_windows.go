@@ -0,0 +1,47 @@
+package win32
+
+import "os"
+
+var (
+	procAttachConsole         = kernel32.NewProc("AttachConsole")
+	procFreeConsole           = kernel32.NewProc("FreeConsole")
+	procGetConsoleWindow      = kernel32.NewProc("GetConsoleWindow")
+	procSetConsoleCtrlHandler = kernel32.NewProc("SetConsoleCtrlHandler")
+)
+
+const (
+	CTRL_C_EVENT     = 0
+	CTRL_BREAK_EVENT = 1
+)
+
+func AttachConsole(pid uint32) error {
+	if r1, _, e1 := procAttachConsole.Call(uintptr(pid)); r1 == 0 {
+		return os.NewSyscallError("AttachConsole", e1)
+	}
+	return nil
+}
+
+func FreeConsole() error {
+	if r1, _, e1 := procFreeConsole.Call(); r1 == 0 {
+		return os.NewSyscallError("FreeConsole", e1)
+	}
+	return nil
+}
+
+// HasConsole tells if the calling process is attached to a console.
+func HasConsole() bool {
+	r1, _, _ := procGetConsoleWindow.Call()
+	return r1 != 0
+}
+
+// SetConsoleCtrlHandler adds or removes handler, made with syscall.NewCallback.
+func SetConsoleCtrlHandler(handler uintptr, add bool) error {
+	var a uintptr
+	if add {
+		a = 1
+	}
+	if r1, _, e1 := procSetConsoleCtrlHandler.Call(handler, a); r1 == 0 {
+		return os.NewSyscallError("SetConsoleCtrlHandler", e1)
+	}
+	return nil
+}
@@ -0,0 +1,136 @@
+package win32
+
+import (
+	"errors"
+	"os"
+	"syscall"
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+var (
+	wevtapi = syscall.NewLazyDLL("wevtapi.dll")
+
+	procEvtQuery = wevtapi.NewProc("EvtQuery")
+	procEvtNext  = wevtapi.NewProc("EvtNext")
+	procEvtClose = wevtapi.NewProc("EvtClose")
+
+	procEvtCreateRenderContext = wevtapi.NewProc("EvtCreateRenderContext")
+	procEvtRender              = wevtapi.NewProc("EvtRender")
+)
+
+const (
+	EvtQueryChannelPath      = 0x1
+	EvtQueryForwardDirection = 0x100
+
+	EvtRenderContextValues = 0
+	EvtRenderEventValues   = 0
+
+	EvtVarTypeString = 1
+)
+
+// EVT_VARIANT
+type evtVariant struct {
+	Value *uint16
+	_     [8 - unsafe.Sizeof(uintptr(0))]byte
+	Count uint32
+	Type  uint32
+}
+
+// CountEvents returns how many events of the log channel match the XPath query.
+func CountEvents(channel, query string) (int, error) {
+	uchannel, err := syscall.UTF16PtrFromString(channel)
+	if err != nil {
+		return 0, err
+	}
+	uquery, err := syscall.UTF16PtrFromString(query)
+	if err != nil {
+		return 0, err
+	}
+	results, _, e1 := procEvtQuery.Call(0, uintptr(unsafe.Pointer(uchannel)), uintptr(unsafe.Pointer(uquery)),
+		EvtQueryChannelPath|EvtQueryForwardDirection)
+	if results == 0 {
+		return 0, os.NewSyscallError("EvtQuery", e1)
+	}
+	defer procEvtClose.Call(results)
+
+	var count int
+	var events [64]uintptr
+	for {
+		var returned uint32
+		r1, _, e1 := procEvtNext.Call(results, uintptr(len(events)), uintptr(unsafe.Pointer(&events[0])), syscall.INFINITE, 0,
+			uintptr(unsafe.Pointer(&returned)))
+		if r1 == 0 {
+			if errors.Is(e1, windows.ERROR_NO_MORE_ITEMS) {
+				return count, nil
+			}
+			return count, os.NewSyscallError("EvtNext", e1)
+		}
+		for _, h := range events[:returned] {
+			procEvtClose.Call(h)
+		}
+		count += int(returned)
+	}
+}
+
+// EventStrings returns the value at path (like "Event/EventData/Data[@Name='ObjectName']") of each event of the log
+// channel matching the XPath query. Events where it isn't a string are skipped.
+func EventStrings(channel, query, path string) ([]string, error) {
+	uchannel, err := syscall.UTF16PtrFromString(channel)
+	if err != nil {
+		return nil, err
+	}
+	uquery, err := syscall.UTF16PtrFromString(query)
+	if err != nil {
+		return nil, err
+	}
+	upath, err := syscall.UTF16PtrFromString(path)
+	if err != nil {
+		return nil, err
+	}
+	context, _, e1 := procEvtCreateRenderContext.Call(1, uintptr(unsafe.Pointer(&upath)), EvtRenderContextValues)
+	if context == 0 {
+		return nil, os.NewSyscallError("EvtCreateRenderContext", e1)
+	}
+	defer procEvtClose.Call(context)
+	results, _, e1 := procEvtQuery.Call(0, uintptr(unsafe.Pointer(uchannel)), uintptr(unsafe.Pointer(uquery)),
+		EvtQueryChannelPath|EvtQueryForwardDirection)
+	if results == 0 {
+		return nil, os.NewSyscallError("EvtQuery", e1)
+	}
+	defer procEvtClose.Call(results)
+
+	var values []string
+	buf := make([]byte, 4096)
+	var events [64]uintptr
+	for {
+		var returned uint32
+		r1, _, e1 := procEvtNext.Call(results, uintptr(len(events)), uintptr(unsafe.Pointer(&events[0])), syscall.INFINITE, 0,
+			uintptr(unsafe.Pointer(&returned)))
+		if r1 == 0 {
+			if errors.Is(e1, windows.ERROR_NO_MORE_ITEMS) {
+				return values, nil
+			}
+			return values, os.NewSyscallError("EvtNext", e1)
+		}
+		for _, h := range events[:returned] {
+			var used, count uint32
+			r1, _, e1 := procEvtRender.Call(context, h, EvtRenderEventValues, uintptr(len(buf)), uintptr(unsafe.Pointer(&buf[0])),
+				uintptr(unsafe.Pointer(&used)), uintptr(unsafe.Pointer(&count)))
+			if r1 == 0 && errors.Is(e1, windows.ERROR_INSUFFICIENT_BUFFER) {
+				buf = make([]byte, used)
+				r1, _, e1 = procEvtRender.Call(context, h, EvtRenderEventValues, uintptr(len(buf)), uintptr(unsafe.Pointer(&buf[0])),
+					uintptr(unsafe.Pointer(&used)), uintptr(unsafe.Pointer(&count)))
+			}
+			procEvtClose.Call(h)
+			if r1 == 0 {
+				return values, os.NewSyscallError("EvtRender", e1)
+			}
+			v := (*evtVariant)(unsafe.Pointer(&buf[0]))
+			if count > 0 && v.Type == EvtVarTypeString && v.Value != nil {
+				values = append(values, windows.UTF16PtrToString(v.Value))
+			}
+		}
+	}
+}
@@ -0,0 +1,66 @@
+package win32
+
+import (
+	"encoding/binary"
+	"os"
+	"syscall"
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+var (
+	iphlpapi = syscall.NewLazyDLL("iphlpapi.dll")
+
+	procGetExtendedTcpTable = iphlpapi.NewProc("GetExtendedTcpTable")
+	procGetExtendedUdpTable = iphlpapi.NewProc("GetExtendedUdpTable")
+)
+
+const (
+	TCP_TABLE_OWNER_PID_ALL = 5
+	UDP_TABLE_OWNER_PID     = 1
+)
+
+// extendedTable calls GetExtendedTcpTable or GetExtendedUdpTable, growing the buffer until the table fits.
+func extendedTable(proc *syscall.LazyProc, af, class uint32) ([]byte, error) {
+	size := uint32(4096)
+	for {
+		buf := make([]byte, size)
+		r1, _, _ := proc.Call(uintptr(unsafe.Pointer(&buf[0])), uintptr(unsafe.Pointer(&size)), 0, uintptr(af), uintptr(class), 0)
+		switch syscall.Errno(r1) {
+		case 0:
+			return buf, nil
+		case windows.ERROR_INSUFFICIENT_BUFFER:
+			continue
+		}
+		return nil, os.NewSyscallError(proc.Name, syscall.Errno(r1))
+	}
+}
+
+// SocketsByProcess counts TCP connections and listeners, and bound UDP sockets, both IPv4 and IPv6, by owning
+// process. Sockets which are neither bound nor connected aren't in the tables.
+func SocketsByProcess() (map[uint32]uint32, error) {
+	tables := []struct {
+		proc      *syscall.LazyProc
+		af, class uint32
+		// Sizes of MIB_TCPROW_OWNER_PID and the others, and where dwOwningPid is there.
+		rowSize, pidOffset int
+	}{
+		{procGetExtendedTcpTable, windows.AF_INET, TCP_TABLE_OWNER_PID_ALL, 24, 20},
+		{procGetExtendedTcpTable, windows.AF_INET6, TCP_TABLE_OWNER_PID_ALL, 56, 52},
+		{procGetExtendedUdpTable, windows.AF_INET, UDP_TABLE_OWNER_PID, 12, 8},
+		{procGetExtendedUdpTable, windows.AF_INET6, UDP_TABLE_OWNER_PID, 28, 24},
+	}
+	result := map[uint32]uint32{}
+	for _, t := range tables {
+		buf, err := extendedTable(t.proc, t.af, t.class)
+		if err != nil {
+			return nil, err
+		}
+		n := int(binary.LittleEndian.Uint32(buf))
+		for i := 0; i < n && 4+(i+1)*t.rowSize <= len(buf); i++ {
+			result[binary.LittleEndian.Uint32(buf[4+i*t.rowSize+t.pidOffset:])]++
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,49 @@
+package win32
+
+import (
+	"os"
+	"syscall"
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+var (
+	powrprof = syscall.NewLazyDLL("powrprof.dll")
+
+	procCallNtPowerInformation = powrprof.NewProc("CallNtPowerInformation")
+)
+
+const ProcessorInformation = 11
+
+// ProcessorPowerInformation is PROCESSOR_POWER_INFORMATION. Frequencies are in MHz; MaxMhz is the rated one, and
+// MhzLimit is lower when the processor is throttled.
+type ProcessorPowerInformation struct {
+	Number           uint32
+	MaxMhz           uint32
+	CurrentMhz       uint32
+	MhzLimit         uint32
+	MaxIdleState     uint32
+	CurrentIdleState uint32
+}
+
+// GetProcessorPowerInformation returns an entry for each processor in the processor group of the calling thread.
+func GetProcessorPowerInformation() ([]ProcessorPowerInformation, error) {
+	n := windows.GetActiveProcessorCount(windows.ALL_PROCESSOR_GROUPS)
+	if n == 0 {
+		n = 1
+	}
+	result := make([]ProcessorPowerInformation, n)
+	size := uintptr(n) * unsafe.Sizeof(result[0])
+	r1, _, _ := procCallNtPowerInformation.Call(ProcessorInformation, 0, 0, uintptr(unsafe.Pointer(&result[0])), size)
+	if r1 != 0 {
+		return nil, os.NewSyscallError("CallNtPowerInformation", windows.NTStatus(r1))
+	}
+	// Entries past the calling thread's group stay zero.
+	for i := range result {
+		if result[i].MaxMhz == 0 {
+			return result[:i], nil
+		}
+	}
+	return result, nil
+}